@@ -0,0 +1,152 @@
+// Copyright 2026 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package commitsign provides github.MessageSigner implementations that sign
+// commits created with GitService.CreateCommit using OpenPGP or SSH keys. It
+// is a separate module so that the github package does not depend on
+// cryptography libraries.
+package commitsign
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha512"
+	"encoding/binary"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/google/go-github/v69/github"
+	"golang.org/x/crypto/ssh"
+)
+
+// PGPSigner returns a github.MessageSigner that signs commits with the first
+// private key in armoredPrivateKey, an ASCII-armored OpenPGP key as exported
+// by "gpg --armor --export-secret-keys".
+//
+// If the key is encrypted, passphrase is used to decrypt it. The key is parsed
+// and decrypted up front, so a bad key or passphrase is reported here rather
+// than when the commit is created.
+func PGPSigner(armoredPrivateKey, passphrase []byte) (github.MessageSigner, error) {
+	keyring, err := openpgp.ReadArmoredKeyRing(bytes.NewReader(armoredPrivateKey))
+	if err != nil {
+		return nil, fmt.Errorf("reading PGP private key: %w", err)
+	}
+
+	var entity *openpgp.Entity
+	for _, e := range keyring {
+		if e.PrivateKey != nil {
+			entity = e
+			break
+		}
+	}
+	if entity == nil {
+		return nil, errors.New("no PGP private key found")
+	}
+
+	if entity.PrivateKey.Encrypted {
+		if len(passphrase) == 0 {
+			return nil, errors.New("PGP private key is encrypted but no passphrase was given")
+		}
+		if err := entity.PrivateKey.Decrypt(passphrase); err != nil {
+			return nil, fmt.Errorf("decrypting PGP private key: %w", err)
+		}
+		for _, subkey := range entity.Subkeys {
+			if subkey.PrivateKey != nil && subkey.PrivateKey.Encrypted {
+				if err := subkey.PrivateKey.Decrypt(passphrase); err != nil {
+					return nil, fmt.Errorf("decrypting PGP private subkey: %w", err)
+				}
+			}
+		}
+	}
+
+	return github.MessageSignerFunc(func(w io.Writer, r io.Reader) error {
+		return openpgp.ArmoredDetachSign(w, entity, r, nil)
+	}), nil
+}
+
+// sshSigNamespace is the namespace git uses for SSH commit signatures.
+const sshSigNamespace = "git"
+
+// SSHSigner returns a github.MessageSigner that signs commits with privateKey,
+// a PEM-encoded SSH private key such as ~/.ssh/id_ed25519, producing the same
+// SSHSIG signatures as "git commit -S" with gpg.format set to ssh.
+//
+// If the key is encrypted, passphrase is used to decrypt it. The key is parsed
+// and decrypted up front, so a bad key or passphrase is reported here rather
+// than when the commit is created.
+func SSHSigner(privateKey, passphrase []byte) (github.MessageSigner, error) {
+	var signer ssh.Signer
+	var err error
+	if len(passphrase) > 0 {
+		signer, err = ssh.ParsePrivateKeyWithPassphrase(privateKey, passphrase)
+	} else {
+		signer, err = ssh.ParsePrivateKey(privateKey)
+	}
+	if err != nil {
+		var missing *ssh.PassphraseMissingError
+		if errors.As(err, &missing) {
+			return nil, errors.New("SSH private key is encrypted but no passphrase was given")
+		}
+		return nil, fmt.Errorf("reading SSH private key: %w", err)
+	}
+
+	return github.MessageSignerFunc(func(w io.Writer, r io.Reader) error {
+		return sshSign(w, signer, r)
+	}), nil
+}
+
+// sshSign writes an armored SSHSIG signature of the contents of r to w, as
+// described in https://github.com/openssh/openssh-portable/blob/master/PROTOCOL.sshsig.
+func sshSign(w io.Writer, signer ssh.Signer, r io.Reader) error {
+	h := sha512.New()
+	if _, err := io.Copy(h, r); err != nil {
+		return err
+	}
+
+	signed := sshSigBlob(sshSigNamespace, "sha512", h.Sum(nil))
+
+	var sig *ssh.Signature
+	var err error
+	// ssh-keygen signs with rsa-sha2-512 rather than the SHA-1 based ssh-rsa.
+	if as, ok := signer.(ssh.AlgorithmSigner); ok && signer.PublicKey().Type() == ssh.KeyAlgoRSA {
+		sig, err = as.SignWithAlgorithm(rand.Reader, signed, ssh.KeyAlgoRSASHA512)
+	} else {
+		sig, err = signer.Sign(rand.Reader, signed)
+	}
+	if err != nil {
+		return err
+	}
+
+	var blob bytes.Buffer
+	blob.WriteString("SSHSIG")
+	_ = binary.Write(&blob, binary.BigEndian, uint32(1))
+	writeSSHString(&blob, signer.PublicKey().Marshal())
+	writeSSHString(&blob, []byte(sshSigNamespace))
+	writeSSHString(&blob, nil)
+	writeSSHString(&blob, []byte("sha512"))
+	writeSSHString(&blob, ssh.Marshal(sig))
+
+	return pem.Encode(w, &pem.Block{Type: "SSH SIGNATURE", Bytes: blob.Bytes()})
+}
+
+// sshSigBlob returns the data that is signed for an SSHSIG signature.
+func sshSigBlob(namespace, hashAlg string, digest []byte) []byte {
+	var b bytes.Buffer
+	b.WriteString("SSHSIG")
+	writeSSHString(&b, []byte(namespace))
+	writeSSHString(&b, nil)
+	writeSSHString(&b, []byte(hashAlg))
+	writeSSHString(&b, digest)
+	return b.Bytes()
+}
+
+// writeSSHString writes s to b in the SSH wire format for strings.
+func writeSSHString(b *bytes.Buffer, s []byte) {
+	_ = binary.Write(b, binary.BigEndian, uint32(len(s)))
+	b.Write(s)
+}
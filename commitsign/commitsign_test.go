@@ -0,0 +1,176 @@
+// Copyright 2026 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package commitsign
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha512"
+	"encoding/pem"
+	"strings"
+	"testing"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+	"golang.org/x/crypto/ssh"
+)
+
+func TestPGPSigner(t *testing.T) {
+	t.Parallel()
+	entity, err := openpgp.NewEntity("n", "", "n@example.com", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var key bytes.Buffer
+	w, err := armor.Encode(&key, openpgp.PrivateKeyType, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := entity.SerializePrivate(w, nil); err != nil {
+		t.Fatal(err)
+	}
+	w.Close()
+
+	signer, err := PGPSigner(key.Bytes(), nil)
+	if err != nil {
+		t.Fatalf("PGPSigner returned error: %v", err)
+	}
+
+	const message = "tree abc\n\nCommit message."
+	var sig bytes.Buffer
+	if err := signer.Sign(&sig, strings.NewReader(message)); err != nil {
+		t.Fatalf("Sign returned error: %v", err)
+	}
+	if _, err := openpgp.CheckArmoredDetachedSignature(openpgp.EntityList{entity}, strings.NewReader(message), &sig, nil); err != nil {
+		t.Errorf("signature does not verify: %v", err)
+	}
+}
+
+func TestPGPSigner_invalidKey(t *testing.T) {
+	t.Parallel()
+	if _, err := PGPSigner([]byte("not a key"), nil); err == nil {
+		t.Error("PGPSigner returned nil error, want error")
+	}
+}
+
+func TestSSHSigner(t *testing.T) {
+	t.Parallel()
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name       string
+		key        any
+		passphrase []byte
+		wantAlg    string
+	}{
+		{name: "ed25519", key: edKey, wantAlg: ssh.KeyAlgoED25519},
+		{name: "rsa", key: rsaKey, wantAlg: ssh.KeyAlgoRSASHA512},
+		{name: "encrypted", key: edKey, passphrase: []byte("p"), wantAlg: ssh.KeyAlgoED25519},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var block *pem.Block
+			var err error
+			if tt.passphrase != nil {
+				block, err = ssh.MarshalPrivateKeyWithPassphrase(tt.key, "", tt.passphrase)
+			} else {
+				block, err = ssh.MarshalPrivateKey(tt.key, "")
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			signer, err := SSHSigner(pem.EncodeToMemory(block), tt.passphrase)
+			if err != nil {
+				t.Fatalf("SSHSigner returned error: %v", err)
+			}
+
+			const message = "tree abc\n\nCommit message."
+			var sig bytes.Buffer
+			if err := signer.Sign(&sig, strings.NewReader(message)); err != nil {
+				t.Fatalf("Sign returned error: %v", err)
+			}
+			testSSHSig(t, sig.Bytes(), message, tt.wantAlg)
+		})
+	}
+}
+
+func TestSSHSigner_missingPassphrase(t *testing.T) {
+	t.Parallel()
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	block, err := ssh.MarshalPrivateKeyWithPassphrase(key, "", []byte("p"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = SSHSigner(pem.EncodeToMemory(block), nil)
+	if err == nil || !strings.Contains(err.Error(), "no passphrase") {
+		t.Errorf("SSHSigner returned error %v, want missing passphrase error", err)
+	}
+	if _, err := SSHSigner(pem.EncodeToMemory(block), []byte("wrong")); err == nil {
+		t.Error("SSHSigner with wrong passphrase returned nil error, want error")
+	}
+}
+
+// testSSHSig checks that armored is a valid SSHSIG signature of message in the
+// git namespace, made with algorithm wantAlg.
+func testSSHSig(t *testing.T, armored []byte, message, wantAlg string) {
+	t.Helper()
+	block, _ := pem.Decode(armored)
+	if block == nil || block.Type != "SSH SIGNATURE" {
+		t.Fatalf("signature is not an armored SSH signature:\n%s", armored)
+	}
+
+	b, ok := bytes.CutPrefix(block.Bytes, []byte("SSHSIG"))
+	if !ok {
+		t.Fatal("signature is missing the SSHSIG magic preamble")
+	}
+	var sig struct {
+		Version   uint32
+		PublicKey []byte
+		Namespace string
+		Reserved  []byte
+		HashAlg   string
+		Signature []byte
+	}
+	if err := ssh.Unmarshal(b, &sig); err != nil {
+		t.Fatalf("unmarshaling signature: %v", err)
+	}
+	if sig.Version != 1 || sig.Namespace != "git" || sig.HashAlg != "sha512" {
+		t.Errorf("signature header = (%v, %q, %q), want (1, \"git\", \"sha512\")", sig.Version, sig.Namespace, sig.HashAlg)
+	}
+
+	pub, err := ssh.ParsePublicKey(sig.PublicKey)
+	if err != nil {
+		t.Fatalf("parsing public key: %v", err)
+	}
+	s := new(ssh.Signature)
+	if err := ssh.Unmarshal(sig.Signature, s); err != nil {
+		t.Fatalf("unmarshaling inner signature: %v", err)
+	}
+	if s.Format != wantAlg {
+		t.Errorf("signature format = %v, want %v", s.Format, wantAlg)
+	}
+
+	digest := sha512.Sum512([]byte(message))
+	if err := pub.Verify(sshSigBlob("git", "sha512", digest[:]), s); err != nil {
+		t.Errorf("signature does not verify: %v", err)
+	}
+}
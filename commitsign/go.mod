@@ -0,0 +1,18 @@
+module github.com/google/go-github/v69/commitsign
+
+go 1.22.0
+
+require (
+	github.com/ProtonMail/go-crypto v0.0.0-20230828082145-3c4c8a2d2371
+	github.com/google/go-github/v69 v69.0.0
+	golang.org/x/crypto v0.31.0
+)
+
+require (
+	github.com/cloudflare/circl v1.3.3 // indirect
+	github.com/google/go-querystring v1.1.0 // indirect
+	golang.org/x/sys v0.28.0 // indirect
+)
+
+// Use version at HEAD, not the latest published.
+replace github.com/google/go-github/v69 => ../
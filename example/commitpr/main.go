@@ -14,10 +14,13 @@
 // Note, if you want to push a single file, you probably prefer to use the
 // content API. An example is available here:
 // https://pkg.go.dev/github.com/google/go-github/github#example-RepositoriesService-CreateFile
+// To commit several files at once without building the tree yourself, see
+// RepositoriesService.CreateOrUpdateFiles.
 //
 // Note, for this to work at least 1 commit is needed, so you if you use this
 // after creating a repository you might want to make sure you set `AutoInit` to
-// `true`.
+// `true`. RepositoriesService.IsEmpty reports whether a repository has no
+// commits yet.
 package main
 
 import (
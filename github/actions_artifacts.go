@@ -6,12 +6,21 @@
 package github
 
 import (
+	"archive/zip"
 	"context"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
 )
 
+// artifactMaxRedirects is the number of permanent redirects followed by
+// DownloadArtifactTo and ExtractArtifact before giving up.
+const artifactMaxRedirects = 10
+
 // ArtifactWorkflowRun represents a GitHub artifact's workflow run.
 //
 // GitHub API docs: https://docs.github.com/rest/actions/artifacts
@@ -67,7 +76,7 @@ type ListArtifactsOptions struct {
 //meta:operation GET /repos/{owner}/{repo}/actions/artifacts
 func (s *ActionsService) ListArtifacts(ctx context.Context, owner, repo string, opts *ListArtifactsOptions) (*ArtifactList, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/actions/artifacts", owner, repo)
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -93,7 +102,7 @@ func (s *ActionsService) ListArtifacts(ctx context.Context, owner, repo string,
 //meta:operation GET /repos/{owner}/{repo}/actions/runs/{run_id}/artifacts
 func (s *ActionsService) ListWorkflowRunArtifacts(ctx context.Context, owner, repo string, runID int64, opts *ListOptions) (*ArtifactList, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/actions/runs/%v/artifacts", owner, repo, runID)
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -188,6 +197,177 @@ func (s *ActionsService) downloadArtifactWithRateLimit(ctx context.Context, u st
 	return url, resp, nil
 }
 
+// DownloadArtifactTo downloads the zip archive of an artifact and streams it
+// to w. The redirect URL returned by GitHub is pre-signed and must not be
+// sent the GitHub credentials, so it is fetched with httpClient, or
+// http.DefaultClient if nil, rather than with the client's own transport.
+//
+// GitHub API docs: https://docs.github.com/rest/actions/artifacts#download-an-artifact
+//
+//meta:operation GET /repos/{owner}/{repo}/actions/artifacts/{artifact_id}/{archive_format}
+func (s *ActionsService) DownloadArtifactTo(ctx context.Context, owner, repo string, artifactID int64, w io.Writer, httpClient *http.Client) (*Response, error) {
+	u, resp, err := s.DownloadArtifact(ctx, owner, repo, artifactID, artifactMaxRedirects)
+	if err != nil {
+		return resp, err
+	}
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", u.String(), nil)
+	if err != nil {
+		return resp, err
+	}
+
+	dlResp, err := httpClient.Do(req)
+	if err != nil {
+		return resp, err
+	}
+	defer dlResp.Body.Close()
+
+	resp = newResponse(dlResp)
+	if err := CheckResponse(dlResp); err != nil {
+		return resp, err
+	}
+
+	_, err = io.Copy(w, dlResp.Body)
+	return resp, err
+}
+
+// ExtractArtifact downloads the zip archive of an artifact and extracts its
+// entries into dir, which is created if it does not exist. Entries whose
+// names would resolve outside of dir are rejected with an error, as are
+// entries that are neither regular files nor directories. The archive is
+// downloaded with httpClient as described in DownloadArtifactTo.
+//
+// GitHub API docs: https://docs.github.com/rest/actions/artifacts#download-an-artifact
+//
+//meta:operation GET /repos/{owner}/{repo}/actions/artifacts/{artifact_id}/{archive_format}
+func (s *ActionsService) ExtractArtifact(ctx context.Context, owner, repo string, artifactID int64, dir string, httpClient *http.Client) (*Response, error) {
+	// zip.Reader requires random access, so spool the archive to disk first.
+	tmp, err := os.CreateTemp("", "go-github-artifact-*.zip")
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	resp, err := s.DownloadArtifactTo(ctx, owner, repo, artifactID, tmp, httpClient)
+	if err != nil {
+		return resp, err
+	}
+
+	size, err := tmp.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return resp, err
+	}
+
+	zr, err := zip.NewReader(tmp, size)
+	if err != nil {
+		return resp, err
+	}
+
+	return resp, extractZip(ctx, zr, dir)
+}
+
+// extractZip writes the entries of zr below dir. It checks ctx before each
+// entry and while copying it, so that large archives can be abandoned part
+// way through.
+func extractZip(ctx context.Context, zr *zip.Reader, dir string) error {
+	root, err := filepath.Abs(dir)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(root, 0o755); err != nil {
+		return err
+	}
+
+	for _, f := range zr.File {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		target, err := zipEntryPath(root, f.Name)
+		if err != nil {
+			return err
+		}
+
+		mode := f.Mode()
+		switch {
+		case mode.IsDir():
+			if err := os.MkdirAll(target, 0o755); err != nil {
+				return err
+			}
+		case mode.IsRegular():
+			if err := extractZipFile(ctx, f, target); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("zip entry %q has unsupported file mode %v", f.Name, mode)
+		}
+	}
+
+	return nil
+}
+
+// zipEntryPath returns the path below root that the zip entry name should
+// be written to, or an error if the entry would escape root.
+func zipEntryPath(root, name string) (string, error) {
+	if name == "" || filepath.IsAbs(name) || strings.HasPrefix(name, "/") || strings.HasPrefix(name, `\`) {
+		return "", fmt.Errorf("zip entry %q has an invalid path", name)
+	}
+
+	target := filepath.Join(root, filepath.FromSlash(name))
+	if target != root && !strings.HasPrefix(target, root+string(os.PathSeparator)) {
+		return "", fmt.Errorf("zip entry %q resolves outside of the destination directory", name)
+	}
+
+	return target, nil
+}
+
+func extractZipFile(ctx context.Context, f *zip.File, target string) (err error) {
+	if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+		return err
+	}
+
+	rc, err := f.Open()
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+
+	perm := f.Mode().Perm()
+	if perm == 0 {
+		perm = 0o644
+	}
+	out, err := os.OpenFile(target, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, perm)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if cerr := out.Close(); err == nil {
+			err = cerr
+		}
+	}()
+
+	_, err = io.Copy(out, &contextReader{ctx, rc}) //nolint:gosec // Entries are trusted as much as the artifact itself.
+	return err
+}
+
+// contextReader is an io.Reader that fails with the error of ctx once ctx is
+// done.
+type contextReader struct {
+	ctx context.Context
+	r   io.Reader
+}
+
+func (r *contextReader) Read(p []byte) (int, error) {
+	if err := r.ctx.Err(); err != nil {
+		return 0, err
+	}
+	return r.r.Read(p)
+}
+
 // DeleteArtifact deletes a workflow run artifact.
 //
 // GitHub API docs: https://docs.github.com/rest/actions/artifacts#delete-an-artifact
@@ -6,11 +6,15 @@
 package github
 
 import (
+	"archive/zip"
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -531,6 +535,234 @@ func TestActionsService_DownloadArtifact_unexpectedCode(t *testing.T) {
 	}
 }
 
+// testZipArchive returns a zip archive containing files, keyed by entry name.
+func testZipArchive(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, content := range files {
+		f, err := zw.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := f.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestActionsService_DownloadArtifactTo(t *testing.T) {
+	t.Parallel()
+	client, mux, serverURL := setup(t)
+
+	mux.HandleFunc("/repos/o/r/actions/artifacts/1/zip", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		http.Redirect(w, r, serverURL+baseURLPath+"/blob/artifact.zip", http.StatusFound)
+	})
+	mux.HandleFunc("/blob/artifact.zip", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, "zip-bytes")
+	})
+
+	ctx := context.Background()
+	var buf bytes.Buffer
+	resp, err := client.Actions.DownloadArtifactTo(ctx, "o", "r", 1, &buf, nil)
+	if err != nil {
+		t.Fatalf("Actions.DownloadArtifactTo returned error: %v", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("Actions.DownloadArtifactTo returned status: %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+	if got, want := buf.String(), "zip-bytes"; got != want {
+		t.Errorf("Actions.DownloadArtifactTo wrote %q, want %q", got, want)
+	}
+
+	const methodName = "DownloadArtifactTo"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Actions.DownloadArtifactTo(ctx, "\n", "\n", -1, &buf, nil)
+		return err
+	})
+}
+
+func TestActionsService_DownloadArtifactTo_httpClient(t *testing.T) {
+	t.Parallel()
+	client, mux, serverURL := setup(t)
+
+	mux.HandleFunc("/repos/o/r/actions/artifacts/1/zip", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, serverURL+baseURLPath+"/blob/artifact.zip", http.StatusFound)
+	})
+	mux.HandleFunc("/blob/artifact.zip", func(w http.ResponseWriter, r *http.Request) {
+		if got, want := r.Header.Get("X-Test"), "download"; got != want {
+			t.Errorf("X-Test header = %q, want %q", got, want)
+		}
+		fmt.Fprint(w, "zip-bytes")
+	})
+
+	httpClient := &http.Client{Transport: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		r = r.Clone(r.Context())
+		r.Header.Set("X-Test", "download")
+		return http.DefaultTransport.RoundTrip(r)
+	})}
+	var buf bytes.Buffer
+	if _, err := client.Actions.DownloadArtifactTo(context.Background(), "o", "r", 1, &buf, httpClient); err != nil {
+		t.Fatalf("Actions.DownloadArtifactTo returned error: %v", err)
+	}
+	if got, want := buf.String(), "zip-bytes"; got != want {
+		t.Errorf("Actions.DownloadArtifactTo wrote %q, want %q", got, want)
+	}
+}
+
+func TestActionsService_DownloadArtifactTo_downloadError(t *testing.T) {
+	t.Parallel()
+	client, mux, serverURL := setup(t)
+
+	mux.HandleFunc("/repos/o/r/actions/artifacts/1/zip", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, serverURL+baseURLPath+"/blob/artifact.zip", http.StatusFound)
+	})
+	mux.HandleFunc("/blob/artifact.zip", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	})
+
+	ctx := context.Background()
+	resp, err := client.Actions.DownloadArtifactTo(ctx, "o", "r", 1, &bytes.Buffer{}, nil)
+	if err == nil {
+		t.Fatal("Actions.DownloadArtifactTo returned nil error, want error")
+	}
+	if got, want := resp.StatusCode, http.StatusForbidden; got != want {
+		t.Errorf("Actions.DownloadArtifactTo returned status: %d, want %d", got, want)
+	}
+}
+
+func TestActionsService_ExtractArtifact(t *testing.T) {
+	t.Parallel()
+	client, mux, serverURL := setup(t)
+
+	archive := testZipArchive(t, map[string]string{
+		"a.txt":       "a",
+		"dir/":        "",
+		"dir/b/c.txt": "c",
+	})
+	mux.HandleFunc("/repos/o/r/actions/artifacts/1/zip", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, serverURL+baseURLPath+"/blob/artifact.zip", http.StatusFound)
+	})
+	mux.HandleFunc("/blob/artifact.zip", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(archive)
+	})
+
+	dir := filepath.Join(t.TempDir(), "out")
+	ctx := context.Background()
+	if _, err := client.Actions.ExtractArtifact(ctx, "o", "r", 1, dir, nil); err != nil {
+		t.Fatalf("Actions.ExtractArtifact returned error: %v", err)
+	}
+
+	for name, want := range map[string]string{"a.txt": "a", "dir/b/c.txt": "c"} {
+		got, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(name)))
+		if err != nil {
+			t.Fatalf("reading %v: %v", name, err)
+		}
+		if string(got) != want {
+			t.Errorf("extracted %v = %q, want %q", name, got, want)
+		}
+	}
+}
+
+func TestActionsService_ExtractArtifact_zipSlip(t *testing.T) {
+	t.Parallel()
+	client, mux, serverURL := setup(t)
+
+	archive := testZipArchive(t, map[string]string{"../../evil.txt": "evil"})
+	mux.HandleFunc("/repos/o/r/actions/artifacts/1/zip", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, serverURL+baseURLPath+"/blob/artifact.zip", http.StatusFound)
+	})
+	mux.HandleFunc("/blob/artifact.zip", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(archive)
+	})
+
+	parent := t.TempDir()
+	dir := filepath.Join(parent, "a", "out")
+	ctx := context.Background()
+	if _, err := client.Actions.ExtractArtifact(ctx, "o", "r", 1, dir, nil); err == nil {
+		t.Fatal("Actions.ExtractArtifact returned nil error, want error")
+	}
+	if _, err := os.Stat(filepath.Join(parent, "evil.txt")); !os.IsNotExist(err) {
+		t.Errorf("Actions.ExtractArtifact wrote outside of the destination directory")
+	}
+}
+
+func TestZipEntryPath(t *testing.T) {
+	t.Parallel()
+	root := filepath.Join(string(os.PathSeparator), "tmp", "out")
+
+	tests := []struct {
+		name    string
+		want    string
+		wantErr bool
+	}{
+		{name: "a.txt", want: filepath.Join(root, "a.txt")},
+		{name: "a/b/../c.txt", want: filepath.Join(root, "a", "c.txt")},
+		{name: "./a.txt", want: filepath.Join(root, "a.txt")},
+		{name: "", wantErr: true},
+		{name: "/etc/passwd", wantErr: true},
+		{name: "../evil.txt", wantErr: true},
+		{name: "a/../../evil.txt", wantErr: true},
+		{name: "../out-sibling/evil.txt", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := zipEntryPath(root, tt.name)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("zipEntryPath(%q) returned %q, want error", tt.name, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("zipEntryPath(%q) returned error: %v", tt.name, err)
+		}
+		if got != tt.want {
+			t.Errorf("zipEntryPath(%q) = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestExtractZip_canceledContext(t *testing.T) {
+	t.Parallel()
+	archive := testZipArchive(t, map[string]string{"a.txt": "a"})
+	zr, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	dir := t.TempDir()
+	if err := extractZip(ctx, zr, dir); !errors.Is(err, context.Canceled) {
+		t.Errorf("extractZip returned %v, want %v", err, context.Canceled)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "a.txt")); !os.IsNotExist(err) {
+		t.Errorf("extractZip wrote entries after the context was canceled")
+	}
+}
+
+func TestContextReader(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithCancel(context.Background())
+	r := &contextReader{ctx, strings.NewReader("abc")}
+
+	p := make([]byte, 1)
+	if _, err := r.Read(p); err != nil {
+		t.Fatalf("contextReader.Read returned error: %v", err)
+	}
+	cancel()
+	if _, err := r.Read(p); !errors.Is(err, context.Canceled) {
+		t.Errorf("contextReader.Read returned %v, want %v", err, context.Canceled)
+	}
+}
+
 func TestActionsService_DeleteArtifact(t *testing.T) {
 	t.Parallel()
 	client, mux, _ := setup(t)
@@ -82,7 +82,7 @@ type ActionsCacheListOptions struct {
 //meta:operation GET /repos/{owner}/{repo}/actions/caches
 func (s *ActionsService) ListCaches(ctx context.Context, owner, repo string, opts *ActionsCacheListOptions) (*ActionsCacheList, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/actions/caches", owner, repo)
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -114,7 +114,7 @@ func (s *ActionsService) ListCaches(ctx context.Context, owner, repo string, opt
 //meta:operation DELETE /repos/{owner}/{repo}/actions/caches
 func (s *ActionsService) DeleteCachesByKey(ctx context.Context, owner, repo, key string, ref *string) (*Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/actions/caches", owner, repo)
-	u, err := addOptions(u, ActionsCache{Key: &key, Ref: ref})
+	u, err := s.client.addOptions(u, ActionsCache{Key: &key, Ref: ref})
 	if err != nil {
 		return nil, err
 	}
@@ -180,7 +180,7 @@ func (s *ActionsService) GetCacheUsageForRepo(ctx context.Context, owner, repo s
 //meta:operation GET /orgs/{org}/actions/cache/usage-by-repository
 func (s *ActionsService) ListCacheUsageByRepoForOrg(ctx context.Context, org string, opts *ListOptions) (*ActionsCacheUsageList, *Response, error) {
 	u := fmt.Sprintf("orgs/%v/actions/cache/usage-by-repository", org)
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
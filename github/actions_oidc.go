@@ -7,15 +7,33 @@ package github
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 )
 
 // OIDCSubjectClaimCustomTemplate represents an OIDC subject claim customization template.
 type OIDCSubjectClaimCustomTemplate struct {
-	UseDefault       *bool    `json:"use_default,omitempty"`
+	UseDefault *bool `json:"use_default,omitempty"`
+	// IncludeClaimKeys lists the claims, such as "repo" or "context", that
+	// make up the subject claim. It is omitted when nil, while a non-nil
+	// empty slice is sent as [] to clear the keys of the template.
 	IncludeClaimKeys []string `json:"include_claim_keys,omitempty"`
 }
 
+// MarshalJSON implements the json.Marshaler interface. It sends a non-nil
+// empty IncludeClaimKeys as [] rather than omitting it.
+func (t OIDCSubjectClaimCustomTemplate) MarshalJSON() ([]byte, error) {
+	type alias OIDCSubjectClaimCustomTemplate
+	v := struct {
+		alias
+		IncludeClaimKeys *[]string `json:"include_claim_keys,omitempty"`
+	}{alias: alias(t)}
+	if t.IncludeClaimKeys != nil {
+		v.IncludeClaimKeys = &t.IncludeClaimKeys
+	}
+	return json.Marshal(v)
+}
+
 // GetOrgOIDCSubjectClaimCustomTemplate gets the subject claim customization template for an organization.
 //
 // GitHub API docs: https://docs.github.com/rest/actions/oidc#get-the-customization-template-for-an-oidc-subject-claim-for-an-organization
@@ -198,3 +198,18 @@ func TestOIDCSubjectClaimCustomTemplate_Marshal(t *testing.T) {
 
 	testJSONMarshal(t, u, want)
 }
+
+func TestOIDCSubjectClaimCustomTemplate_MarshalEmptyKeys(t *testing.T) {
+	t.Parallel()
+	u := &OIDCSubjectClaimCustomTemplate{
+		UseDefault:       Ptr(false),
+		IncludeClaimKeys: []string{},
+	}
+
+	want := `{
+		"use_default": false,
+		"include_claim_keys": []
+	}`
+
+	testJSONMarshal(t, u, want)
+}
@@ -33,8 +33,13 @@ func (a ActionsPermissionsEnterprise) String() string {
 //
 // GitHub API docs: https://docs.github.com/enterprise-cloud@latest/rest/actions/permissions
 type DefaultWorkflowPermissionEnterprise struct {
-	DefaultWorkflowPermissions   *string `json:"default_workflow_permissions,omitempty"`
-	CanApprovePullRequestReviews *bool   `json:"can_approve_pull_request_reviews,omitempty"`
+	// DefaultWorkflowPermissions is the permissions of the GITHUB_TOKEN of
+	// workflows in the enterprise: "read" for read access to the contents and
+	// packages scopes only, or "write" for read and write access to all scopes.
+	DefaultWorkflowPermissions *string `json:"default_workflow_permissions,omitempty"`
+	// CanApprovePullRequestReviews is whether GitHub Actions can approve pull
+	// requests, and, when editing, create them.
+	CanApprovePullRequestReviews *bool `json:"can_approve_pull_request_reviews,omitempty"`
 }
 
 // GetActionsPermissionsInEnterprise gets the GitHub Actions permissions policy for an enterprise.
@@ -87,7 +92,7 @@ func (s *ActionsService) EditActionsPermissionsInEnterprise(ctx context.Context,
 //meta:operation GET /enterprises/{enterprise}/actions/permissions/organizations
 func (s *ActionsService) ListEnabledOrgsInEnterprise(ctx context.Context, owner string, opts *ListOptions) (*ActionsEnabledOnEnterpriseRepos, *Response, error) {
 	u := fmt.Sprintf("enterprises/%v/actions/permissions/organizations", owner)
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -237,11 +242,17 @@ func (s *ActionsService) GetDefaultWorkflowPermissionsInEnterprise(ctx context.C
 }
 
 // EditDefaultWorkflowPermissionsInEnterprise sets the GitHub Actions default workflow permissions for an enterprise.
+// An *InvalidEnumError is returned without making a request if
+// permissions.DefaultWorkflowPermissions is not "read" or "write".
 //
 // GitHub API docs: https://docs.github.com/enterprise-cloud@latest/rest/actions/permissions#set-default-workflow-permissions-for-an-enterprise
 //
 //meta:operation PUT /enterprises/{enterprise}/actions/permissions/workflow
 func (s *ActionsService) EditDefaultWorkflowPermissionsInEnterprise(ctx context.Context, enterprise string, permissions DefaultWorkflowPermissionEnterprise) (*DefaultWorkflowPermissionEnterprise, *Response, error) {
+	if err := validateEnum("default_workflow_permissions", permissions.GetDefaultWorkflowPermissions(), "read", "write"); err != nil {
+		return nil, nil, err
+	}
+
 	u := fmt.Sprintf("enterprises/%v/actions/permissions/workflow", enterprise)
 	req, err := s.client.NewRequest("PUT", u, permissions)
 	if err != nil {
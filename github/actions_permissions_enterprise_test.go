@@ -8,6 +8,7 @@ package github
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"testing"
@@ -375,3 +376,15 @@ func TestActionsService_EditDefaultWorkflowPermissionsInEnterprise(t *testing.T)
 		return resp, err
 	})
 }
+
+func TestActionsService_EditDefaultWorkflowPermissionsInEnterprise_invalid(t *testing.T) {
+	t.Parallel()
+	client, _, _ := setup(t)
+
+	ctx := context.Background()
+	_, _, err := client.Actions.EditDefaultWorkflowPermissionsInEnterprise(ctx, "e", DefaultWorkflowPermissionEnterprise{DefaultWorkflowPermissions: Ptr("none")})
+	var enumErr *InvalidEnumError
+	if !errors.As(err, &enumErr) {
+		t.Errorf("Actions.EditDefaultWorkflowPermissionsInEnterprise returned error %v, want *InvalidEnumError", err)
+	}
+}
@@ -14,9 +14,17 @@ import (
 //
 // GitHub API docs: https://docs.github.com/rest/actions/permissions
 type ActionsPermissions struct {
+	// EnabledRepositories is the policy that controls the repositories in the
+	// organization that are allowed to run GitHub Actions.
+	// Possible values are: "all", "none", "selected".
 	EnabledRepositories *string `json:"enabled_repositories,omitempty"`
-	AllowedActions      *string `json:"allowed_actions,omitempty"`
-	SelectedActionsURL  *string `json:"selected_actions_url,omitempty"`
+	// AllowedActions is the permissions policy that controls the actions and
+	// reusable workflows that are allowed to run.
+	// Possible values are: "all", "local_only", "selected". When set to
+	// "selected", the allowed actions are configured with
+	// ActionsService.EditActionsAllowed.
+	AllowedActions     *string `json:"allowed_actions,omitempty"`
+	SelectedActionsURL *string `json:"selected_actions_url,omitempty"`
 }
 
 func (a ActionsPermissions) String() string {
@@ -29,13 +37,22 @@ type ActionsEnabledOnOrgRepos struct {
 	Repositories []*Repository `json:"repositories"`
 }
 
-// ActionsAllowed represents selected actions that are allowed.
+// ActionsAllowed represents selected actions that are allowed. The same type
+// is used at the enterprise, organization, and repository level, so code that
+// manages the policy can be shared between them.
 //
 // GitHub API docs: https://docs.github.com/rest/actions/permissions
 type ActionsAllowed struct {
-	GithubOwnedAllowed *bool    `json:"github_owned_allowed,omitempty"`
-	VerifiedAllowed    *bool    `json:"verified_allowed,omitempty"`
-	PatternsAllowed    []string `json:"patterns_allowed,omitempty"`
+	// GithubOwnedAllowed allows all actions created by GitHub, such as those
+	// in the actions and github organizations.
+	GithubOwnedAllowed *bool `json:"github_owned_allowed,omitempty"`
+	// VerifiedAllowed allows all actions by verified Marketplace creators.
+	VerifiedAllowed *bool `json:"verified_allowed,omitempty"`
+	// PatternsAllowed lists the actions and reusable workflows that are
+	// allowed, such as "monalisa/octocat@v1", "monalisa/*", or
+	// "octo-org/shared/.github/workflows/build.yml@main". Wildcards, tags, and
+	// SHAs are supported.
+	PatternsAllowed []string `json:"patterns_allowed,omitempty"`
 }
 
 func (a ActionsAllowed) String() string {
@@ -46,8 +63,13 @@ func (a ActionsAllowed) String() string {
 //
 // GitHub API docs: https://docs.github.com/rest/actions/permissions
 type DefaultWorkflowPermissionOrganization struct {
-	DefaultWorkflowPermissions   *string `json:"default_workflow_permissions,omitempty"`
-	CanApprovePullRequestReviews *bool   `json:"can_approve_pull_request_reviews,omitempty"`
+	// DefaultWorkflowPermissions is the permissions of the GITHUB_TOKEN of
+	// workflows in the organization: "read" for read access to the contents and
+	// packages scopes only, or "write" for read and write access to all scopes.
+	DefaultWorkflowPermissions *string `json:"default_workflow_permissions,omitempty"`
+	// CanApprovePullRequestReviews is whether GitHub Actions can approve pull
+	// requests, and, when editing, create them.
+	CanApprovePullRequestReviews *bool `json:"can_approve_pull_request_reviews,omitempty"`
 }
 
 // GetActionsPermissions gets the GitHub Actions permissions policy for repositories and allowed actions in an organization.
@@ -100,7 +122,7 @@ func (s *ActionsService) EditActionsPermissions(ctx context.Context, org string,
 //meta:operation GET /orgs/{org}/actions/permissions/repositories
 func (s *ActionsService) ListEnabledReposInOrg(ctx context.Context, owner string, opts *ListOptions) (*ActionsEnabledOnOrgRepos, *Response, error) {
 	u := fmt.Sprintf("orgs/%v/actions/permissions/repositories", owner)
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -184,7 +206,8 @@ func (s *ActionsService) RemoveEnabledReposInOrg(ctx context.Context, owner stri
 	return resp, nil
 }
 
-// GetActionsAllowed gets the actions that are allowed in an organization.
+// GetActionsAllowed gets the actions and reusable workflows that are allowed in
+// an organization when its AllowedActions policy is "selected".
 //
 // GitHub API docs: https://docs.github.com/rest/actions/permissions#get-allowed-actions-and-reusable-workflows-for-an-organization
 //
@@ -206,7 +229,10 @@ func (s *ActionsService) GetActionsAllowed(ctx context.Context, org string) (*Ac
 	return actionsAllowed, resp, nil
 }
 
-// EditActionsAllowed sets the actions that are allowed in an organization.
+// EditActionsAllowed sets the actions and reusable workflows that are allowed
+// in an organization when its AllowedActions policy is "selected". Whether
+// other repositories may use the reusable workflows of a private repository is
+// set per repository with RepositoriesService.EditActionsAccessLevel.
 //
 // GitHub API docs: https://docs.github.com/rest/actions/permissions#set-allowed-actions-and-reusable-workflows-for-an-organization
 //
@@ -250,11 +276,17 @@ func (s *ActionsService) GetDefaultWorkflowPermissionsInOrganization(ctx context
 }
 
 // EditDefaultWorkflowPermissionsInOrganization sets the GitHub Actions default workflow permissions for an organization.
+// An *InvalidEnumError is returned without making a request if
+// permissions.DefaultWorkflowPermissions is not "read" or "write".
 //
 // GitHub API docs: https://docs.github.com/rest/actions/permissions#set-default-workflow-permissions-for-an-organization
 //
 //meta:operation PUT /orgs/{org}/actions/permissions/workflow
 func (s *ActionsService) EditDefaultWorkflowPermissionsInOrganization(ctx context.Context, org string, permissions DefaultWorkflowPermissionOrganization) (*DefaultWorkflowPermissionOrganization, *Response, error) {
+	if err := validateEnum("default_workflow_permissions", permissions.GetDefaultWorkflowPermissions(), "read", "write"); err != nil {
+		return nil, nil, err
+	}
+
 	u := fmt.Sprintf("orgs/%v/actions/permissions/workflow", org)
 	req, err := s.client.NewRequest("PUT", u, permissions)
 	if err != nil {
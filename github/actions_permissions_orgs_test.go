@@ -8,6 +8,7 @@ package github
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"testing"
@@ -415,3 +416,15 @@ func TestActionsService_EditDefaultWorkflowPermissionsInOrganization(t *testing.
 		return resp, err
 	})
 }
+
+func TestActionsService_EditDefaultWorkflowPermissionsInOrganization_invalid(t *testing.T) {
+	t.Parallel()
+	client, _, _ := setup(t)
+
+	ctx := context.Background()
+	_, _, err := client.Actions.EditDefaultWorkflowPermissionsInOrganization(ctx, "o", DefaultWorkflowPermissionOrganization{DefaultWorkflowPermissions: Ptr("none")})
+	var enumErr *InvalidEnumError
+	if !errors.As(err, &enumErr) {
+		t.Errorf("Actions.EditDefaultWorkflowPermissionsInOrganization returned error %v, want *InvalidEnumError", err)
+	}
+}
@@ -72,7 +72,7 @@ type RepoRequiredWorkflows struct {
 //meta:operation GET /orgs/{org}/actions/required_workflows
 func (s *ActionsService) ListOrgRequiredWorkflows(ctx context.Context, org string, opts *ListOptions) (*OrgRequiredWorkflows, *Response, error) {
 	url := fmt.Sprintf("orgs/%v/actions/required_workflows", org)
-	u, err := addOptions(url, opts)
+	u, err := s.client.addOptions(url, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -176,7 +176,7 @@ func (s *ActionsService) DeleteRequiredWorkflow(ctx context.Context, org string,
 //meta:operation GET /orgs/{org}/actions/required_workflows/{workflow_id}/repositories
 func (s *ActionsService) ListRequiredWorkflowSelectedRepos(ctx context.Context, org string, requiredWorkflowID int64, opts *ListOptions) (*RequiredWorkflowSelectedRepos, *Response, error) {
 	url := fmt.Sprintf("orgs/%v/actions/required_workflows/%v/repositories", org, requiredWorkflowID)
-	u, err := addOptions(url, opts)
+	u, err := s.client.addOptions(url, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -247,7 +247,7 @@ func (s *ActionsService) RemoveRepoFromRequiredWorkflow(ctx context.Context, org
 //meta:operation GET /repos/{owner}/{repo}/actions/required_workflows
 func (s *ActionsService) ListRepoRequiredWorkflows(ctx context.Context, owner, repo string, opts *ListOptions) (*RepoRequiredWorkflows, *Response, error) {
 	url := fmt.Sprintf("repos/%v/%v/actions/required_workflows", owner, repo)
-	u, err := addOptions(url, opts)
+	u, err := s.client.addOptions(url, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -85,7 +85,7 @@ type ListOrgRunnerGroupOptions struct {
 //meta:operation GET /orgs/{org}/actions/runner-groups
 func (s *ActionsService) ListOrganizationRunnerGroups(ctx context.Context, org string, opts *ListOrgRunnerGroupOptions) (*RunnerGroups, *Response, error) {
 	u := fmt.Sprintf("orgs/%v/actions/runner-groups", org)
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -190,7 +190,7 @@ func (s *ActionsService) UpdateOrganizationRunnerGroup(ctx context.Context, org
 //meta:operation GET /orgs/{org}/actions/runner-groups/{runner_group_id}/repositories
 func (s *ActionsService) ListRepositoryAccessRunnerGroup(ctx context.Context, org string, groupID int64, opts *ListOptions) (*ListRepositories, *Response, error) {
 	u := fmt.Sprintf("orgs/%v/actions/runner-groups/%v/repositories", org, groupID)
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -267,7 +267,7 @@ func (s *ActionsService) RemoveRepositoryAccessRunnerGroup(ctx context.Context,
 //meta:operation GET /orgs/{org}/actions/runner-groups/{runner_group_id}/runners
 func (s *ActionsService) ListRunnerGroupRunners(ctx context.Context, org string, groupID int64, opts *ListOptions) (*Runners, *Response, error) {
 	u := fmt.Sprintf("orgs/%v/actions/runner-groups/%v/runners", org, groupID)
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
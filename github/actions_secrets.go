@@ -108,7 +108,7 @@ type Secrets struct {
 }
 
 func (s *ActionsService) listSecrets(ctx context.Context, url string, opts *ListOptions) (*Secrets, *Response, error) {
-	u, err := addOptions(url, opts)
+	u, err := s.client.addOptions(url, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -316,7 +316,7 @@ type SelectedReposList struct {
 }
 
 func (s *ActionsService) listSelectedReposForSecret(ctx context.Context, url string, opts *ListOptions) (*SelectedReposList, *Response, error) {
-	u, err := addOptions(url, opts)
+	u, err := s.client.addOptions(url, opts)
 	if err != nil {
 		return nil, nil, err
 	}
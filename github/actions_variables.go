@@ -30,7 +30,7 @@ type ActionsVariables struct {
 }
 
 func (s *ActionsService) listVariables(ctx context.Context, url string, opts *ListOptions) (*ActionsVariables, *Response, error) {
-	u, err := addOptions(url, opts)
+	u, err := s.client.addOptions(url, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -250,7 +250,7 @@ func (s *ActionsService) DeleteEnvVariable(ctx context.Context, owner, repo, env
 }
 
 func (s *ActionsService) listSelectedReposForVariable(ctx context.Context, url string, opts *ListOptions) (*SelectedReposList, *Response, error) {
-	u, err := addOptions(url, opts)
+	u, err := s.client.addOptions(url, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -0,0 +1,124 @@
+// Copyright 2026 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// WorkflowInput is an input declared under on.workflow_dispatch.inputs in a
+// workflow file.
+//
+// GitHub docs: https://docs.github.com/actions/writing-workflows/workflow-syntax-for-github-actions#onworkflow_dispatchinputs
+type WorkflowInput struct {
+	Description string `yaml:"description"`
+	Required    bool   `yaml:"required"`
+	// Default is the value used when the input is not provided, or "" if
+	// there is none.
+	Default string `yaml:"default"`
+	// Type is one of "string", "boolean", "number", "choice", or
+	// "environment". It is "string" if the workflow does not declare one.
+	Type string `yaml:"type"`
+	// Options lists the allowed values of a "choice" input.
+	Options []string `yaml:"options"`
+}
+
+// WorkflowInputs maps the names of the inputs of a workflow_dispatch workflow
+// to their declarations. They can be read from a workflow file with the
+// github.com/google/go-github/v69/workflowinputs package.
+type WorkflowInputs map[string]*WorkflowInput
+
+// WorkflowInputError reports an input that does not match the declaration
+// of the workflow it is dispatched to.
+type WorkflowInputError struct {
+	Input  string // Name of the input.
+	Reason string // Why the input is invalid.
+}
+
+func (e *WorkflowInputError) Error() string {
+	return fmt.Sprintf("workflow input %q: %v", e.Input, e.Reason)
+}
+
+// Validate checks inputs, as sent in CreateWorkflowDispatchEventRequest.Inputs,
+// against the declared inputs. It returns a *WorkflowInputError, or several
+// joined with errors.Join, for each input that the workflow does not declare,
+// each required input without a default that is missing, and each value that
+// does not match the type of its input. Values may be given as strings, as
+// GitHub does, or as bool or numeric values for boolean and number inputs.
+func (w WorkflowInputs) Validate(inputs map[string]interface{}) error {
+	var errs []error
+
+	names := make([]string, 0, len(inputs))
+	for name := range inputs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		input, ok := w[name]
+		if !ok {
+			errs = append(errs, &WorkflowInputError{Input: name, Reason: "not declared by the workflow"})
+			continue
+		}
+		if reason := input.check(inputs[name]); reason != "" {
+			errs = append(errs, &WorkflowInputError{Input: name, Reason: reason})
+		}
+	}
+
+	declared := make([]string, 0, len(w))
+	for name := range w {
+		declared = append(declared, name)
+	}
+	sort.Strings(declared)
+	for _, name := range declared {
+		if _, ok := inputs[name]; !ok && w[name].Required && w[name].Default == "" {
+			errs = append(errs, &WorkflowInputError{Input: name, Reason: "required but not provided"})
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// check returns why value is not valid for the input, or "" if it is.
+func (in *WorkflowInput) check(value interface{}) string {
+	switch in.Type {
+	case "boolean":
+		switch v := value.(type) {
+		case bool:
+			return ""
+		case string:
+			if v == "true" || v == "false" {
+				return ""
+			}
+		}
+		return fmt.Sprintf("%v is not a boolean", value)
+	case "number":
+		switch v := value.(type) {
+		case int, int32, int64, uint, uint32, uint64, float32, float64:
+			return ""
+		case string:
+			if _, err := strconv.ParseFloat(v, 64); err == nil {
+				return ""
+			}
+		}
+		return fmt.Sprintf("%v is not a number", value)
+	case "choice":
+		v, ok := value.(string)
+		if !ok || !slices.Contains(in.Options, v) {
+			return fmt.Sprintf("%v is not one of %v", value, strings.Join(in.Options, ", "))
+		}
+		return ""
+	default:
+		if _, ok := value.(string); !ok {
+			return fmt.Sprintf("%v is not a string", value)
+		}
+		return ""
+	}
+}
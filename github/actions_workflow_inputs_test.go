@@ -0,0 +1,62 @@
+// Copyright 2026 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestWorkflowInputs_Validate(t *testing.T) {
+	t.Parallel()
+	declared := WorkflowInputs{
+		"environment": {Description: "Target environment", Type: "environment", Required: true},
+		"level":       {Type: "choice", Options: []string{"debug", "info", "warn"}, Default: "info", Required: true},
+		"dry_run":     {Type: "boolean", Default: "true"},
+		"replicas":    {Type: "number"},
+		"note":        {Type: "string"},
+	}
+
+	valid := []map[string]interface{}{
+		{"environment": "prod"},
+		{"environment": "prod", "level": "warn", "dry_run": false, "replicas": 3, "note": "n"},
+		{"environment": "prod", "dry_run": "true", "replicas": "2.5"},
+	}
+	for _, inputs := range valid {
+		if err := declared.Validate(inputs); err != nil {
+			t.Errorf("Validate(%v) returned error: %v", inputs, err)
+		}
+	}
+
+	err := declared.Validate(map[string]interface{}{
+		"level":    "trace",
+		"dry_run":  "yes",
+		"replicas": "many",
+		"note":     1,
+		"extra":    "x",
+	})
+	var got []WorkflowInputError
+	for _, e := range err.(interface{ Unwrap() []error }).Unwrap() {
+		var inputErr *WorkflowInputError
+		if !errors.As(e, &inputErr) {
+			t.Fatalf("Validate returned %T, want *WorkflowInputError", e)
+		}
+		got = append(got, *inputErr)
+	}
+	want := []WorkflowInputError{
+		{Input: "dry_run", Reason: "yes is not a boolean"},
+		{Input: "extra", Reason: "not declared by the workflow"},
+		{Input: "level", Reason: "trace is not one of debug, info, warn"},
+		{Input: "note", Reason: "1 is not a string"},
+		{Input: "replicas", Reason: "many is not a number"},
+		{Input: "environment", Reason: "required but not provided"},
+	}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Validate returned %+v, want %+v", got, want)
+	}
+}
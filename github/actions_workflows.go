@@ -53,6 +53,7 @@ type CreateWorkflowDispatchEventRequest struct {
 	// Inputs represents input keys and values configured in the workflow file.
 	// The maximum number of properties is 10.
 	// Default: Any default properties configured in the workflow file will be used when `inputs` are omitted.
+	// Use WorkflowInputs.Validate to check them before dispatching.
 	Inputs map[string]interface{} `json:"inputs,omitempty"`
 }
 
@@ -63,7 +64,7 @@ type CreateWorkflowDispatchEventRequest struct {
 //meta:operation GET /repos/{owner}/{repo}/actions/workflows
 func (s *ActionsService) ListWorkflows(ctx context.Context, owner, repo string, opts *ListOptions) (*Workflows, *Response, error) {
 	u := fmt.Sprintf("repos/%s/%s/actions/workflows", owner, repo)
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -8,6 +8,8 @@ package github
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"time"
 )
 
 // ListEvents drinks from the firehose of all public events across GitHub.
@@ -16,7 +18,7 @@ import (
 //
 //meta:operation GET /events
 func (s *ActivityService) ListEvents(ctx context.Context, opts *ListOptions) ([]*Event, *Response, error) {
-	u, err := addOptions("events", opts)
+	u, err := s.client.addOptions("events", opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -42,7 +44,7 @@ func (s *ActivityService) ListEvents(ctx context.Context, opts *ListOptions) ([]
 //meta:operation GET /repos/{owner}/{repo}/events
 func (s *ActivityService) ListRepositoryEvents(ctx context.Context, owner, repo string, opts *ListOptions) ([]*Event, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/events", owner, repo)
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -61,6 +63,78 @@ func (s *ActivityService) ListRepositoryEvents(ctx context.Context, owner, repo
 	return events, resp, nil
 }
 
+// defaultEventPollInterval is how long PollEvents waits between polls when
+// GitHub does not specify an interval.
+const defaultEventPollInterval = time.Minute
+
+// PollEvents watches the events of a repository, calling handler once for
+// each new event, oldest first, until ctx is done or handler returns an
+// error, and returns that error. The events already listed when PollEvents
+// starts are passed to handler first.
+//
+// It waits between polls for as long as the X-Poll-Interval header of the
+// last response asks, and makes conditional requests using the ETag of the
+// last response, so that polls that find no new events do not count against
+// the rate limit. Only the latest page of events is fetched on each poll, so
+// events may be missed if more than 100 happen between two polls. An error
+// listing the events stops the polling and is returned.
+//
+// GitHub API docs: https://docs.github.com/rest/activity/events#list-repository-events
+//
+//meta:operation GET /repos/{owner}/{repo}/events
+func (s *ActivityService) PollEvents(ctx context.Context, owner, repo string, handler func(*Event) error) error {
+	u := fmt.Sprintf("repos/%v/%v/events?per_page=100", owner, repo)
+	interval := defaultEventPollInterval
+	var etag string
+	var seen map[string]bool
+
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+		}
+
+		req, err := s.client.NewRequest("GET", u, nil)
+		if err != nil {
+			return err
+		}
+		if etag != "" {
+			req.Header.Set("If-None-Match", etag)
+		}
+
+		var events []*Event
+		resp, err := s.client.Do(ctx, req, &events)
+		if resp != nil && resp.Header.Get(headerPollInterval) != "" {
+			interval = resp.PollInterval
+		}
+		switch {
+		case resp != nil && resp.StatusCode == http.StatusNotModified:
+		case err != nil:
+			return err
+		default:
+			etag = resp.Header.Get("ETag")
+			current := make(map[string]bool, len(events))
+			// Events are listed newest first.
+			for i := len(events) - 1; i >= 0; i-- {
+				e := events[i]
+				current[e.GetID()] = true
+				if seen[e.GetID()] {
+					continue
+				}
+				if err := handler(e); err != nil {
+					return err
+				}
+			}
+			seen = current
+		}
+
+		timer.Reset(interval)
+	}
+}
+
 // ListIssueEventsForRepository lists issue events for a repository.
 //
 // GitHub API docs: https://docs.github.com/rest/issues/events#list-issue-events-for-a-repository
@@ -68,7 +142,7 @@ func (s *ActivityService) ListRepositoryEvents(ctx context.Context, owner, repo
 //meta:operation GET /repos/{owner}/{repo}/issues/events
 func (s *ActivityService) ListIssueEventsForRepository(ctx context.Context, owner, repo string, opts *ListOptions) ([]*IssueEvent, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/issues/events", owner, repo)
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -94,7 +168,7 @@ func (s *ActivityService) ListIssueEventsForRepository(ctx context.Context, owne
 //meta:operation GET /networks/{owner}/{repo}/events
 func (s *ActivityService) ListEventsForRepoNetwork(ctx context.Context, owner, repo string, opts *ListOptions) ([]*Event, *Response, error) {
 	u := fmt.Sprintf("networks/%v/%v/events", owner, repo)
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -120,7 +194,7 @@ func (s *ActivityService) ListEventsForRepoNetwork(ctx context.Context, owner, r
 //meta:operation GET /orgs/{org}/events
 func (s *ActivityService) ListEventsForOrganization(ctx context.Context, org string, opts *ListOptions) ([]*Event, *Response, error) {
 	u := fmt.Sprintf("orgs/%v/events", org)
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -154,7 +228,7 @@ func (s *ActivityService) ListEventsPerformedByUser(ctx context.Context, user st
 	} else {
 		u = fmt.Sprintf("users/%v/events", user)
 	}
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -188,7 +262,7 @@ func (s *ActivityService) ListEventsReceivedByUser(ctx context.Context, user str
 	} else {
 		u = fmt.Sprintf("users/%v/received_events", user)
 	}
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -215,7 +289,7 @@ func (s *ActivityService) ListEventsReceivedByUser(ctx context.Context, user str
 //meta:operation GET /users/{username}/events/orgs/{org}
 func (s *ActivityService) ListUserEventsForOrganization(ctx context.Context, org, user string, opts *ListOptions) ([]*Event, *Response, error) {
 	u := fmt.Sprintf("users/%v/events/orgs/%v", user, org)
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -8,6 +8,7 @@ package github
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"testing"
@@ -97,6 +98,68 @@ func TestActivityService_ListRepositoryEvents_invalidOwner(t *testing.T) {
 	testURLParseError(t, err)
 }
 
+func TestActivityService_PollEvents(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	var polls int
+	mux.HandleFunc("/repos/o/r/events", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"per_page": "100"})
+		polls++
+		w.Header().Set(headerPollInterval, "0")
+		switch polls {
+		case 1:
+			testHeader(t, r, "If-None-Match", "")
+			w.Header().Set("ETag", `"a"`)
+			fmt.Fprint(w, `[{"id":"2"},{"id":"1"}]`)
+		case 2:
+			testHeader(t, r, "If-None-Match", `"a"`)
+			w.WriteHeader(http.StatusNotModified)
+		default:
+			testHeader(t, r, "If-None-Match", `"a"`)
+			w.Header().Set("ETag", `"b"`)
+			fmt.Fprint(w, `[{"id":"3"},{"id":"2"},{"id":"1"}]`)
+		}
+	})
+
+	errStop := errors.New("stop")
+	var got []string
+	ctx := context.Background()
+	err := client.Activity.PollEvents(ctx, "o", "r", func(e *Event) error {
+		got = append(got, e.GetID())
+		if e.GetID() == "3" {
+			return errStop
+		}
+		return nil
+	})
+	if !errors.Is(err, errStop) {
+		t.Errorf("Activity.PollEvents returned error %v, want %v", err, errStop)
+	}
+	if want := []string{"1", "2", "3"}; !cmp.Equal(got, want) {
+		t.Errorf("Activity.PollEvents passed events %v, want %v", got, want)
+	}
+	if polls != 3 {
+		t.Errorf("Activity.PollEvents polled %v times, want 3", polls)
+	}
+}
+
+func TestActivityService_PollEvents_error(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/events", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	ctx := context.Background()
+	err := client.Activity.PollEvents(ctx, "o", "r", func(*Event) error { return nil })
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) || errResp.Response.StatusCode != http.StatusNotFound {
+		t.Errorf("Activity.PollEvents returned error %v, want a 404 *ErrorResponse", err)
+	}
+}
+
 func TestActivityService_ListIssueEventsForRepository(t *testing.T) {
 	t.Parallel()
 	client, mux, _ := setup(t)
@@ -8,6 +8,9 @@ package github
 import (
 	"context"
 	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -28,6 +31,42 @@ type Notification struct {
 	URL        *string    `json:"url,omitempty"`
 }
 
+// SubjectRef returns the owner, repository and number of the issue or pull
+// request a notification is about, parsed from its subject's URL, or from its
+// latest comment's URL if the subject has none. ok is false if the
+// notification is not about an issue or pull request, such as a release or a
+// commit.
+func (n *Notification) SubjectRef() (owner, repo string, number int, ok bool) {
+	subject := n.GetSubject()
+	for _, s := range []string{subject.GetURL(), subject.GetLatestCommentURL()} {
+		if owner, repo, number, ok = parseIssueURL(s); ok {
+			return owner, repo, number, true
+		}
+	}
+	return "", "", 0, false
+}
+
+// parseIssueURL parses an API URL of the form
+// .../repos/{owner}/{repo}/issues/{number} or .../repos/{owner}/{repo}/pulls/{number}.
+func parseIssueURL(s string) (owner, repo string, number int, ok bool) {
+	u, err := url.Parse(s)
+	if err != nil {
+		return "", "", 0, false
+	}
+	parts := strings.Split(strings.Trim(u.Path, "/"), "/")
+	for i := 0; i+4 < len(parts); i++ {
+		if parts[i] != "repos" || (parts[i+3] != "issues" && parts[i+3] != "pulls") {
+			continue
+		}
+		number, err := strconv.Atoi(parts[i+4])
+		if err != nil {
+			return "", "", 0, false
+		}
+		return parts[i+1], parts[i+2], number, true
+	}
+	return "", "", 0, false
+}
+
 // NotificationSubject identifies the subject of a notification.
 type NotificationSubject struct {
 	Title            *string `json:"title,omitempty"`
@@ -54,7 +93,7 @@ type NotificationListOptions struct {
 //meta:operation GET /notifications
 func (s *ActivityService) ListNotifications(ctx context.Context, opts *NotificationListOptions) ([]*Notification, *Response, error) {
 	u := "notifications"
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -81,7 +120,7 @@ func (s *ActivityService) ListNotifications(ctx context.Context, opts *Notificat
 //meta:operation GET /repos/{owner}/{repo}/notifications
 func (s *ActivityService) ListRepositoryNotifications(ctx context.Context, owner, repo string, opts *NotificationListOptions) ([]*Notification, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/notifications", owner, repo)
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -178,6 +217,35 @@ func (s *ActivityService) MarkThreadRead(ctx context.Context, id string) (*Respo
 	return s.client.Do(ctx, req, nil)
 }
 
+// markThreadsReadConcurrency is how many threads MarkThreadsRead marks as
+// read at once.
+const markThreadsReadConcurrency = 4
+
+// MarkThreadsRead marks each of the specified threads as read, a few at a
+// time. It returns the errors of the threads that could not be marked, keyed
+// by thread ID, or nil if all were marked.
+//
+// GitHub API docs: https://docs.github.com/rest/activity/notifications#mark-a-thread-as-read
+//
+//meta:operation PATCH /notifications/threads/{thread_id}
+func (s *ActivityService) MarkThreadsRead(ctx context.Context, ids []string) map[string]error {
+	var failed map[string]error
+	markRead := func(ctx context.Context, id string) error {
+		_, err := s.MarkThreadRead(ctx, id)
+		return err
+	}
+	for i, err := range ForEachBounded(ctx, ids, markThreadsReadConcurrency, markRead) {
+		if err == nil {
+			continue
+		}
+		if failed == nil {
+			failed = make(map[string]error)
+		}
+		failed[ids[i]] = err
+	}
+	return failed
+}
+
 // MarkThreadDone marks the specified thread as done.
 // Marking a thread as "done" is equivalent to marking a notification in your notification inbox on GitHub as done.
 //
@@ -8,6 +8,7 @@ package github
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"testing"
@@ -208,6 +209,35 @@ func TestActivityService_MarkThreadRead(t *testing.T) {
 	})
 }
 
+func TestActivityService_MarkThreadsRead(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	for _, id := range []string{"1", "2", "3"} {
+		mux.HandleFunc("/notifications/threads/"+id, func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, "PATCH")
+			w.WriteHeader(http.StatusResetContent)
+		})
+	}
+	mux.HandleFunc("/notifications/threads/4", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	})
+
+	ctx := context.Background()
+	if failed := client.Activity.MarkThreadsRead(ctx, []string{"1", "2", "3"}); failed != nil {
+		t.Errorf("Activity.MarkThreadsRead returned %v, want nil", failed)
+	}
+
+	failed := client.Activity.MarkThreadsRead(ctx, []string{"1", "4"})
+	if len(failed) != 1 {
+		t.Fatalf("Activity.MarkThreadsRead returned %v, want one failure", failed)
+	}
+	var errResp *ErrorResponse
+	if !errors.As(failed["4"], &errResp) || errResp.Response.StatusCode != http.StatusForbidden {
+		t.Errorf("Activity.MarkThreadsRead returned error %v for 4, want 403 *ErrorResponse", failed["4"])
+	}
+}
+
 func TestActivityService_MarkThreadDone(t *testing.T) {
 	t.Parallel()
 	client, mux, _ := setup(t)
@@ -386,6 +416,51 @@ func TestNotification_Marshal(t *testing.T) {
 	testJSONMarshal(t, u, want)
 }
 
+func TestNotification_SubjectRef(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name       string
+		subject    *NotificationSubject
+		wantOwner  string
+		wantRepo   string
+		wantNumber int
+		wantOK     bool
+	}{
+		{name: "no subject"},
+		{
+			name:      "issue",
+			subject:   &NotificationSubject{URL: Ptr("https://api.github.com/repos/o/r/issues/12")},
+			wantOwner: "o", wantRepo: "r", wantNumber: 12, wantOK: true,
+		},
+		{
+			name:      "pull request on GHES",
+			subject:   &NotificationSubject{URL: Ptr("https://ghe.example.com/api/v3/repos/o/r/pulls/3")},
+			wantOwner: "o", wantRepo: "r", wantNumber: 3, wantOK: true,
+		},
+		{
+			name:      "latest comment",
+			subject:   &NotificationSubject{LatestCommentURL: Ptr("https://api.github.com/repos/o/r/issues/7")},
+			wantOwner: "o", wantRepo: "r", wantNumber: 7, wantOK: true,
+		},
+		{
+			name:    "release",
+			subject: &NotificationSubject{URL: Ptr("https://api.github.com/repos/o/r/releases/1")},
+		},
+		{
+			name:    "issue comment",
+			subject: &NotificationSubject{LatestCommentURL: Ptr("https://api.github.com/repos/o/r/issues/comments/1")},
+		},
+	}
+
+	for _, tt := range tests {
+		n := &Notification{Subject: tt.subject}
+		owner, repo, number, ok := n.SubjectRef()
+		if owner != tt.wantOwner || repo != tt.wantRepo || number != tt.wantNumber || ok != tt.wantOK {
+			t.Errorf("%v: SubjectRef returned (%q, %q, %v, %v), want (%q, %q, %v, %v)", tt.name, owner, repo, number, ok, tt.wantOwner, tt.wantRepo, tt.wantNumber, tt.wantOK)
+		}
+	}
+}
+
 func TestNotificationSubject_Marshal(t *testing.T) {
 	t.Parallel()
 	testJSONMarshal(t, &NotificationSubject{}, "{}")
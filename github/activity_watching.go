@@ -32,7 +32,7 @@ type Subscription struct {
 //meta:operation GET /repos/{owner}/{repo}/subscribers
 func (s *ActivityService) ListWatchers(ctx context.Context, owner, repo string, opts *ListOptions) ([]*User, *Response, error) {
 	u := fmt.Sprintf("repos/%s/%s/subscribers", owner, repo)
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -66,7 +66,7 @@ func (s *ActivityService) ListWatched(ctx context.Context, user string, opts *Li
 	} else {
 		u = "user/subscriptions"
 	}
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
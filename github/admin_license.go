@@ -0,0 +1,50 @@
+// Copyright 2026 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+)
+
+// LicenseInfo represents the license and seat usage of a GitHub Enterprise
+// Server installation.
+type LicenseInfo struct {
+	// Seats is the number of seats on the license. It is either a number
+	// or the string "unlimited".
+	Seats interface{} `json:"seats,omitempty"`
+	// SeatsAvailable is the number of unused seats. It is either a number
+	// or the string "unlimited".
+	SeatsAvailable      interface{} `json:"seats_available,omitempty"`
+	SeatsUsed           *int        `json:"seats_used,omitempty"`
+	Kind                *string     `json:"kind,omitempty"`
+	DaysUntilExpiration *int        `json:"days_until_expiration,omitempty"`
+	ExpireAt            *Timestamp  `json:"expire_at,omitempty"`
+}
+
+// GetLicense returns the license information of a GitHub Enterprise Server
+// installation, including how many seats are used and available.
+//
+// Please note that this is only available to site administrators,
+// otherwise it will error with a 404 not found (instead of 401 or 403).
+//
+// GitHub API docs: https://docs.github.com/enterprise-server@3.15/rest/enterprise-admin/license#get-license-information
+//
+//meta:operation GET /enterprise/settings/license
+func (s *AdminService) GetLicense(ctx context.Context) (*LicenseInfo, *Response, error) {
+	u := "enterprise/settings/license"
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	license := new(LicenseInfo)
+	resp, err := s.client.Do(ctx, req, license)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return license, resp, nil
+}
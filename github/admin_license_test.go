@@ -0,0 +1,109 @@
+// Copyright 2026 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestAdminService_GetLicense(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/enterprise/settings/license", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{
+			"seats": 1400,
+			"seats_used": 1316,
+			"seats_available": 84,
+			"kind": "standard",
+			"days_until_expiration": 365,
+			"expire_at": "2006-01-02T15:04:05Z"
+		}`)
+	})
+
+	ctx := context.Background()
+	license, _, err := client.Admin.GetLicense(ctx)
+	if err != nil {
+		t.Errorf("AdminService.GetLicense returned error: %v", err)
+	}
+
+	want := &LicenseInfo{
+		Seats:               float64(1400),
+		SeatsUsed:           Ptr(1316),
+		SeatsAvailable:      float64(84),
+		Kind:                Ptr("standard"),
+		DaysUntilExpiration: Ptr(365),
+		ExpireAt:            &Timestamp{referenceTime},
+	}
+	if !cmp.Equal(license, want) {
+		t.Errorf("AdminService.GetLicense returned %+v, want %+v", license, want)
+	}
+
+	const methodName = "GetLicense"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Admin.GetLicense(ctx)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestAdminService_GetLicense_unlimited(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/enterprise/settings/license", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"seats": "unlimited", "seats_used": 10, "seats_available": "unlimited"}`)
+	})
+
+	ctx := context.Background()
+	license, _, err := client.Admin.GetLicense(ctx)
+	if err != nil {
+		t.Errorf("AdminService.GetLicense returned error: %v", err)
+	}
+
+	want := &LicenseInfo{
+		Seats:          "unlimited",
+		SeatsUsed:      Ptr(10),
+		SeatsAvailable: "unlimited",
+	}
+	if !cmp.Equal(license, want) {
+		t.Errorf("AdminService.GetLicense returned %+v, want %+v", license, want)
+	}
+}
+
+func TestLicenseInfo_Marshal(t *testing.T) {
+	t.Parallel()
+	testJSONMarshal(t, &LicenseInfo{}, "{}")
+
+	u := &LicenseInfo{
+		Seats:               1400,
+		SeatsUsed:           Ptr(1316),
+		SeatsAvailable:      "unlimited",
+		Kind:                Ptr("standard"),
+		DaysUntilExpiration: Ptr(365),
+		ExpireAt:            &Timestamp{referenceTime},
+	}
+
+	want := `{
+		"seats": 1400,
+		"seats_used": 1316,
+		"seats_available": "unlimited",
+		"kind": "standard",
+		"days_until_expiration": 365,
+		"expire_at": ` + referenceTimeStr + `
+	}`
+
+	testJSONMarshal(t, u, want)
+}
@@ -7,9 +7,17 @@ package github
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
 )
 
+// ErrAppNotInstalled is wrapped, together with the *ErrorResponse, in the
+// error returned by the Find*Installation methods when the authenticated app
+// is not installed on the requested organization, repository, or user
+// account.
+var ErrAppNotInstalled = errors.New("app is not installed")
+
 // AppsService provides access to the installation related functions
 // in the GitHub API.
 //
@@ -154,6 +162,9 @@ type InstallationPermissions struct {
 }
 
 // InstallationRequest represents a pending GitHub App installation request.
+// Requests are listed by the app, with AppsService.ListInstallationRequests.
+// The REST API has no endpoints for organization owners to list, approve, or
+// deny them; that is only possible in the organization's settings.
 type InstallationRequest struct {
 	ID        *int64     `json:"id,omitempty"`
 	NodeID    *string    `json:"node_id,omitempty"`
@@ -244,7 +255,7 @@ func (s *AppsService) Get(ctx context.Context, appSlug string) (*App, *Response,
 //
 //meta:operation GET /app/installation-requests
 func (s *AppsService) ListInstallationRequests(ctx context.Context, opts *ListOptions) ([]*InstallationRequest, *Response, error) {
-	u, err := addOptions("app/installation-requests", opts)
+	u, err := s.client.addOptions("app/installation-requests", opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -269,7 +280,7 @@ func (s *AppsService) ListInstallationRequests(ctx context.Context, opts *ListOp
 //
 //meta:operation GET /app/installations
 func (s *AppsService) ListInstallations(ctx context.Context, opts *ListOptions) ([]*Installation, *Response, error) {
-	u, err := addOptions("app/installations", opts)
+	u, err := s.client.addOptions("app/installations", opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -303,7 +314,7 @@ func (s *AppsService) GetInstallation(ctx context.Context, id int64) (*Installat
 //
 //meta:operation GET /user/installations
 func (s *AppsService) ListUserInstallations(ctx context.Context, opts *ListOptions) ([]*Installation, *Response, error) {
-	u, err := addOptions("user/installations", opts)
+	u, err := s.client.addOptions("user/installations", opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -444,6 +455,7 @@ func (s *AppsService) CreateAttachment(ctx context.Context, contentReferenceID i
 }
 
 // FindOrganizationInstallation finds the organization's installation information.
+// It returns ErrAppNotInstalled if the app is not installed on the organization.
 //
 // GitHub API docs: https://docs.github.com/rest/apps/apps#get-an-organization-installation-for-the-authenticated-app
 //
@@ -453,6 +465,9 @@ func (s *AppsService) FindOrganizationInstallation(ctx context.Context, org stri
 }
 
 // FindRepositoryInstallation finds the repository's installation information.
+// This can be used to look up the installation ID needed by CreateInstallationToken
+// without listing every installation of the app. It returns ErrAppNotInstalled
+// if the app is not installed on the repository.
 //
 // GitHub API docs: https://docs.github.com/rest/apps/apps#get-a-repository-installation-for-the-authenticated-app
 //
@@ -462,6 +477,7 @@ func (s *AppsService) FindRepositoryInstallation(ctx context.Context, owner, rep
 }
 
 // FindRepositoryInstallationByID finds the repository's installation information.
+// It returns ErrAppNotInstalled if the app is not installed on the repository.
 //
 // Note: FindRepositoryInstallationByID uses the undocumented GitHub API endpoint "GET /repositories/{repository_id}/installation".
 //
@@ -471,6 +487,7 @@ func (s *AppsService) FindRepositoryInstallationByID(ctx context.Context, id int
 }
 
 // FindUserInstallation finds the user's installation information.
+// It returns ErrAppNotInstalled if the app is not installed on the user's account.
 //
 // GitHub API docs: https://docs.github.com/rest/apps/apps#get-a-user-installation-for-the-authenticated-app
 //
@@ -488,8 +505,25 @@ func (s *AppsService) getInstallation(ctx context.Context, url string) (*Install
 	i := new(Installation)
 	resp, err := s.client.Do(ctx, req, i)
 	if err != nil {
+		if isNotFound(err) {
+			err = fmt.Errorf("%w: %w", ErrAppNotInstalled, err)
+		}
 		return nil, resp, err
 	}
 
 	return i, resp, nil
 }
+
+// isNotFound reports whether err is an *ErrorResponse for a 404 Not Found response.
+func isNotFound(err error) bool {
+	return isErrorStatus(err, http.StatusNotFound)
+}
+
+// isErrorStatus reports whether err is an *ErrorResponse for a response with
+// the given status code.
+func isErrorStatus(err error, status int) bool {
+	var errorResponse *ErrorResponse
+	return errors.As(err, &errorResponse) &&
+		errorResponse.Response != nil &&
+		errorResponse.Response.StatusCode == status
+}
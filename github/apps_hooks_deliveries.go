@@ -16,7 +16,7 @@ import (
 //
 //meta:operation GET /app/hook/deliveries
 func (s *AppsService) ListHookDeliveries(ctx context.Context, opts *ListCursorOptions) ([]*HookDelivery, *Response, error) {
-	u, err := addOptions("app/hook/deliveries", opts)
+	u, err := s.client.addOptions("app/hook/deliveries", opts)
 	if err != nil {
 		return nil, nil, err
 	}
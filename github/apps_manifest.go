@@ -10,26 +10,34 @@ import (
 	"fmt"
 )
 
-// AppConfig describes the configuration of a GitHub App.
+// AppConfig describes the configuration of a GitHub App, including the
+// credentials returned when it is created from a manifest.
 type AppConfig struct {
-	ID            *int64     `json:"id,omitempty"`
-	Slug          *string    `json:"slug,omitempty"`
-	NodeID        *string    `json:"node_id,omitempty"`
-	Owner         *User      `json:"owner,omitempty"`
-	Name          *string    `json:"name,omitempty"`
-	Description   *string    `json:"description,omitempty"`
-	ExternalURL   *string    `json:"external_url,omitempty"`
-	HTMLURL       *string    `json:"html_url,omitempty"`
-	CreatedAt     *Timestamp `json:"created_at,omitempty"`
-	UpdatedAt     *Timestamp `json:"updated_at,omitempty"`
-	ClientID      *string    `json:"client_id,omitempty"`
-	ClientSecret  *string    `json:"client_secret,omitempty"`
-	WebhookSecret *string    `json:"webhook_secret,omitempty"`
-	PEM           *string    `json:"pem,omitempty"`
+	ID                 *int64                   `json:"id,omitempty"`
+	Slug               *string                  `json:"slug,omitempty"`
+	NodeID             *string                  `json:"node_id,omitempty"`
+	Owner              *User                    `json:"owner,omitempty"`
+	Name               *string                  `json:"name,omitempty"`
+	Description        *string                  `json:"description,omitempty"`
+	ExternalURL        *string                  `json:"external_url,omitempty"`
+	HTMLURL            *string                  `json:"html_url,omitempty"`
+	CreatedAt          *Timestamp               `json:"created_at,omitempty"`
+	UpdatedAt          *Timestamp               `json:"updated_at,omitempty"`
+	Permissions        *InstallationPermissions `json:"permissions,omitempty"`
+	Events             []string                 `json:"events,omitempty"`
+	InstallationsCount *int                     `json:"installations_count,omitempty"`
+	ClientID           *string                  `json:"client_id,omitempty"`
+	ClientSecret       *string                  `json:"client_secret,omitempty"`
+	WebhookSecret      *string                  `json:"webhook_secret,omitempty"`
+	// PEM is the app's PEM-encoded private key, used to sign the JWTs that
+	// authenticate as the app. It is only returned when the app is created.
+	PEM *string `json:"pem,omitempty"`
 }
 
 // CompleteAppManifest completes the App manifest handshake flow for the given
-// code.
+// code, creating the GitHub App and returning its configuration and
+// credentials. The code is the temporary one GitHub passes to the manifest's
+// redirect URL, and expires after one hour.
 //
 // GitHub API docs: https://docs.github.com/rest/apps/apps#create-a-github-app-from-a-manifest
 //
@@ -17,6 +17,10 @@ import (
 const (
 	manifestJSON = `{
 	"id": 1,
+  "slug": "s",
+  "permissions": {"contents": "read", "issues": "write"},
+  "events": ["push", "issues"],
+  "installations_count": 0,
   "client_id": "a" ,
   "client_secret": "b",
   "webhook_secret": "c",
@@ -41,11 +45,18 @@ func TestGetConfig(t *testing.T) {
 	}
 
 	want := &AppConfig{
-		ID:            Ptr(int64(1)),
-		ClientID:      Ptr("a"),
-		ClientSecret:  Ptr("b"),
-		WebhookSecret: Ptr("c"),
-		PEM:           Ptr("key"),
+		ID:   Ptr(int64(1)),
+		Slug: Ptr("s"),
+		Permissions: &InstallationPermissions{
+			Contents: Ptr("read"),
+			Issues:   Ptr("write"),
+		},
+		Events:             []string{"push", "issues"},
+		InstallationsCount: Ptr(0),
+		ClientID:           Ptr("a"),
+		ClientSecret:       Ptr("b"),
+		WebhookSecret:      Ptr("c"),
+		PEM:                Ptr("key"),
 	}
 
 	if !cmp.Equal(cfg, want) {
@@ -95,16 +106,21 @@ func TestAppConfig_Marshal(t *testing.T) {
 			CreatedAt:       &Timestamp{referenceTime},
 			SuspendedAt:     &Timestamp{referenceTime},
 		},
-		Name:          Ptr("n"),
-		Description:   Ptr("d"),
-		ExternalURL:   Ptr("eu"),
-		HTMLURL:       Ptr("hu"),
-		CreatedAt:     &Timestamp{referenceTime},
-		UpdatedAt:     &Timestamp{referenceTime},
-		ClientID:      Ptr("ci"),
-		ClientSecret:  Ptr("cs"),
-		WebhookSecret: Ptr("ws"),
-		PEM:           Ptr("pem"),
+		Name:        Ptr("n"),
+		Description: Ptr("d"),
+		ExternalURL: Ptr("eu"),
+		HTMLURL:     Ptr("hu"),
+		CreatedAt:   &Timestamp{referenceTime},
+		UpdatedAt:   &Timestamp{referenceTime},
+		Permissions: &InstallationPermissions{
+			Metadata: Ptr("read"),
+		},
+		Events:             []string{"push"},
+		InstallationsCount: Ptr(2),
+		ClientID:           Ptr("ci"),
+		ClientSecret:       Ptr("cs"),
+		WebhookSecret:      Ptr("ws"),
+		PEM:                Ptr("pem"),
 	}
 
 	want := `{
@@ -137,6 +153,11 @@ func TestAppConfig_Marshal(t *testing.T) {
 		"html_url": "hu",
 		"created_at": ` + referenceTimeStr + `,
 		"updated_at": ` + referenceTimeStr + `,
+		"permissions": {
+			"metadata": "read"
+		},
+		"events": ["push"],
+		"installations_count": 2,
 		"client_id": "ci",
 		"client_secret": "cs",
 		"webhook_secret": "ws",
@@ -96,7 +96,7 @@ type MarketplacePurchaseAccount struct {
 //meta:operation GET /marketplace_listing/stubbed/plans
 func (s *MarketplaceService) ListPlans(ctx context.Context, opts *ListOptions) ([]*MarketplacePlan, *Response, error) {
 	uri := s.marketplaceURI("plans")
-	u, err := addOptions(uri, opts)
+	u, err := s.client.addOptions(uri, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -124,7 +124,7 @@ func (s *MarketplaceService) ListPlans(ctx context.Context, opts *ListOptions) (
 //meta:operation GET /marketplace_listing/stubbed/plans/{plan_id}/accounts
 func (s *MarketplaceService) ListPlanAccountsForPlan(ctx context.Context, planID int64, opts *ListOptions) ([]*MarketplacePlanAccount, *Response, error) {
 	uri := s.marketplaceURI(fmt.Sprintf("plans/%v/accounts", planID))
-	u, err := addOptions(uri, opts)
+	u, err := s.client.addOptions(uri, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -180,7 +180,7 @@ func (s *MarketplaceService) ListMarketplacePurchasesForUser(ctx context.Context
 		uri = "user/marketplace_purchases/stubbed"
 	}
 
-	u, err := addOptions(uri, opts)
+	u, err := s.client.addOptions(uri, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -8,6 +8,7 @@ package github
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"testing"
@@ -652,6 +653,33 @@ func TestAppsService_FindRepositoryInstallation(t *testing.T) {
 	})
 }
 
+func TestAppsService_FindRepositoryInstallation_notInstalled(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/installation", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"message":"Not Found"}`)
+	})
+
+	ctx := context.Background()
+	installation, resp, err := client.Apps.FindRepositoryInstallation(ctx, "o", "r")
+	if !errors.Is(err, ErrAppNotInstalled) {
+		t.Errorf("Apps.FindRepositoryInstallation returned error %v, want %v", err, ErrAppNotInstalled)
+	}
+	if installation != nil {
+		t.Errorf("Apps.FindRepositoryInstallation returned %+v, want nil", installation)
+	}
+	var errorResponse *ErrorResponse
+	if !errors.As(err, &errorResponse) {
+		t.Errorf("Apps.FindRepositoryInstallation returned error %v, want it to wrap an *ErrorResponse", err)
+	}
+	if got, want := resp.StatusCode, http.StatusNotFound; got != want {
+		t.Errorf("Apps.FindRepositoryInstallation returned status %d, want %d", got, want)
+	}
+}
+
 func TestAppsService_FindRepositoryInstallationByID(t *testing.T) {
 	t.Parallel()
 	client, mux, _ := setup(t)
@@ -134,7 +134,7 @@ func (s *BillingService) GetStorageBillingOrg(ctx context.Context, org string) (
 //meta:operation GET /orgs/{org}/settings/billing/advanced-security
 func (s *BillingService) GetAdvancedSecurityActiveCommittersOrg(ctx context.Context, org string, opts *ListOptions) (*ActiveCommitters, *Response, error) {
 	u := fmt.Sprintf("orgs/%v/settings/billing/advanced-security", org)
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -0,0 +1,132 @@
+// Copyright 2026 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// MaxBodyLength is the maximum number of characters GitHub accepts in the body
+// of an issue, pull request, or comment. Longer bodies are rejected with a
+// 422 Unprocessable Entity response.
+const MaxBodyLength = 65536
+
+// SplitBody splits body into parts of at most max characters each, so that
+// each part can be posted as a separate issue or pull request comment. A max
+// of zero or less means MaxBodyLength.
+//
+// Parts end at paragraph boundaries (blank lines) where possible, otherwise
+// at line boundaries, and only split a line when it is longer than max on its
+// own. If a part ends inside a fenced code block, the block is closed at the
+// end of that part and reopened, with the same info string, at the start of
+// the next one, so that every part renders correctly on its own. Leading and
+// trailing newlines are removed from each part. A body that already fits is returned
+// as the only part, and an empty body yields no parts.
+func SplitBody(body string, max int) []string {
+	if max <= 0 {
+		max = MaxBodyLength
+	}
+	body = strings.TrimRight(body, "\n")
+	if body == "" {
+		return nil
+	}
+	if utf8.RuneCountInString(body) <= max {
+		return []string{body}
+	}
+
+	lines := strings.SplitAfter(body, "\n")
+	// fences[i] is the opening line of the code fence that is open after
+	// lines[i], or "" if none is.
+	fences := make([]string, len(lines))
+	var fence string
+	for i, line := range lines {
+		fence = nextFence(fence, line)
+		fences[i] = fence
+	}
+
+	var parts []string
+	for i := 0; i < len(lines); {
+		var prefix string
+		if i > 0 && fences[i-1] != "" {
+			prefix = strings.TrimRight(fences[i-1], "\n") + "\n"
+		}
+		size := utf8.RuneCountInString(prefix)
+
+		// Find the longest run of whole lines that fits, together with the
+		// closing fence it would need.
+		end, brk := i, 0
+		for j := i; j < len(lines); j++ {
+			size += utf8.RuneCountInString(lines[j])
+			if size+len(closingFence(fences[j])) > max {
+				break
+			}
+			end = j + 1
+			if fences[j] == "" && strings.TrimSpace(lines[j]) == "" {
+				brk = j + 1
+			}
+		}
+
+		if end == i {
+			// A single line is too long: split it, carrying the rest over.
+			room := max - utf8.RuneCountInString(prefix) - len(closingFence(fences[i]))
+			if room < 1 {
+				prefix, room = "", max
+			}
+			head, tail := splitRunes(lines[i], room)
+			parts = append(parts, prefix+strings.TrimRight(head, "\n")+closingFence(fences[i]))
+			lines[i] = tail
+			continue
+		}
+
+		if brk > i && end < len(lines) {
+			end = brk
+		}
+		part := prefix + strings.Trim(strings.Join(lines[i:end], ""), "\n") + closingFence(fences[end-1])
+		if strings.TrimSpace(part) != "" {
+			parts = append(parts, part)
+		}
+		i = end
+	}
+	return parts
+}
+
+// nextFence returns the code fence open after line, given the opening line
+// of the fence open before it, if any.
+func nextFence(open, line string) string {
+	trimmed := strings.TrimSpace(line)
+	if open == "" {
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			return line
+		}
+		return ""
+	}
+	marker := strings.TrimSpace(open)[:1]
+	if len(trimmed) >= 3 && strings.Trim(trimmed, marker) == "" {
+		return ""
+	}
+	return open
+}
+
+// closingFence returns the text that closes the code fence opened by open,
+// or "" if open is empty.
+func closingFence(open string) string {
+	if open == "" {
+		return ""
+	}
+	return "\n" + strings.TrimSpace(open)[:3]
+}
+
+// splitRunes splits s after its first n runes.
+func splitRunes(s string, n int) (string, string) {
+	for i := range s {
+		if n == 0 {
+			return s[:i], s[i:]
+		}
+		n--
+	}
+	return s, ""
+}
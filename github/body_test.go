@@ -0,0 +1,98 @@
+// Copyright 2026 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestSplitBody(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		body string
+		max  int
+		want []string
+	}{
+		{
+			name: "empty",
+			body: "\n",
+			max:  10,
+			want: nil,
+		},
+		{
+			name: "fits",
+			body: "short\n",
+			max:  10,
+			want: []string{"short"},
+		},
+		{
+			name: "paragraphs",
+			body: "aaaa\nbbbb\n\ncccc\n\ndddd",
+			max:  16,
+			want: []string{"aaaa\nbbbb", "cccc\n\ndddd"},
+		},
+		{
+			name: "lines",
+			body: "aaaa\nbbbb\ncccc",
+			max:  10,
+			want: []string{"aaaa\nbbbb", "cccc"},
+		},
+		{
+			name: "long line",
+			body: "ééééééééé\nb",
+			max:  4,
+			want: []string{"éééé", "éééé", "é\nb"},
+		},
+		{
+			name: "code fence",
+			body: "intro\n\n```go\nline1\nline2\nline3\n```\nafter",
+			max:  22,
+			want: []string{"intro", "```go\nline1\nline2\n```", "```go\nline3\n```\nafter"},
+		},
+		{
+			name: "blank lines inside fence are not paragraph breaks",
+			body: "~~~\na\n\nb\n~~~\n\nc",
+			max:  13,
+			want: []string{"~~~\na\n\nb\n~~~", "c"},
+		},
+	}
+
+	for _, tt := range tests {
+		got := SplitBody(tt.body, tt.max)
+		if !cmp.Equal(got, tt.want) {
+			t.Errorf("%v: SplitBody = %q, want %q", tt.name, got, tt.want)
+		}
+		for _, part := range got {
+			if n := utf8.RuneCountInString(part); n > tt.max {
+				t.Errorf("%v: part %q has %v characters, more than %v", tt.name, part, n, tt.max)
+			}
+		}
+	}
+}
+
+func TestSplitBody_defaultMax(t *testing.T) {
+	t.Parallel()
+	para := strings.Repeat("x", 1000) + "\n\n"
+	body := strings.Repeat(para, 100)
+
+	got := SplitBody(body, 0)
+	if len(got) != 2 {
+		t.Fatalf("SplitBody returned %v parts, want 2", len(got))
+	}
+	for _, part := range got {
+		if len(part) > MaxBodyLength {
+			t.Errorf("part has %v characters, more than %v", len(part), MaxBodyLength)
+		}
+		if strings.Count(part, "x")%1000 != 0 {
+			t.Errorf("part does not end at a paragraph boundary")
+		}
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright 2026 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// ForEachBounded calls fn for each of items, running at most concurrency calls
+// at a time (a concurrency of less than 1 is treated as 1). It is intended for
+// bulk operations for which GitHub has no batch endpoint, such as deleting many
+// package versions or artifacts.
+//
+// The returned slice has one entry per item, holding the error returned by fn
+// for that item, or nil. Once fn returns a *RateLimitError or
+// *AbuseRateLimitError, or ctx is done, no further calls are started; items
+// that were not started are assigned that error.
+func ForEachBounded[T any](ctx context.Context, items []T, concurrency int, fn func(ctx context.Context, item T) error) []error {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	errs := make([]error, len(items))
+	sem := make(chan struct{}, concurrency)
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		stopErr error
+	)
+	stopped := func() error {
+		mu.Lock()
+		defer mu.Unlock()
+		if stopErr != nil {
+			return stopErr
+		}
+		return ctx.Err()
+	}
+
+	for i, item := range items {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if err := stopped(); err != nil {
+			for j := i; j < len(items); j++ {
+				errs[j] = err
+			}
+			break
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			err := fn(ctx, item)
+			errs[i] = err
+			if isRateLimitError(err) {
+				mu.Lock()
+				if stopErr == nil {
+					stopErr = err
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	return errs
+}
+
+// isRateLimitError reports whether err is a primary or secondary rate limit error.
+func isRateLimitError(err error) bool {
+	var rateLimitErr *RateLimitError
+	var abuseErr *AbuseRateLimitError
+	return errors.As(err, &rateLimitErr) || errors.As(err, &abuseErr)
+}
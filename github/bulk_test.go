@@ -0,0 +1,103 @@
+// Copyright 2026 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestForEachBounded(t *testing.T) {
+	t.Parallel()
+	errOdd := errors.New("odd")
+	items := []int{0, 1, 2, 3, 4, 5, 6, 7}
+
+	var mu sync.Mutex
+	inFlight, maxInFlight := 0, 0
+	errs := ForEachBounded(context.Background(), items, 3, func(_ context.Context, i int) error {
+		mu.Lock()
+		inFlight++
+		maxInFlight = max(maxInFlight, inFlight)
+		mu.Unlock()
+
+		time.Sleep(5 * time.Millisecond)
+
+		mu.Lock()
+		inFlight--
+		mu.Unlock()
+		if i%2 == 1 {
+			return errOdd
+		}
+		return nil
+	})
+
+	if len(errs) != len(items) {
+		t.Fatalf("ForEachBounded returned %v errors, want %v", len(errs), len(items))
+	}
+	for i, err := range errs {
+		var want error
+		if i%2 == 1 {
+			want = errOdd
+		}
+		if err != want {
+			t.Errorf("errs[%v] = %v, want %v", i, err, want)
+		}
+	}
+	if maxInFlight > 3 {
+		t.Errorf("ForEachBounded ran %v calls at once, want at most 3", maxInFlight)
+	}
+}
+
+func TestForEachBounded_rateLimit(t *testing.T) {
+	t.Parallel()
+	rateErr := &RateLimitError{Message: "rate limited"}
+
+	var called []int
+	errs := ForEachBounded(context.Background(), []int{0, 1, 2, 3}, 0, func(_ context.Context, i int) error {
+		called = append(called, i)
+		if i == 1 {
+			return rateErr
+		}
+		return nil
+	})
+
+	if len(called) != 2 {
+		t.Errorf("ForEachBounded called fn for %v, want [0 1]", called)
+	}
+	want := []error{nil, rateErr, rateErr, rateErr}
+	for i := range want {
+		if errs[i] != want[i] {
+			t.Errorf("errs[%v] = %v, want %v", i, errs[i], want[i])
+		}
+	}
+}
+
+func TestForEachBounded_canceled(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithCancel(context.Background())
+
+	var called []int
+	errs := ForEachBounded(ctx, []int{0, 1, 2}, 1, func(_ context.Context, i int) error {
+		called = append(called, i)
+		cancel()
+		return nil
+	})
+
+	if len(called) != 1 {
+		t.Errorf("ForEachBounded called fn for %v, want [0]", called)
+	}
+	if errs[0] != nil {
+		t.Errorf("errs[0] = %v, want nil", errs[0])
+	}
+	for i := 1; i < len(errs); i++ {
+		if !errors.Is(errs[i], context.Canceled) {
+			t.Errorf("errs[%v] = %v, want %v", i, errs[i], context.Canceled)
+		}
+	}
+}
@@ -231,7 +231,7 @@ func (s *ChecksService) UpdateCheckRun(ctx context.Context, owner, repo string,
 //meta:operation GET /repos/{owner}/{repo}/check-runs/{check_run_id}/annotations
 func (s *ChecksService) ListCheckRunAnnotations(ctx context.Context, owner, repo string, checkRunID int64, opts *ListOptions) ([]*CheckRunAnnotation, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/check-runs/%v/annotations", owner, repo, checkRunID)
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -275,7 +275,7 @@ type ListCheckRunsResults struct {
 //meta:operation GET /repos/{owner}/{repo}/commits/{ref}/check-runs
 func (s *ChecksService) ListCheckRunsForRef(ctx context.Context, owner, repo, ref string, opts *ListCheckRunsOptions) (*ListCheckRunsResults, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/commits/%v/check-runs", owner, repo, refURLEscape(ref))
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -303,7 +303,7 @@ func (s *ChecksService) ListCheckRunsForRef(ctx context.Context, owner, repo, re
 //meta:operation GET /repos/{owner}/{repo}/check-suites/{check_suite_id}/check-runs
 func (s *ChecksService) ListCheckRunsCheckSuite(ctx context.Context, owner, repo string, checkSuiteID int64, opts *ListCheckRunsOptions) (*ListCheckRunsResults, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/check-suites/%v/check-runs", owner, repo, checkSuiteID)
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -363,7 +363,7 @@ type ListCheckSuiteResults struct {
 //meta:operation GET /repos/{owner}/{repo}/commits/{ref}/check-suites
 func (s *ChecksService) ListCheckSuitesForRef(ctx context.Context, owner, repo, ref string, opts *ListCheckSuiteOptions) (*ListCheckSuiteResults, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/commits/%v/check-suites", owner, repo, refURLEscape(ref))
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -385,9 +385,13 @@ func (s *ChecksService) ListCheckSuitesForRef(ctx context.Context, owner, repo,
 }
 
 // AutoTriggerCheck enables or disables automatic creation of CheckSuite events upon pushes to the repository.
+//
+// Both fields are required by SetCheckSuitePreferences. Setting is a pointer
+// so that Ptr(false), which disables automatic creation, is sent rather than
+// omitted.
 type AutoTriggerCheck struct {
 	AppID   *int64 `json:"app_id,omitempty"`  // The id of the GitHub App. (Required.)
-	Setting *bool  `json:"setting,omitempty"` // Set to "true" to enable automatic creation of CheckSuite events upon pushes to the repository, or "false" to disable them. Default: "true" (Required.)
+	Setting *bool  `json:"setting,omitempty"` // Set to true to enable automatic creation of CheckSuite events upon pushes to the repository, or false to disable them. (Required.)
 }
 
 // CheckSuitePreferenceOptions set options for check suite preferences for a repository.
@@ -407,11 +411,20 @@ type PreferenceList struct {
 }
 
 // SetCheckSuitePreferences changes the default automatic flow when creating check suites.
+// Preferences are only changed for the apps listed in opts.AutoTriggerChecks.
+// An error is returned, without sending a request, if any of them is missing
+// its AppID or Setting.
 //
 // GitHub API docs: https://docs.github.com/rest/checks/suites#update-repository-preferences-for-check-suites
 //
 //meta:operation PATCH /repos/{owner}/{repo}/check-suites/preferences
 func (s *ChecksService) SetCheckSuitePreferences(ctx context.Context, owner, repo string, opts CheckSuitePreferenceOptions) (*CheckSuitePreferenceResults, *Response, error) {
+	for i, check := range opts.AutoTriggerChecks {
+		if check == nil || check.AppID == nil || check.Setting == nil {
+			return nil, nil, fmt.Errorf("auto_trigger_checks[%v]: app_id and setting are required", i)
+		}
+	}
+
 	u := fmt.Sprintf("repos/%v/%v/check-suites/preferences", owner, repo)
 	req, err := s.client.NewRequest("PATCH", u, opts)
 	if err != nil {
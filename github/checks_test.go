@@ -559,6 +559,23 @@ func TestChecksService_SetCheckSuitePreferences(t *testing.T) {
 	})
 }
 
+func TestChecksService_SetCheckSuitePreferences_missingFields(t *testing.T) {
+	t.Parallel()
+	client, _, _ := setup(t)
+
+	ctx := context.Background()
+	for _, check := range []*AutoTriggerCheck{
+		nil,
+		{AppID: Ptr(int64(2))},
+		{Setting: Ptr(false)},
+	} {
+		opt := CheckSuitePreferenceOptions{AutoTriggerChecks: []*AutoTriggerCheck{check}}
+		if _, _, err := client.Checks.SetCheckSuitePreferences(ctx, "o", "r", opt); err == nil {
+			t.Errorf("Checks.SetCheckSuitePreferences(%+v) returned nil error", check)
+		}
+	}
+}
+
 func TestChecksService_CreateCheckSuite(t *testing.T) {
 	t.Parallel()
 	client, mux, _ := setup(t)
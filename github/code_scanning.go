@@ -242,7 +242,7 @@ type SarifID struct {
 //meta:operation GET /orgs/{org}/code-scanning/alerts
 func (s *CodeScanningService) ListAlertsForOrg(ctx context.Context, org string, opts *AlertListOptions) ([]*Alert, *Response, error) {
 	u := fmt.Sprintf("orgs/%v/code-scanning/alerts", org)
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -272,7 +272,7 @@ func (s *CodeScanningService) ListAlertsForOrg(ctx context.Context, org string,
 //meta:operation GET /repos/{owner}/{repo}/code-scanning/alerts
 func (s *CodeScanningService) ListAlertsForRepo(ctx context.Context, owner, repo string, opts *AlertListOptions) ([]*Alert, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/code-scanning/alerts", owner, repo)
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -355,7 +355,7 @@ func (s *CodeScanningService) UpdateAlert(ctx context.Context, owner, repo strin
 //meta:operation GET /repos/{owner}/{repo}/code-scanning/alerts/{alert_number}/instances
 func (s *CodeScanningService) ListAlertInstances(ctx context.Context, owner, repo string, id int64, opts *AlertInstancesListOptions) ([]*MostRecentInstance, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/code-scanning/alerts/%v/instances", owner, repo, id)
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -455,7 +455,7 @@ func (s *CodeScanningService) GetSARIF(ctx context.Context, owner, repo, sarifID
 //meta:operation GET /repos/{owner}/{repo}/code-scanning/analyses
 func (s *CodeScanningService) ListAnalysesForRepo(ctx context.Context, owner, repo string, opts *AnalysesListOptions) ([]*ScanningAnalysis, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/code-scanning/analyses", owner, repo)
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
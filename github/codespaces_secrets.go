@@ -20,7 +20,7 @@ import (
 //
 //meta:operation GET /user/codespaces/secrets
 func (s *CodespacesService) ListUserSecrets(ctx context.Context, opts *ListOptions) (*Secrets, *Response, error) {
-	u, err := addOptions("user/codespaces/secrets", opts)
+	u, err := s.client.addOptions("user/codespaces/secrets", opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -36,7 +36,7 @@ func (s *CodespacesService) ListUserSecrets(ctx context.Context, opts *ListOptio
 //meta:operation GET /orgs/{org}/codespaces/secrets
 func (s *CodespacesService) ListOrgSecrets(ctx context.Context, org string, opts *ListOptions) (*Secrets, *Response, error) {
 	u := fmt.Sprintf("orgs/%v/codespaces/secrets", org)
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -52,7 +52,7 @@ func (s *CodespacesService) ListOrgSecrets(ctx context.Context, org string, opts
 //meta:operation GET /repos/{owner}/{repo}/codespaces/secrets
 func (s *CodespacesService) ListRepoSecrets(ctx context.Context, owner, repo string, opts *ListOptions) (*Secrets, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/codespaces/secrets", owner, repo)
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -291,7 +291,7 @@ func (s *CodespacesService) deleteSecret(ctx context.Context, url string) (*Resp
 //meta:operation GET /user/codespaces/secrets/{secret_name}/repositories
 func (s *CodespacesService) ListSelectedReposForUserSecret(ctx context.Context, name string, opts *ListOptions) (*SelectedReposList, *Response, error) {
 	u := fmt.Sprintf("user/codespaces/secrets/%v/repositories", name)
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -308,7 +308,7 @@ func (s *CodespacesService) ListSelectedReposForUserSecret(ctx context.Context,
 //meta:operation GET /orgs/{org}/codespaces/secrets/{secret_name}/repositories
 func (s *CodespacesService) ListSelectedReposForOrgSecret(ctx context.Context, org, name string, opts *ListOptions) (*SelectedReposList, *Response, error) {
 	u := fmt.Sprintf("orgs/%v/codespaces/secrets/%v/repositories", org, name)
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -284,7 +284,7 @@ func (s *CopilotService) GetCopilotBilling(ctx context.Context, org string) (*Co
 //meta:operation GET /orgs/{org}/copilot/billing/seats
 func (s *CopilotService) ListCopilotSeats(ctx context.Context, org string, opts *ListOptions) (*ListCopilotSeatsResponse, *Response, error) {
 	u := fmt.Sprintf("orgs/%v/copilot/billing/seats", org)
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -312,7 +312,7 @@ func (s *CopilotService) ListCopilotSeats(ctx context.Context, org string, opts
 //meta:operation GET /enterprises/{enterprise}/copilot/billing/seats
 func (s *CopilotService) ListCopilotEnterpriseSeats(ctx context.Context, enterprise string, opts *ListOptions) (*ListCopilotSeatsResponse, *Response, error) {
 	u := fmt.Sprintf("enterprises/%v/copilot/billing/seats", enterprise)
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -472,7 +472,7 @@ func (s *CopilotService) GetSeatDetails(ctx context.Context, org, user string) (
 //meta:operation GET /enterprises/{enterprise}/copilot/metrics
 func (s *CopilotService) GetEnterpriseMetrics(ctx context.Context, enterprise string, opts *CopilotMetricsListOptions) ([]*CopilotMetrics, *Response, error) {
 	u := fmt.Sprintf("enterprises/%v/copilot/metrics", enterprise)
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -498,7 +498,7 @@ func (s *CopilotService) GetEnterpriseMetrics(ctx context.Context, enterprise st
 //meta:operation GET /enterprises/{enterprise}/team/{team_slug}/copilot/metrics
 func (s *CopilotService) GetEnterpriseTeamMetrics(ctx context.Context, enterprise, team string, opts *CopilotMetricsListOptions) ([]*CopilotMetrics, *Response, error) {
 	u := fmt.Sprintf("enterprises/%v/team/%v/copilot/metrics", enterprise, team)
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -524,7 +524,7 @@ func (s *CopilotService) GetEnterpriseTeamMetrics(ctx context.Context, enterpris
 //meta:operation GET /orgs/{org}/copilot/metrics
 func (s *CopilotService) GetOrganizationMetrics(ctx context.Context, org string, opts *CopilotMetricsListOptions) ([]*CopilotMetrics, *Response, error) {
 	u := fmt.Sprintf("orgs/%v/copilot/metrics", org)
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -550,7 +550,7 @@ func (s *CopilotService) GetOrganizationMetrics(ctx context.Context, org string,
 //meta:operation GET /orgs/{org}/team/{team_slug}/copilot/metrics
 func (s *CopilotService) GetOrganizationTeamMetrics(ctx context.Context, org, team string, opts *CopilotMetricsListOptions) ([]*CopilotMetrics, *Response, error) {
 	u := fmt.Sprintf("orgs/%v/team/%v/copilot/metrics", org, team)
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -94,7 +94,7 @@ type ListAlertsOptions struct {
 }
 
 func (s *DependabotService) listAlerts(ctx context.Context, url string, opts *ListAlertsOptions) ([]*DependabotAlert, *Response, error) {
-	u, err := addOptions(url, opts)
+	u, err := s.client.addOptions(url, opts)
 	if err != nil {
 		return nil, nil, err
 	}
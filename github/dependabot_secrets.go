@@ -46,7 +46,7 @@ func (s *DependabotService) GetOrgPublicKey(ctx context.Context, org string) (*P
 }
 
 func (s *DependabotService) listSecrets(ctx context.Context, url string, opts *ListOptions) (*Secrets, *Response, error) {
-	u, err := addOptions(url, opts)
+	u, err := s.client.addOptions(url, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -217,7 +217,7 @@ func (s *DependabotService) DeleteOrgSecret(ctx context.Context, org, name strin
 //meta:operation GET /orgs/{org}/dependabot/secrets/{secret_name}/repositories
 func (s *DependabotService) ListSelectedReposForOrgSecret(ctx context.Context, org, name string, opts *ListOptions) (*SelectedReposList, *Response, error) {
 	url := fmt.Sprintf("orgs/%v/dependabot/secrets/%v/repositories", org, name)
-	u, err := addOptions(url, opts)
+	u, err := s.client.addOptions(url, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -7,6 +7,7 @@ package github
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 )
 
@@ -34,6 +35,29 @@ type RepoDependencies struct {
 	FilesAnalyzed    *bool   `json:"filesAnalyzed,omitempty"`
 	LicenseConcluded *string `json:"licenseConcluded,omitempty"`
 	LicenseDeclared  *string `json:"licenseDeclared,omitempty"`
+	CopyrightText    *string `json:"copyrightText,omitempty"`
+	// ExternalRefs identifies the package outside the SBOM, typically by its
+	// package URL (purl).
+	ExternalRefs []*SBOMExternalRef `json:"externalRefs,omitempty"`
+}
+
+// SBOMExternalRef represents an external reference of a package in an SBOM,
+// such as its package URL.
+type SBOMExternalRef struct {
+	// ReferenceCategory is e.g. "PACKAGE-MANAGER" or "SECURITY".
+	ReferenceCategory *string `json:"referenceCategory,omitempty"`
+	// ReferenceType is e.g. "purl".
+	ReferenceType    *string `json:"referenceType,omitempty"`
+	ReferenceLocator *string `json:"referenceLocator,omitempty"`
+}
+
+// SBOMRelationship represents a relationship between two elements of an SBOM,
+// such as a repository depending on one of its packages.
+type SBOMRelationship struct {
+	SPDXElementID *string `json:"spdxElementId,omitempty"`
+	// RelationshipType is e.g. "DEPENDS_ON" or "DESCRIBES".
+	RelationshipType   *string `json:"relationshipType,omitempty"`
+	RelatedSPDXElement *string `json:"relatedSpdxElement,omitempty"`
 }
 
 // SBOMInfo represents a software bill of materials (SBOM) using SPDX.
@@ -53,6 +77,25 @@ type SBOMInfo struct {
 
 	// List of packages dependencies
 	Packages []*RepoDependencies `json:"packages,omitempty"`
+
+	// Relationships between the repository and its packages.
+	Relationships []*SBOMRelationship `json:"relationships,omitempty"`
+
+	// Raw is the SPDX document exactly as returned by GitHub, for fields not
+	// modeled above or for passing the document on to SPDX tooling. It is
+	// set when unmarshaling and ignored when marshaling.
+	Raw json.RawMessage `json:"-"`
+}
+
+func (s *SBOMInfo) UnmarshalJSON(data []byte) error {
+	type sbomInfoAlias SBOMInfo
+	var v sbomInfoAlias
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*s = SBOMInfo(v)
+	s.Raw = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 func (s SBOM) String() string {
@@ -7,6 +7,7 @@ package github
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"testing"
@@ -29,10 +30,26 @@ func TestDependencyGraphService_GetSBOM(t *testing.T) {
       "name":"owner/repo",
       "packages":[
                 {
+                "SPDXID":"SPDXRef-rubygems-rails",
                 "name":"rubygems:rails",
-                "versionInfo":"1.0.0"
+                "versionInfo":"1.0.0",
+                "externalRefs":[
+                    {
+                    "referenceCategory":"PACKAGE-MANAGER",
+                    "referenceType":"purl",
+                    "referenceLocator":"pkg:gem/rails@1.0.0"
+                    }
+                ]
                 }
-            ]
+            ],
+      "relationships":[
+                {
+                "spdxElementId":"SPDXRef-DOCUMENT",
+                "relationshipType":"DEPENDS_ON",
+                "relatedSpdxElement":"SPDXRef-rubygems-rails"
+                }
+            ],
+      "comment":"not modeled"
         }
     }`)
 	})
@@ -52,13 +69,37 @@ func TestDependencyGraphService_GetSBOM(t *testing.T) {
 			Name: Ptr("owner/repo"),
 			Packages: []*RepoDependencies{
 				{
+					SPDXID:      Ptr("SPDXRef-rubygems-rails"),
 					Name:        Ptr("rubygems:rails"),
 					VersionInfo: Ptr("1.0.0"),
+					ExternalRefs: []*SBOMExternalRef{
+						{
+							ReferenceCategory: Ptr("PACKAGE-MANAGER"),
+							ReferenceType:     Ptr("purl"),
+							ReferenceLocator:  Ptr("pkg:gem/rails@1.0.0"),
+						},
+					},
+				},
+			},
+			Relationships: []*SBOMRelationship{
+				{
+					SPDXElementID:      Ptr("SPDXRef-DOCUMENT"),
+					RelationshipType:   Ptr("DEPENDS_ON"),
+					RelatedSPDXElement: Ptr("SPDXRef-rubygems-rails"),
 				},
 			},
 		},
 	}
 
+	var raw map[string]interface{}
+	if err := json.Unmarshal(sbom.GetSBOM().Raw, &raw); err != nil {
+		t.Fatalf("SBOM.Raw is not valid JSON: %v", err)
+	}
+	if got, want := raw["comment"], "not modeled"; got != want {
+		t.Errorf("SBOM.Raw comment = %v, want %v", got, want)
+	}
+	sbom.SBOM.Raw = nil
+
 	if !cmp.Equal(sbom, want) {
 		t.Errorf("DependencyGraph.GetSBOM returned %+v, want %+v", sbom, want)
 	}
@@ -82,7 +82,7 @@ func (s *EnterpriseService) CreateRegistrationToken(ctx context.Context, enterpr
 //meta:operation GET /enterprises/{enterprise}/actions/runners
 func (s *EnterpriseService) ListRunners(ctx context.Context, enterprise string, opts *ListRunnersOptions) (*Runners, *Response, error) {
 	u := fmt.Sprintf("enterprises/%v/actions/runners", enterprise)
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
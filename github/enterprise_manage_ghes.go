@@ -120,7 +120,7 @@ func (s *EnterpriseService) ClusterStatus(ctx context.Context) (*ClusterStatus,
 //
 //meta:operation GET /manage/v1/replication/status
 func (s *EnterpriseService) ReplicationStatus(ctx context.Context, opts *NodeQueryOptions) (*ClusterStatus, *Response, error) {
-	u, err := addOptions("manage/v1/replication/status", opts)
+	u, err := s.client.addOptions("manage/v1/replication/status", opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -144,7 +144,7 @@ func (s *EnterpriseService) ReplicationStatus(ctx context.Context, opts *NodeQue
 //
 //meta:operation GET /manage/v1/version
 func (s *EnterpriseService) GetNodeReleaseVersions(ctx context.Context, opts *NodeQueryOptions) ([]*NodeReleaseVersion, *Response, error) {
-	u, err := addOptions("manage/v1/version", opts)
+	u, err := s.client.addOptions("manage/v1/version", opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -309,7 +309,7 @@ type NodeDetails struct {
 //
 //meta:operation GET /manage/v1/config/apply/events
 func (s *EnterpriseService) ConfigApplyEvents(ctx context.Context, opts *ConfigApplyEventsOptions) (*ConfigApplyEvents, *Response, error) {
-	u, err := addOptions("manage/v1/config/apply/events", opts)
+	u, err := s.client.addOptions("manage/v1/config/apply/events", opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -416,7 +416,7 @@ func (s *EnterpriseService) LicenseStatus(ctx context.Context) ([]*LicenseCheck,
 //
 //meta:operation GET /manage/v1/config/nodes
 func (s *EnterpriseService) NodeMetadata(ctx context.Context, opts *NodeQueryOptions) (*NodeMetadataStatus, *Response, error) {
-	u, err := addOptions("manage/v1/config/nodes", opts)
+	u, err := s.client.addOptions("manage/v1/config/nodes", opts)
 	if err != nil {
 		return nil, nil, err
 	}
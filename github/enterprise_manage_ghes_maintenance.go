@@ -50,7 +50,7 @@ type MaintenanceOptions struct {
 //
 //meta:operation GET /manage/v1/maintenance
 func (s *EnterpriseService) GetMaintenanceStatus(ctx context.Context, opts *NodeQueryOptions) ([]*MaintenanceStatus, *Response, error) {
-	u, err := addOptions("manage/v1/maintenance", opts)
+	u, err := s.client.addOptions("manage/v1/maintenance", opts)
 	if err != nil {
 		return nil, nil, err
 	}
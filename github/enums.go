@@ -0,0 +1,55 @@
+// Copyright 2026 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"fmt"
+	"strings"
+)
+
+// InvalidEnumError is returned, before any request is made, when a request
+// field that only accepts a fixed set of values is set to another value.
+type InvalidEnumError struct {
+	Field   string   // JSON name of the offending field.
+	Value   string   // Value the field was set to.
+	Allowed []string // Values accepted by the GitHub API.
+}
+
+func (e *InvalidEnumError) Error() string {
+	return fmt.Sprintf("invalid value %q for %v: must be one of %v", e.Value, e.Field, strings.Join(e.Allowed, ", "))
+}
+
+// validateEnum returns an *InvalidEnumError if value is neither empty nor one
+// of allowed.
+func validateEnum(field, value string, allowed ...string) error {
+	if value == "" {
+		return nil
+	}
+	for _, a := range allowed {
+		if value == a {
+			return nil
+		}
+	}
+	return &InvalidEnumError{Field: field, Value: value, Allowed: allowed}
+}
+
+// validateRepositoryEnums validates the enum fields of repo that are used by
+// the repository create and edit endpoints.
+func validateRepositoryEnums(repo *Repository) error {
+	if err := validateEnum("visibility", repo.GetVisibility(), "public", "private", "internal"); err != nil {
+		return err
+	}
+	if err := validateEnum("squash_merge_commit_title", repo.GetSquashMergeCommitTitle(), "PR_TITLE", "COMMIT_OR_PR_TITLE"); err != nil {
+		return err
+	}
+	if err := validateEnum("squash_merge_commit_message", repo.GetSquashMergeCommitMessage(), "PR_BODY", "COMMIT_MESSAGES", "BLANK"); err != nil {
+		return err
+	}
+	if err := validateEnum("merge_commit_title", repo.GetMergeCommitTitle(), "PR_TITLE", "MERGE_MESSAGE"); err != nil {
+		return err
+	}
+	return validateEnum("merge_commit_message", repo.GetMergeCommitMessage(), "PR_BODY", "PR_TITLE", "BLANK")
+}
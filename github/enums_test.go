@@ -0,0 +1,93 @@
+// Copyright 2026 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestInvalidEnumError_Error(t *testing.T) {
+	t.Parallel()
+	err := &InvalidEnumError{Field: "visibility", Value: "secret", Allowed: []string{"public", "private"}}
+	want := `invalid value "secret" for visibility: must be one of public, private`
+	if got := err.Error(); got != want {
+		t.Errorf("InvalidEnumError.Error returned %q, want %q", got, want)
+	}
+}
+
+func TestValidateEnum(t *testing.T) {
+	t.Parallel()
+	if err := validateEnum("f", "", "a", "b"); err != nil {
+		t.Errorf("validateEnum returned error for empty value: %v", err)
+	}
+	if err := validateEnum("f", "b", "a", "b"); err != nil {
+		t.Errorf("validateEnum returned error for allowed value: %v", err)
+	}
+
+	err := validateEnum("f", "B", "a", "b")
+	want := &InvalidEnumError{Field: "f", Value: "B", Allowed: []string{"a", "b"}}
+	if !cmp.Equal(err, want) {
+		t.Errorf("validateEnum returned %#v, want %#v", err, want)
+	}
+}
+
+func TestInvalidEnum_noRequest(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Unexpected request to %v with an invalid enum value", r.URL)
+	})
+
+	ctx := context.Background()
+	tests := []struct {
+		name  string
+		field string
+		call  func() error
+	}{
+		{
+			name:  "Repositories.Create",
+			field: "visibility",
+			call: func() error {
+				_, _, err := client.Repositories.Create(ctx, "", &Repository{Name: Ptr("n"), Visibility: Ptr("Private")})
+				return err
+			},
+		},
+		{
+			name:  "Repositories.Edit",
+			field: "squash_merge_commit_message",
+			call: func() error {
+				_, _, err := client.Repositories.Edit(ctx, "o", "r", &Repository{SquashMergeCommitMessage: Ptr("PR_MESSAGE")})
+				return err
+			},
+		},
+		{
+			name:  "PullRequests.Merge",
+			field: "merge_method",
+			call: func() error {
+				_, _, err := client.PullRequests.Merge(ctx, "o", "r", 1, "", &PullRequestOptions{MergeMethod: "sqaush"})
+				return err
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		var enumErr *InvalidEnumError
+		err := tt.call()
+		if !errors.As(err, &enumErr) {
+			t.Errorf("%v returned error %v, want *InvalidEnumError", tt.name, err)
+			continue
+		}
+		if enumErr.Field != tt.field {
+			t.Errorf("%v returned error for field %v, want %v", tt.name, enumErr.Field, tt.field)
+		}
+	}
+}
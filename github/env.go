@@ -0,0 +1,192 @@
+// Copyright 2026 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Environment variables read by NewClientFromEnv.
+const (
+	envToken             = "GITHUB_TOKEN"
+	envGHToken           = "GH_TOKEN"
+	envAPIURL            = "GITHUB_API_URL"
+	envAppID             = "GITHUB_APP_ID"
+	envAppPrivateKey     = "GITHUB_APP_PRIVATE_KEY"
+	envAppInstallationID = "GITHUB_APP_INSTALLATION_ID"
+)
+
+// NewClientFromEnv returns a new GitHub API client configured from the
+// environment, using the same variables GitHub Actions and the gh CLI use.
+//
+// If GITHUB_API_URL is set to anything other than https://api.github.com,
+// the client is pointed at that GitHub Enterprise Server instance, with the
+// upload URL derived from the same host.
+//
+// Credentials are chosen in this order:
+//
+//   - If GITHUB_APP_ID and GITHUB_APP_PRIVATE_KEY are set, the client
+//     authenticates as that GitHub App using a JWT signed with the PEM-encoded
+//     RSA private key. If GITHUB_APP_INSTALLATION_ID is also set, the client
+//     instead authenticates as that installation, exchanging the JWT for
+//     installation tokens as they expire. Setting only one of the two app
+//     variables, or setting GITHUB_APP_INSTALLATION_ID on its own, is an error.
+//   - Otherwise GH_TOKEN, then GITHUB_TOKEN, is used as a personal access
+//     token or Actions token.
+//   - Otherwise the client is unauthenticated.
+//
+// App credentials take precedence over tokens because GitHub Actions always
+// sets GITHUB_TOKEN, so explicitly configured app credentials should win.
+func NewClientFromEnv(ctx context.Context) (*Client, error) {
+	c := NewClient(nil)
+
+	if apiURL := os.Getenv(envAPIURL); apiURL != "" && strings.TrimSuffix(apiURL, "/") != strings.TrimSuffix(defaultBaseURL, "/") {
+		u, err := c.WithEnterpriseURLs(apiURL, enterpriseUploadURL(apiURL))
+		if err != nil {
+			return nil, fmt.Errorf("invalid %v: %w", envAPIURL, err)
+		}
+		c = u
+	}
+
+	appID, key := os.Getenv(envAppID), os.Getenv(envAppPrivateKey)
+	installation := os.Getenv(envAppInstallationID)
+	switch {
+	case appID != "" && key == "":
+		return nil, fmt.Errorf("%v is set but %v is not", envAppID, envAppPrivateKey)
+	case appID == "" && key != "":
+		return nil, fmt.Errorf("%v is set but %v is not", envAppPrivateKey, envAppID)
+	case appID == "" && installation != "":
+		return nil, fmt.Errorf("%v is set but %v and %v are not", envAppInstallationID, envAppID, envAppPrivateKey)
+	case appID != "":
+		return newAppClientFromEnv(ctx, c, appID, key, installation)
+	}
+
+	if token := os.Getenv(envGHToken); token != "" {
+		return c.WithAuthToken(token), nil
+	}
+	if token := os.Getenv(envToken); token != "" {
+		return c.WithAuthToken(token), nil
+	}
+	return c, nil
+}
+
+// enterpriseUploadURL derives the upload URL of a GitHub Enterprise Server
+// instance from its API URL.
+func enterpriseUploadURL(apiURL string) string {
+	u := strings.TrimSuffix(apiURL, "/")
+	if strings.HasSuffix(u, "/api/v3") {
+		return strings.TrimSuffix(u, "/api/v3") + "/api/uploads/"
+	}
+	return u
+}
+
+func newAppClientFromEnv(_ context.Context, c *Client, appID, key, installation string) (*Client, error) {
+	id, err := strconv.ParseInt(appID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %v %q: must be a numeric app ID", envAppID, appID)
+	}
+	// Keys stored in a single-line variable commonly have escaped newlines.
+	pk, err := parseRSAPrivateKey([]byte(strings.ReplaceAll(key, `\n`, "\n")))
+	if err != nil {
+		return nil, fmt.Errorf("invalid %v: %w", envAppPrivateKey, err)
+	}
+
+	appClient := c.WithTokenSource(&appTokenSource{appID: id, key: pk}, 0)
+	if installation == "" {
+		return appClient, nil
+	}
+
+	installationID, err := strconv.ParseInt(installation, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %v %q: must be a numeric installation ID", envAppInstallationID, installation)
+	}
+	return c.WithTokenSource(&installationTokenSource{apps: appClient.Apps, id: installationID}, 0), nil
+}
+
+// parseRSAPrivateKey parses a PEM-encoded RSA private key in either PKCS #1
+// or PKCS #8 form, as downloaded from the GitHub App settings page.
+func parseRSAPrivateKey(data []byte) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM-encoded private key found")
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("private key is a %T, want an RSA key", key)
+	}
+	return rsaKey, nil
+}
+
+// appJWTLifetime is how long the JWTs signed by appTokenSource are valid.
+// GitHub rejects JWTs that expire more than 10 minutes in the future.
+const appJWTLifetime = 9 * time.Minute
+
+// appTokenSource is a TokenSource that signs the JWTs used to authenticate
+// as a GitHub App.
+type appTokenSource struct {
+	appID int64
+	key   *rsa.PrivateKey
+}
+
+func (s *appTokenSource) Token(_ context.Context) (string, time.Time, error) {
+	now := time.Now()
+	// Backdate the issue time to allow for clock drift, as GitHub recommends.
+	iat, exp := now.Add(-time.Minute), now.Add(appJWTLifetime)
+
+	header, err := json.Marshal(map[string]string{"alg": "RS256", "typ": "JWT"})
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	claims, err := json.Marshal(map[string]int64{"iat": iat.Unix(), "exp": exp.Unix(), "iss": s.appID})
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	enc := base64.RawURLEncoding
+	signed := enc.EncodeToString(header) + "." + enc.EncodeToString(claims)
+	sum := sha256.Sum256([]byte(signed))
+	sig, err := rsa.SignPKCS1v15(rand.Reader, s.key, crypto.SHA256, sum[:])
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	return signed + "." + enc.EncodeToString(sig), exp, nil
+}
+
+// installationTokenSource is a TokenSource that creates installation access
+// tokens for a GitHub App installation.
+type installationTokenSource struct {
+	apps *AppsService
+	id   int64
+}
+
+func (s *installationTokenSource) Token(ctx context.Context) (string, time.Time, error) {
+	token, _, err := s.apps.CreateInstallationToken(ctx, s.id, nil)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	return token.GetToken(), token.GetExpiresAt().Time, nil
+}
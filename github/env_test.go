@@ -0,0 +1,259 @@
+// Copyright 2026 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// clearClientEnv unsets every variable read by NewClientFromEnv for the
+// duration of the test.
+func clearClientEnv(t *testing.T) {
+	t.Helper()
+	for _, name := range []string{envToken, envGHToken, envAPIURL, envAppID, envAppPrivateKey, envAppInstallationID} {
+		t.Setenv(name, "")
+	}
+}
+
+// envTestServer returns the API URL of a test server whose /api/v3/ handlers
+// are registered on the returned mux.
+func envTestServer(t *testing.T) (string, *http.ServeMux) {
+	t.Helper()
+	mux := http.NewServeMux()
+	server := httptest.NewServer(http.StripPrefix("/api/v3", mux))
+	t.Cleanup(server.Close)
+	return server.URL + "/api/v3", mux
+}
+
+func TestNewClientFromEnv_tokens(t *testing.T) {
+	tests := []struct {
+		name     string
+		ghToken  string
+		token    string
+		wantAuth string
+	}{
+		{name: "none"},
+		{name: "GITHUB_TOKEN", token: "t", wantAuth: "Bearer t"},
+		{name: "GH_TOKEN", ghToken: "g", wantAuth: "Bearer g"},
+		{name: "GH_TOKEN wins", ghToken: "g", token: "t", wantAuth: "Bearer g"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clearClientEnv(t)
+			apiURL, mux := envTestServer(t)
+			t.Setenv(envAPIURL, apiURL)
+			t.Setenv(envGHToken, tt.ghToken)
+			t.Setenv(envToken, tt.token)
+
+			mux.HandleFunc("/user", func(w http.ResponseWriter, r *http.Request) {
+				if got := r.Header.Get("Authorization"); got != tt.wantAuth {
+					t.Errorf("Authorization = %q, want %q", got, tt.wantAuth)
+				}
+				fmt.Fprint(w, `{"login":"l"}`)
+			})
+
+			client, err := NewClientFromEnv(context.Background())
+			if err != nil {
+				t.Fatalf("NewClientFromEnv returned error: %v", err)
+			}
+			if _, _, err := client.Users.Get(context.Background(), ""); err != nil {
+				t.Errorf("Users.Get returned error: %v", err)
+			}
+		})
+	}
+}
+
+func TestNewClientFromEnv_apiURL(t *testing.T) {
+	clearClientEnv(t)
+
+	t.Setenv(envAPIURL, "https://api.github.com")
+	client, err := NewClientFromEnv(context.Background())
+	if err != nil {
+		t.Fatalf("NewClientFromEnv returned error: %v", err)
+	}
+	if got, want := client.BaseURL.String(), defaultBaseURL; got != want {
+		t.Errorf("BaseURL = %v, want %v", got, want)
+	}
+
+	t.Setenv(envAPIURL, "https://ghe.example.com/api/v3")
+	client, err = NewClientFromEnv(context.Background())
+	if err != nil {
+		t.Fatalf("NewClientFromEnv returned error: %v", err)
+	}
+	if got, want := client.BaseURL.String(), "https://ghe.example.com/api/v3/"; got != want {
+		t.Errorf("BaseURL = %v, want %v", got, want)
+	}
+	if got, want := client.UploadURL.String(), "https://ghe.example.com/api/uploads/"; got != want {
+		t.Errorf("UploadURL = %v, want %v", got, want)
+	}
+}
+
+func TestNewClientFromEnv_invalidAppConfig(t *testing.T) {
+	tests := []struct {
+		name         string
+		appID        string
+		key          string
+		installation string
+		wantErr      string
+	}{
+		{name: "missing key", appID: "1", wantErr: "GITHUB_APP_ID is set but GITHUB_APP_PRIVATE_KEY is not"},
+		{name: "missing app ID", key: "k", wantErr: "GITHUB_APP_PRIVATE_KEY is set but GITHUB_APP_ID is not"},
+		{name: "installation only", installation: "2", wantErr: "GITHUB_APP_INSTALLATION_ID is set but"},
+		{name: "bad app ID", appID: "x", key: "k", wantErr: "invalid GITHUB_APP_ID"},
+		{name: "bad key", appID: "1", key: "k", wantErr: "invalid GITHUB_APP_PRIVATE_KEY"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clearClientEnv(t)
+			t.Setenv(envToken, "t")
+			t.Setenv(envAppID, tt.appID)
+			t.Setenv(envAppPrivateKey, tt.key)
+			t.Setenv(envAppInstallationID, tt.installation)
+
+			_, err := NewClientFromEnv(context.Background())
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("NewClientFromEnv returned error %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestNewClientFromEnv_app(t *testing.T) {
+	clearClientEnv(t)
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+
+	apiURL, mux := envTestServer(t)
+	t.Setenv(envAPIURL, apiURL)
+	t.Setenv(envToken, "t")
+	t.Setenv(envAppID, "42")
+	// Escaped newlines, as when the key is stored in a single-line secret.
+	t.Setenv(envAppPrivateKey, strings.ReplaceAll(string(keyPEM), "\n", `\n`))
+
+	mux.HandleFunc("/app", func(w http.ResponseWriter, r *http.Request) {
+		testAppJWT(t, r, &key.PublicKey, 42)
+		fmt.Fprint(w, `{"id":42}`)
+	})
+
+	client, err := NewClientFromEnv(context.Background())
+	if err != nil {
+		t.Fatalf("NewClientFromEnv returned error: %v", err)
+	}
+	if _, _, err := client.Apps.Get(context.Background(), ""); err != nil {
+		t.Errorf("Apps.Get returned error: %v", err)
+	}
+}
+
+func TestNewClientFromEnv_appInstallation(t *testing.T) {
+	clearClientEnv(t)
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+
+	apiURL, mux := envTestServer(t)
+	t.Setenv(envAPIURL, apiURL)
+	t.Setenv(envAppID, "42")
+	t.Setenv(envAppPrivateKey, string(keyPEM))
+	t.Setenv(envAppInstallationID, "7")
+
+	var exchanges int
+	mux.HandleFunc("/app/installations/7/access_tokens", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testAppJWT(t, r, &key.PublicKey, 42)
+		exchanges++
+		fmt.Fprint(w, `{"token":"inst","expires_at":"2999-01-01T00:00:00Z"}`)
+	})
+	mux.HandleFunc("/user/repos", func(w http.ResponseWriter, r *http.Request) {
+		if got, want := r.Header.Get("Authorization"), "Bearer inst"; got != want {
+			t.Errorf("Authorization = %q, want %q", got, want)
+		}
+		fmt.Fprint(w, `[]`)
+	})
+
+	client, err := NewClientFromEnv(context.Background())
+	if err != nil {
+		t.Fatalf("NewClientFromEnv returned error: %v", err)
+	}
+	for i := 0; i < 2; i++ {
+		if _, _, err := client.Repositories.List(context.Background(), "", nil); err != nil {
+			t.Errorf("Repositories.List returned error: %v", err)
+		}
+	}
+	if exchanges != 1 {
+		t.Errorf("installation token was created %v times, want 1", exchanges)
+	}
+}
+
+// testAppJWT checks that r is authenticated with a valid RS256 JWT signed by
+// pub and issued for appID.
+func testAppJWT(t *testing.T, r *http.Request, pub *rsa.PublicKey, appID int64) {
+	t.Helper()
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok {
+		t.Errorf("Authorization header %q is not a bearer token", r.Header.Get("Authorization"))
+		return
+	}
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		t.Errorf("JWT %q has %v parts, want 3", token, len(parts))
+		return
+	}
+
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		t.Errorf("decoding JWT signature: %v", err)
+		return
+	}
+	sum := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	if err := rsa.VerifyPKCS1v15(pub, crypto.SHA256, sum[:], sig); err != nil {
+		t.Errorf("JWT signature does not verify: %v", err)
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		t.Errorf("decoding JWT claims: %v", err)
+		return
+	}
+	var claims struct {
+		IAT int64 `json:"iat"`
+		EXP int64 `json:"exp"`
+		ISS int64 `json:"iss"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		t.Errorf("unmarshaling JWT claims: %v", err)
+		return
+	}
+	if claims.ISS != appID {
+		t.Errorf("JWT iss = %v, want %v", claims.ISS, appID)
+	}
+	if claims.EXP <= claims.IAT {
+		t.Errorf("JWT exp %v is not after iat %v", claims.EXP, claims.IAT)
+	}
+}
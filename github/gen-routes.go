@@ -0,0 +1,104 @@
+// Copyright 2026 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build ignore
+
+// gen-routes generates the list of path templates of the endpoints used by
+// the package, from the //meta:operation comments of its methods.
+//
+// It is meant to be used by go-github contributors in conjunction with the
+// go generate tool before sending a PR to GitHub.
+// Please see the CONTRIBUTING.md file for more information.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"text/template"
+)
+
+const filename = "github-routes.go"
+
+var sourceTmpl = template.Must(template.New("source").Parse(source))
+
+func main() {
+	files, err := filepath.Glob("*.go")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	seen := map[string]bool{}
+	var routes []string
+	for _, file := range files {
+		if strings.HasSuffix(file, "_test.go") || strings.HasPrefix(file, "gen-") || strings.HasPrefix(file, "github-") {
+			continue
+		}
+		f, err := os.Open(file)
+		if err != nil {
+			log.Fatal(err)
+		}
+		scanner := bufio.NewScanner(f)
+		for scanner.Scan() {
+			fields := strings.Fields(scanner.Text())
+			if len(fields) != 3 || fields[0] != "//meta:operation" {
+				continue
+			}
+			route := strings.TrimPrefix(fields[2], "/")
+			if !seen[route] {
+				seen[route] = true
+				routes = append(routes, route)
+			}
+		}
+		f.Close()
+		if err := scanner.Err(); err != nil {
+			log.Fatal(err)
+		}
+	}
+	slices.Sort(routes)
+
+	var buf bytes.Buffer
+	if err := sourceTmpl.Execute(&buf, routes); err != nil {
+		log.Fatal(err)
+	}
+	clean, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatalf("format.Source:\n%v\n%v", buf.String(), err)
+	}
+
+	if err := os.Chmod(filename, 0644); err != nil && !os.IsNotExist(err) {
+		log.Fatal(fmt.Errorf("os.Chmod(%q, 0644): %v", filename, err))
+	}
+	if err := os.WriteFile(filename, clean, 0444); err != nil {
+		log.Fatal(err)
+	}
+	if err := os.Chmod(filename, 0444); err != nil {
+		log.Fatal(fmt.Errorf("os.Chmod(%q, 0444): %v", filename, err))
+	}
+}
+
+const source = `// Copyright 2026 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by gen-routes; DO NOT EDIT.
+// Instead, please run "go generate ./..." as described here:
+// https://github.com/google/go-github/blob/master/CONTRIBUTING.md#submitting-a-patch
+
+package github
+
+// routes lists the path templates, relative to the API root, of the endpoints
+// used by the methods of this package.
+var routes = []string{
+{{range .}}	"{{.}}",
+{{end}}}
+`
@@ -48,6 +48,10 @@ type GistFile struct {
 	Type     *string `json:"type,omitempty"`
 	RawURL   *string `json:"raw_url,omitempty"`
 	Content  *string `json:"content,omitempty"`
+
+	// Truncated is true when Content holds only the start of a large file.
+	// The whole file can be fetched from RawURL.
+	Truncated *bool `json:"truncated,omitempty"`
 }
 
 func (g GistFile) String() string {
@@ -108,7 +112,7 @@ func (s *GistsService) List(ctx context.Context, user string, opts *GistListOpti
 	} else {
 		u = "gists"
 	}
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -133,7 +137,7 @@ func (s *GistsService) List(ctx context.Context, user string, opts *GistListOpti
 //
 //meta:operation GET /gists/public
 func (s *GistsService) ListAll(ctx context.Context, opts *GistListOptions) ([]*Gist, *Response, error) {
-	u, err := addOptions("gists/public", opts)
+	u, err := s.client.addOptions("gists/public", opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -158,7 +162,7 @@ func (s *GistsService) ListAll(ctx context.Context, opts *GistListOptions) ([]*G
 //
 //meta:operation GET /gists/starred
 func (s *GistsService) ListStarred(ctx context.Context, opts *GistListOptions) ([]*Gist, *Response, error) {
-	u, err := addOptions("gists/starred", opts)
+	u, err := s.client.addOptions("gists/starred", opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -268,7 +272,7 @@ func (s *GistsService) Edit(ctx context.Context, id string, gist *Gist) (*Gist,
 //meta:operation GET /gists/{gist_id}/commits
 func (s *GistsService) ListCommits(ctx context.Context, id string, opts *ListOptions) ([]*GistCommit, *Response, error) {
 	u := fmt.Sprintf("gists/%v/commits", id)
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -377,7 +381,7 @@ func (s *GistsService) Fork(ctx context.Context, id string) (*Gist, *Response, e
 //meta:operation GET /gists/{gist_id}/forks
 func (s *GistsService) ListForks(ctx context.Context, id string, opts *ListOptions) ([]*GistFork, *Response, error) {
 	u := fmt.Sprintf("gists/%v/forks", id)
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -1026,12 +1026,13 @@ func TestGistFile_Marshal(t *testing.T) {
 	testJSONMarshal(t, &GistFile{}, "{}")
 
 	u := &GistFile{
-		Size:     Ptr(1),
-		Filename: Ptr("fn"),
-		Language: Ptr("lan"),
-		Type:     Ptr("type"),
-		RawURL:   Ptr("rurl"),
-		Content:  Ptr("con"),
+		Size:      Ptr(1),
+		Filename:  Ptr("fn"),
+		Language:  Ptr("lan"),
+		Type:      Ptr("type"),
+		RawURL:    Ptr("rurl"),
+		Content:   Ptr("con"),
+		Truncated: Ptr(true),
 	}
 
 	want := `{
@@ -1040,7 +1041,8 @@ func TestGistFile_Marshal(t *testing.T) {
 		"language": "lan",
 		"type": "type",
 		"raw_url": "rurl",
-		"content": "con"
+		"content": "con",
+		"truncated": true
 	}`
 
 	testJSONMarshal(t, u, want)
@@ -8,7 +8,11 @@ package github
 import (
 	"bytes"
 	"context"
+	"encoding/base64"
 	"fmt"
+	"io"
+	"os"
+	"strings"
 )
 
 // Blob represents a blob object.
@@ -86,3 +90,75 @@ func (s *GitService) CreateBlob(ctx context.Context, owner string, repo string,
 
 	return t, resp, nil
 }
+
+// CreateBlobFromReader creates a blob object with the contents read from r.
+// The contents are base64-encoded as they are streamed into the request body,
+// so they are never held in memory in full. If r reports its size, through a
+// Len method as on *bytes.Reader or a Stat method as on *os.File, the request
+// is sent with a Content-Length; otherwise it is sent chunked.
+//
+// GitHub API docs: https://docs.github.com/rest/git/blobs#create-a-blob
+//
+//meta:operation POST /repos/{owner}/{repo}/git/blobs
+func (s *GitService) CreateBlobFromReader(ctx context.Context, owner, repo string, r io.Reader) (*Blob, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/git/blobs", owner, repo)
+	req, err := s.client.NewRequest("POST", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	const (
+		prefix = `{"content":"`
+		suffix = `","encoding":"base64"}`
+	)
+
+	pr, pw := io.Pipe()
+	defer pr.Close()
+	go func() {
+		enc := base64.NewEncoder(base64.StdEncoding, pw)
+		_, err := io.Copy(enc, r)
+		if err == nil {
+			err = enc.Close()
+		}
+		pw.CloseWithError(err)
+	}()
+
+	req.Body = io.NopCloser(io.MultiReader(strings.NewReader(prefix), pr, strings.NewReader(suffix)))
+	req.ContentLength = -1
+	if size, ok := readerSize(r); ok {
+		req.ContentLength = int64(len(prefix)) + int64(base64.StdEncoding.EncodedLen(int(size))) + int64(len(suffix))
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	blob := new(Blob)
+	resp, err := s.client.Do(ctx, req, blob)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return blob, resp, nil
+}
+
+// readerSize returns the number of bytes remaining to be read from r, if r
+// reports it.
+func readerSize(r io.Reader) (int64, bool) {
+	switch r := r.(type) {
+	case interface{ Len() int }:
+		return int64(r.Len()), true
+	case interface{ Stat() (os.FileInfo, error) }:
+		fi, err := r.Stat()
+		if err != nil || !fi.Mode().IsRegular() {
+			return 0, false
+		}
+		size := fi.Size()
+		if seeker, ok := r.(io.Seeker); ok {
+			offset, err := seeker.Seek(0, io.SeekCurrent)
+			if err != nil {
+				return 0, false
+			}
+			size -= offset
+		}
+		return size, true
+	}
+	return 0, false
+}
@@ -8,9 +8,12 @@ package github
 import (
 	"bytes"
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -171,6 +174,65 @@ func TestGitService_CreateBlob_invalidOwner(t *testing.T) {
 	testURLParseError(t, err)
 }
 
+func TestGitService_CreateBlobFromReader(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	content := "blob content\x00\xff"
+	mux.HandleFunc("/repos/o/r/git/blobs", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, "Content-Type", "application/json")
+
+		body, err := io.ReadAll(r.Body)
+		assertNilError(t, err)
+		if r.ContentLength != -1 && r.ContentLength != int64(len(body)) {
+			t.Errorf("Content-Length = %v, want %v", r.ContentLength, len(body))
+		}
+
+		v := new(Blob)
+		assertNilError(t, json.Unmarshal(body, v))
+		want := &Blob{
+			Content:  Ptr(base64.StdEncoding.EncodeToString([]byte(content))),
+			Encoding: Ptr("base64"),
+		}
+		if !cmp.Equal(v, want) {
+			t.Errorf("Git.CreateBlobFromReader request body: %+v, want %+v", v, want)
+		}
+
+		fmt.Fprintf(w, `{"sha":"s","size":%v}`, len(content))
+	})
+
+	ctx := context.Background()
+	readers := []struct {
+		name          string
+		r             io.Reader
+		contentLength bool
+	}{
+		{"strings.Reader", strings.NewReader(content), true},
+		{"os.File", openTestFile(t, "blob", content), true},
+		{"unsized", io.MultiReader(strings.NewReader(content)), false},
+	}
+	for _, tt := range readers {
+		blob, resp, err := client.Git.CreateBlobFromReader(ctx, "o", "r", tt.r)
+		if err != nil {
+			t.Errorf("Git.CreateBlobFromReader(%v) returned error: %v", tt.name, err)
+			continue
+		}
+		if want := (&Blob{SHA: Ptr("s"), Size: Ptr(len(content))}); !cmp.Equal(blob, want) {
+			t.Errorf("Git.CreateBlobFromReader(%v) returned %+v, want %+v", tt.name, blob, want)
+		}
+		if got := resp.Request.ContentLength > 0; got != tt.contentLength {
+			t.Errorf("Git.CreateBlobFromReader(%v) sent Content-Length %v", tt.name, resp.Request.ContentLength)
+		}
+	}
+
+	const methodName = "CreateBlobFromReader"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Git.CreateBlobFromReader(ctx, "\n", "\n", strings.NewReader(content))
+		return err
+	})
+}
+
 func TestBlob_Marshal(t *testing.T) {
 	t.Parallel()
 	testJSONMarshal(t, &Blob{}, "{}")
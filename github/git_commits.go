@@ -24,6 +24,9 @@ type SignatureVerification struct {
 
 // MessageSigner is used by GitService.CreateCommit to sign a commit.
 //
+// The github.com/google/go-github/v69/commitsign package provides
+// MessageSigners for armored OpenPGP and SSH private keys.
+//
 // To create a MessageSigner that signs a commit with a [golang.org/x/crypto/openpgp.Entity],
 // or [github.com/ProtonMail/go-crypto/openpgp.Entity], use:
 //
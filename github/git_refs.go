@@ -7,7 +7,9 @@ package github
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
 	"net/url"
 	"strings"
 )
@@ -47,7 +49,19 @@ type updateRefRequest struct {
 	Force *bool   `json:"force"`
 }
 
+// UpdateRefRequest describes a non-forced update of a reference, as used by
+// UpdateRefWithRetry.
+type UpdateRefRequest struct {
+	// Ref is the name of the reference to update, such as "heads/main" or
+	// "refs/heads/main".
+	Ref string
+	// SHA is the commit SHA the reference should point to.
+	SHA string
+}
+
 // GetRef fetches a single reference in a repository.
+// If the repository is empty, the returned *ErrorResponse matches
+// ErrEmptyRepository.
 //
 // GitHub API docs: https://docs.github.com/rest/git/refs#get-a-reference
 //
@@ -63,7 +77,7 @@ func (s *GitService) GetRef(ctx context.Context, owner string, repo string, ref
 	r := new(Reference)
 	resp, err := s.client.Do(ctx, req, r)
 	if err != nil {
-		return nil, resp, err
+		return nil, resp, markEmptyRepository(err, http.StatusConflict)
 	}
 
 	return r, resp, nil
@@ -89,6 +103,8 @@ type ReferenceListOptions struct {
 
 // ListMatchingRefs lists references in a repository that match a supplied ref.
 // Use an empty ref to list all references.
+// If the repository is empty, the returned *ErrorResponse matches
+// ErrEmptyRepository.
 //
 // GitHub API docs: https://docs.github.com/rest/git/refs#list-matching-references
 //
@@ -99,7 +115,7 @@ func (s *GitService) ListMatchingRefs(ctx context.Context, owner, repo string, o
 		ref = strings.TrimPrefix(opts.Ref, "refs/")
 	}
 	u := fmt.Sprintf("repos/%v/%v/git/matching-refs/%v", owner, repo, refURLEscape(ref))
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -112,7 +128,7 @@ func (s *GitService) ListMatchingRefs(ctx context.Context, owner, repo string, o
 	var rs []*Reference
 	resp, err := s.client.Do(ctx, req, &rs)
 	if err != nil {
-		return nil, resp, err
+		return nil, resp, markEmptyRepository(err, http.StatusConflict)
 	}
 
 	return rs, resp, nil
@@ -168,6 +184,52 @@ func (s *GitService) UpdateRef(ctx context.Context, owner string, repo string, r
 	return r, resp, nil
 }
 
+// UpdateRefWithRetry updates an existing ref without forcing it. If GitHub
+// rejects the update because it is not a fast forward (i.e. the ref moved
+// since ref was built), it fetches the SHA the ref currently points to, calls
+// rebuild with it to compute a new request, and tries again. At most attempts
+// updates are made; a value less than 1 is treated as 1. Each retry also
+// consumes the client's retry budget, if any (see Client.WithRetryBudget).
+// Errors returned by rebuild are returned as-is.
+//
+// GitHub API docs: https://docs.github.com/rest/git/refs#get-a-reference
+// GitHub API docs: https://docs.github.com/rest/git/refs#update-a-reference
+//
+//meta:operation GET /repos/{owner}/{repo}/git/ref/{ref}
+//meta:operation PATCH /repos/{owner}/{repo}/git/refs/{ref}
+func (s *GitService) UpdateRefWithRetry(ctx context.Context, owner, repo string, ref UpdateRefRequest, rebuild func(currentSHA string) (UpdateRefRequest, error), attempts int) (*Reference, *Response, error) {
+	for attempt := 1; ; attempt++ {
+		r, resp, err := s.UpdateRef(ctx, owner, repo, &Reference{
+			Ref:    Ptr(ref.Ref),
+			Object: &GitObject{SHA: Ptr(ref.SHA)},
+		}, false)
+		if err == nil || attempt >= attempts || !isNotFastForward(err) || !s.client.allowRetry() {
+			return r, resp, err
+		}
+
+		current, resp, err := s.GetRef(ctx, owner, repo, ref.Ref)
+		if err != nil {
+			return nil, resp, err
+		}
+
+		ref, err = rebuild(current.GetObject().GetSHA())
+		if err != nil {
+			return nil, resp, err
+		}
+	}
+}
+
+// isNotFastForward reports whether err is GitHub rejecting a non-forced ref
+// update because the new SHA is not a descendant of the current one.
+func isNotFastForward(err error) bool {
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) || errResp.Response == nil {
+		return false
+	}
+	return errResp.Response.StatusCode == http.StatusUnprocessableEntity &&
+		strings.Contains(strings.ToLower(errResp.Message), "fast forward")
+}
+
 // DeleteRef deletes a ref from a repository.
 //
 // GitHub API docs: https://docs.github.com/rest/git/refs#delete-a-reference
@@ -8,6 +8,7 @@ package github
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"strings"
@@ -552,6 +553,135 @@ func TestGitService_UpdateRef(t *testing.T) {
 	})
 }
 
+func TestGitService_UpdateRefWithRetry(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	var patches []string
+	mux.HandleFunc("/repos/o/r/git/refs/heads/b", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		v := new(updateRefRequest)
+		assertNilError(t, json.NewDecoder(r.Body).Decode(v))
+		if *v.Force {
+			t.Error("Request body force = true, want false")
+		}
+		patches = append(patches, *v.SHA)
+		if len(patches) == 1 {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			fmt.Fprint(w, `{"message":"Update is not a fast forward"}`)
+			return
+		}
+		fmt.Fprintf(w, `{"ref":"refs/heads/b","object":{"sha":%q}}`, *v.SHA)
+	})
+	mux.HandleFunc("/repos/o/r/git/ref/heads/b", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"ref":"refs/heads/b","object":{"sha":"moved"}}`)
+	})
+
+	var gotCurrent string
+	rebuild := func(currentSHA string) (UpdateRefRequest, error) {
+		gotCurrent = currentSHA
+		return UpdateRefRequest{Ref: "refs/heads/b", SHA: "rebuilt"}, nil
+	}
+
+	ctx := context.Background()
+	ref, _, err := client.Git.UpdateRefWithRetry(ctx, "o", "r", UpdateRefRequest{Ref: "refs/heads/b", SHA: "first"}, rebuild, 3)
+	if err != nil {
+		t.Fatalf("Git.UpdateRefWithRetry returned error: %v", err)
+	}
+
+	if gotCurrent != "moved" {
+		t.Errorf("rebuild called with %q, want %q", gotCurrent, "moved")
+	}
+	if want := []string{"first", "rebuilt"}; !cmp.Equal(patches, want) {
+		t.Errorf("Git.UpdateRefWithRetry sent SHAs %v, want %v", patches, want)
+	}
+	want := &Reference{Ref: Ptr("refs/heads/b"), Object: &GitObject{SHA: Ptr("rebuilt")}}
+	if !cmp.Equal(ref, want) {
+		t.Errorf("Git.UpdateRefWithRetry returned %+v, want %+v", ref, want)
+	}
+}
+
+func TestGitService_UpdateRefWithRetry_attemptsExhausted(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	patches := 0
+	mux.HandleFunc("/repos/o/r/git/refs/heads/b", func(w http.ResponseWriter, r *http.Request) {
+		patches++
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		fmt.Fprint(w, `{"message":"Update is not a fast forward"}`)
+	})
+	mux.HandleFunc("/repos/o/r/git/ref/heads/b", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"ref":"refs/heads/b","object":{"sha":"moved"}}`)
+	})
+
+	rebuild := func(currentSHA string) (UpdateRefRequest, error) {
+		return UpdateRefRequest{Ref: "heads/b", SHA: currentSHA}, nil
+	}
+
+	ctx := context.Background()
+	_, resp, err := client.Git.UpdateRefWithRetry(ctx, "o", "r", UpdateRefRequest{Ref: "heads/b", SHA: "s"}, rebuild, 2)
+	if err == nil {
+		t.Fatal("Git.UpdateRefWithRetry returned nil error, want error")
+	}
+	if resp.StatusCode != http.StatusUnprocessableEntity {
+		t.Errorf("Git.UpdateRefWithRetry returned status %v, want %v", resp.StatusCode, http.StatusUnprocessableEntity)
+	}
+	if patches != 2 {
+		t.Errorf("Git.UpdateRefWithRetry made %v updates, want 2", patches)
+	}
+}
+
+func TestGitService_UpdateRefWithRetry_noRetry(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	patches := 0
+	mux.HandleFunc("/repos/o/r/git/refs/heads/b", func(w http.ResponseWriter, r *http.Request) {
+		patches++
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		fmt.Fprint(w, `{"message":"Reference does not exist"}`)
+	})
+
+	rebuild := func(string) (UpdateRefRequest, error) {
+		t.Error("rebuild called, want no retry")
+		return UpdateRefRequest{}, nil
+	}
+
+	ctx := context.Background()
+	if _, _, err := client.Git.UpdateRefWithRetry(ctx, "o", "r", UpdateRefRequest{Ref: "heads/b", SHA: "s"}, rebuild, 3); err == nil {
+		t.Error("Git.UpdateRefWithRetry returned nil error, want error")
+	}
+	if patches != 1 {
+		t.Errorf("Git.UpdateRefWithRetry made %v updates, want 1", patches)
+	}
+}
+
+func TestGitService_UpdateRefWithRetry_rebuildError(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/git/refs/heads/b", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		fmt.Fprint(w, `{"message":"Update is not a fast forward"}`)
+	})
+	mux.HandleFunc("/repos/o/r/git/ref/heads/b", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"ref":"refs/heads/b","object":{"sha":"moved"}}`)
+	})
+
+	errRebuild := errors.New("rebuild failed")
+	rebuild := func(string) (UpdateRefRequest, error) {
+		return UpdateRefRequest{}, errRebuild
+	}
+
+	ctx := context.Background()
+	_, _, err := client.Git.UpdateRefWithRetry(ctx, "o", "r", UpdateRefRequest{Ref: "heads/b", SHA: "s"}, rebuild, 3)
+	if !errors.Is(err, errRebuild) {
+		t.Errorf("Git.UpdateRefWithRetry returned error %v, want %v", err, errRebuild)
+	}
+}
+
 func TestGitService_DeleteRef(t *testing.T) {
 	t.Parallel()
 	client, mux, _ := setup(t)
@@ -582,6 +712,58 @@ func TestGitService_DeleteRef(t *testing.T) {
 	})
 }
 
+func TestGitService_GetRef_emptyRepository(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/git/ref/heads/main", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.WriteHeader(http.StatusConflict)
+		fmt.Fprint(w, `{"message":"Git Repository is empty."}`)
+	})
+
+	ctx := context.Background()
+	_, _, err := client.Git.GetRef(ctx, "o", "r", "refs/heads/main")
+	if !errors.Is(err, ErrEmptyRepository) {
+		t.Errorf("Git.GetRef returned error %v, want ErrEmptyRepository", err)
+	}
+	if _, ok := err.(*ErrorResponse); !ok {
+		t.Errorf("Git.GetRef returned error of type %T, want *ErrorResponse", err)
+	}
+}
+
+func TestGitService_GetRef_notFoundIsNotEmptyRepository(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/git/ref/heads/main", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"message":"Git Repository is empty."}`)
+	})
+
+	ctx := context.Background()
+	_, _, err := client.Git.GetRef(ctx, "o", "r", "refs/heads/main")
+	if err == nil || errors.Is(err, ErrEmptyRepository) {
+		t.Errorf("Git.GetRef returned error %v, want a plain 404 *ErrorResponse", err)
+	}
+}
+
+func TestGitService_GetRef_conflictIsNotEmptyRepository(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/git/ref/heads/main", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusConflict)
+		fmt.Fprint(w, `{"message":"Repository access blocked"}`)
+	})
+
+	ctx := context.Background()
+	_, _, err := client.Git.GetRef(ctx, "o", "r", "refs/heads/main")
+	if err == nil || errors.Is(err, ErrEmptyRepository) {
+		t.Errorf("Git.GetRef returned error %v, want a plain 409 *ErrorResponse", err)
+	}
+}
+
 func TestGitService_GetRef_pathEscape(t *testing.T) {
 	t.Parallel()
 	client, mux, _ := setup(t)
@@ -846,6 +846,14 @@ func (a *AppConfig) GetID() int64 {
 	return *a.ID
 }
 
+// GetInstallationsCount returns the InstallationsCount field if it's non-nil, zero value otherwise.
+func (a *AppConfig) GetInstallationsCount() int {
+	if a == nil || a.InstallationsCount == nil {
+		return 0
+	}
+	return *a.InstallationsCount
+}
+
 // GetName returns the Name field if it's non-nil, zero value otherwise.
 func (a *AppConfig) GetName() string {
 	if a == nil || a.Name == nil {
@@ -878,6 +886,14 @@ func (a *AppConfig) GetPEM() string {
 	return *a.PEM
 }
 
+// GetPermissions returns the Permissions field.
+func (a *AppConfig) GetPermissions() *InstallationPermissions {
+	if a == nil {
+		return nil
+	}
+	return a.Permissions
+}
+
 // GetSlug returns the Slug field if it's non-nil, zero value otherwise.
 func (a *AppConfig) GetSlug() string {
 	if a == nil || a.Slug == nil {
@@ -3798,6 +3814,38 @@ func (c *CommitFile) GetStatus() string {
 	return *c.Status
 }
 
+// GetAuthor returns the Author field.
+func (c *CommitOptions) GetAuthor() *CommitAuthor {
+	if c == nil {
+		return nil
+	}
+	return c.Author
+}
+
+// GetBranch returns the Branch field if it's non-nil, zero value otherwise.
+func (c *CommitOptions) GetBranch() string {
+	if c == nil || c.Branch == nil {
+		return ""
+	}
+	return *c.Branch
+}
+
+// GetCommitter returns the Committer field.
+func (c *CommitOptions) GetCommitter() *CommitAuthor {
+	if c == nil {
+		return nil
+	}
+	return c.Committer
+}
+
+// GetMessage returns the Message field if it's non-nil, zero value otherwise.
+func (c *CommitOptions) GetMessage() string {
+	if c == nil || c.Message == nil {
+		return ""
+	}
+	return *c.Message
+}
+
 // GetAuthor returns the Author field.
 func (c *CommitResult) GetAuthor() *User {
 	if c == nil {
@@ -6158,6 +6206,30 @@ func (c *Credit) GetUser() *User {
 	return c.User
 }
 
+// GetActor returns the Actor field.
+func (c *CrossReference) GetActor() *User {
+	if c == nil {
+		return nil
+	}
+	return c.Actor
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (c *CrossReference) GetCreatedAt() Timestamp {
+	if c == nil || c.CreatedAt == nil {
+		return Timestamp{}
+	}
+	return *c.CreatedAt
+}
+
+// GetIssue returns the Issue field.
+func (c *CrossReference) GetIssue() *Issue {
+	if c == nil {
+		return nil
+	}
+	return c.Issue
+}
+
 // GetApp returns the App field.
 func (c *CustomDeploymentProtectionRule) GetApp() *CustomDeploymentProtectionRuleApp {
 	if c == nil {
@@ -9446,6 +9518,14 @@ func (g *GistFile) GetSize() int {
 	return *g.Size
 }
 
+// GetTruncated returns the Truncated field if it's non-nil, zero value otherwise.
+func (g *GistFile) GetTruncated() bool {
+	if g == nil || g.Truncated == nil {
+		return false
+	}
+	return *g.Truncated
+}
+
 // GetType returns the Type field if it's non-nil, zero value otherwise.
 func (g *GistFile) GetType() string {
 	if g == nil || g.Type == nil {
@@ -12870,6 +12950,38 @@ func (l *LicenseCheck) GetStatus() string {
 	return *l.Status
 }
 
+// GetDaysUntilExpiration returns the DaysUntilExpiration field if it's non-nil, zero value otherwise.
+func (l *LicenseInfo) GetDaysUntilExpiration() int {
+	if l == nil || l.DaysUntilExpiration == nil {
+		return 0
+	}
+	return *l.DaysUntilExpiration
+}
+
+// GetExpireAt returns the ExpireAt field if it's non-nil, zero value otherwise.
+func (l *LicenseInfo) GetExpireAt() Timestamp {
+	if l == nil || l.ExpireAt == nil {
+		return Timestamp{}
+	}
+	return *l.ExpireAt
+}
+
+// GetKind returns the Kind field if it's non-nil, zero value otherwise.
+func (l *LicenseInfo) GetKind() string {
+	if l == nil || l.Kind == nil {
+		return ""
+	}
+	return *l.Kind
+}
+
+// GetSeatsUsed returns the SeatsUsed field if it's non-nil, zero value otherwise.
+func (l *LicenseInfo) GetSeatsUsed() int {
+	if l == nil || l.SeatsUsed == nil {
+		return 0
+	}
+	return *l.SeatsUsed
+}
+
 // GetAdvancedSecurityEnabled returns the AdvancedSecurityEnabled field if it's non-nil, zero value otherwise.
 func (l *LicenseStatus) GetAdvancedSecurityEnabled() bool {
 	if l == nil || l.AdvancedSecurityEnabled == nil {
@@ -14846,6 +14958,14 @@ func (n *NotificationSubject) GetURL() string {
 	return *n.URL
 }
 
+// GetPrimary returns the Primary field.
+func (n *NoVerifiedEmailError) GetPrimary() *UserEmail {
+	if n == nil {
+		return nil
+	}
+	return n.Primary
+}
+
 // GetClientID returns the ClientID field if it's non-nil, zero value otherwise.
 func (o *OAuthAPP) GetClientID() string {
 	if o == nil || o.ClientID == nil {
@@ -16398,6 +16518,78 @@ func (p *PagesBuild) GetURL() string {
 	return *p.URL
 }
 
+// GetPageURL returns the PageURL field if it's non-nil, zero value otherwise.
+func (p *PagesDeployment) GetPageURL() string {
+	if p == nil || p.PageURL == nil {
+		return ""
+	}
+	return *p.PageURL
+}
+
+// GetPreviewURL returns the PreviewURL field if it's non-nil, zero value otherwise.
+func (p *PagesDeployment) GetPreviewURL() string {
+	if p == nil || p.PreviewURL == nil {
+		return ""
+	}
+	return *p.PreviewURL
+}
+
+// GetStatusURL returns the StatusURL field if it's non-nil, zero value otherwise.
+func (p *PagesDeployment) GetStatusURL() string {
+	if p == nil || p.StatusURL == nil {
+		return ""
+	}
+	return *p.StatusURL
+}
+
+// GetArtifactID returns the ArtifactID field if it's non-nil, zero value otherwise.
+func (p *PagesDeploymentRequest) GetArtifactID() int64 {
+	if p == nil || p.ArtifactID == nil {
+		return 0
+	}
+	return *p.ArtifactID
+}
+
+// GetArtifactURL returns the ArtifactURL field if it's non-nil, zero value otherwise.
+func (p *PagesDeploymentRequest) GetArtifactURL() string {
+	if p == nil || p.ArtifactURL == nil {
+		return ""
+	}
+	return *p.ArtifactURL
+}
+
+// GetEnvironment returns the Environment field if it's non-nil, zero value otherwise.
+func (p *PagesDeploymentRequest) GetEnvironment() string {
+	if p == nil || p.Environment == nil {
+		return ""
+	}
+	return *p.Environment
+}
+
+// GetOIDCToken returns the OIDCToken field if it's non-nil, zero value otherwise.
+func (p *PagesDeploymentRequest) GetOIDCToken() string {
+	if p == nil || p.OIDCToken == nil {
+		return ""
+	}
+	return *p.OIDCToken
+}
+
+// GetPagesBuildVersion returns the PagesBuildVersion field if it's non-nil, zero value otherwise.
+func (p *PagesDeploymentRequest) GetPagesBuildVersion() string {
+	if p == nil || p.PagesBuildVersion == nil {
+		return ""
+	}
+	return *p.PagesBuildVersion
+}
+
+// GetStatus returns the Status field if it's non-nil, zero value otherwise.
+func (p *PagesDeploymentStatus) GetStatus() string {
+	if p == nil || p.Status == nil {
+		return ""
+	}
+	return *p.Status
+}
+
 // GetCAAError returns the CAAError field if it's non-nil, zero value otherwise.
 func (p *PagesDomain) GetCAAError() string {
 	if p == nil || p.CAAError == nil {
@@ -17566,6 +17758,46 @@ func (p *ProjectV2Event) GetSender() *User {
 	return p.Sender
 }
 
+// GetDate returns the Date field if it's non-nil, zero value otherwise.
+func (p *ProjectV2FieldValue) GetDate() string {
+	if p == nil || p.Date == nil {
+		return ""
+	}
+	return *p.Date
+}
+
+// GetIterationID returns the IterationID field if it's non-nil, zero value otherwise.
+func (p *ProjectV2FieldValue) GetIterationID() string {
+	if p == nil || p.IterationID == nil {
+		return ""
+	}
+	return *p.IterationID
+}
+
+// GetNumber returns the Number field.
+func (p *ProjectV2FieldValue) GetNumber() *float64 {
+	if p == nil {
+		return nil
+	}
+	return p.Number
+}
+
+// GetSingleSelectOptionID returns the SingleSelectOptionID field if it's non-nil, zero value otherwise.
+func (p *ProjectV2FieldValue) GetSingleSelectOptionID() string {
+	if p == nil || p.SingleSelectOptionID == nil {
+		return ""
+	}
+	return *p.SingleSelectOptionID
+}
+
+// GetText returns the Text field if it's non-nil, zero value otherwise.
+func (p *ProjectV2FieldValue) GetText() string {
+	if p == nil || p.Text == nil {
+		return ""
+	}
+	return *p.Text
+}
+
 // GetArchivedAt returns the ArchivedAt field if it's non-nil, zero value otherwise.
 func (p *ProjectV2Item) GetArchivedAt() Timestamp {
 	if p == nil || p.ArchivedAt == nil {
@@ -20342,6 +20574,14 @@ func (r *ReleaseAsset) GetURL() string {
 	return *r.URL
 }
 
+// GetOptions returns the Options field.
+func (r *ReleaseAssetSpec) GetOptions() *UploadOptions {
+	if r == nil {
+		return nil
+	}
+	return r.Options
+}
+
 // GetAction returns the Action field if it's non-nil, zero value otherwise.
 func (r *ReleaseEvent) GetAction() string {
 	if r == nil || r.Action == nil {
@@ -20390,6 +20630,14 @@ func (r *ReleaseEvent) GetSender() *User {
 	return r.Sender
 }
 
+// GetRelease returns the Release field.
+func (r *ReleaseSpec) GetRelease() *RepositoryRelease {
+	if r == nil {
+		return nil
+	}
+	return r.Release
+}
+
 // GetBuildDate returns the BuildDate field if it's non-nil, zero value otherwise.
 func (r *ReleaseVersion) GetBuildDate() string {
 	if r == nil || r.BuildDate == nil {
@@ -20510,6 +20758,14 @@ func (r *RepoAdvisoryCreditDetailed) GetUser() *User {
 	return r.User
 }
 
+// GetCopyrightText returns the CopyrightText field if it's non-nil, zero value otherwise.
+func (r *RepoDependencies) GetCopyrightText() string {
+	if r == nil || r.CopyrightText == nil {
+		return ""
+	}
+	return *r.CopyrightText
+}
+
 // GetDownloadLocation returns the DownloadLocation field if it's non-nil, zero value otherwise.
 func (r *RepoDependencies) GetDownloadLocation() string {
 	if r == nil || r.DownloadLocation == nil {
@@ -23102,6 +23358,14 @@ func (r *RepositoryVulnerabilityAlertEvent) GetSender() *User {
 	return r.Sender
 }
 
+// GetSettings returns the Settings field.
+func (r *RepoSnapshot) GetSettings() *Repository {
+	if r == nil {
+		return nil
+	}
+	return r.Settings
+}
+
 // GetForkRepos returns the ForkRepos field if it's non-nil, zero value otherwise.
 func (r *RepoStats) GetForkRepos() int {
 	if r == nil || r.ForkRepos == nil {
@@ -23342,6 +23606,22 @@ func (r *RequiredWorkflowSelectedRepos) GetTotalCount() int {
 	return *r.TotalCount
 }
 
+// GetDeprecation returns the Deprecation field if it's non-nil, zero value otherwise.
+func (r *Response) GetDeprecation() time.Time {
+	if r == nil || r.Deprecation == nil {
+		return time.Time{}
+	}
+	return *r.Deprecation
+}
+
+// GetSunset returns the Sunset field if it's non-nil, zero value otherwise.
+func (r *Response) GetSunset() time.Time {
+	if r == nil || r.Sunset == nil {
+		return time.Time{}
+	}
+	return *r.Sunset
+}
+
 // GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
 func (r *ReviewersRequest) GetNodeID() string {
 	if r == nil || r.NodeID == nil {
@@ -23726,6 +24006,30 @@ func (s *SBOM) GetSBOM() *SBOMInfo {
 	return s.SBOM
 }
 
+// GetReferenceCategory returns the ReferenceCategory field if it's non-nil, zero value otherwise.
+func (s *SBOMExternalRef) GetReferenceCategory() string {
+	if s == nil || s.ReferenceCategory == nil {
+		return ""
+	}
+	return *s.ReferenceCategory
+}
+
+// GetReferenceLocator returns the ReferenceLocator field if it's non-nil, zero value otherwise.
+func (s *SBOMExternalRef) GetReferenceLocator() string {
+	if s == nil || s.ReferenceLocator == nil {
+		return ""
+	}
+	return *s.ReferenceLocator
+}
+
+// GetReferenceType returns the ReferenceType field if it's non-nil, zero value otherwise.
+func (s *SBOMExternalRef) GetReferenceType() string {
+	if s == nil || s.ReferenceType == nil {
+		return ""
+	}
+	return *s.ReferenceType
+}
+
 // GetCreationInfo returns the CreationInfo field.
 func (s *SBOMInfo) GetCreationInfo() *CreationInfo {
 	if s == nil {
@@ -23774,6 +24078,30 @@ func (s *SBOMInfo) GetSPDXVersion() string {
 	return *s.SPDXVersion
 }
 
+// GetRelatedSPDXElement returns the RelatedSPDXElement field if it's non-nil, zero value otherwise.
+func (s *SBOMRelationship) GetRelatedSPDXElement() string {
+	if s == nil || s.RelatedSPDXElement == nil {
+		return ""
+	}
+	return *s.RelatedSPDXElement
+}
+
+// GetRelationshipType returns the RelationshipType field if it's non-nil, zero value otherwise.
+func (s *SBOMRelationship) GetRelationshipType() string {
+	if s == nil || s.RelationshipType == nil {
+		return ""
+	}
+	return *s.RelationshipType
+}
+
+// GetSPDXElementID returns the SPDXElementID field if it's non-nil, zero value otherwise.
+func (s *SBOMRelationship) GetSPDXElementID() string {
+	if s == nil || s.SPDXElementID == nil {
+		return ""
+	}
+	return *s.SPDXElementID
+}
+
 // GetAnalysisKey returns the AnalysisKey field if it's non-nil, zero value otherwise.
 func (s *ScanningAnalysis) GetAnalysisKey() string {
 	if s == nil || s.AnalysisKey == nil {
@@ -26286,6 +26614,286 @@ func (t *Timeline) GetUser() *User {
 	return t.User
 }
 
+// GetActor returns the Actor field.
+func (t *TimelineAssignEvent) GetActor() *User {
+	if t == nil {
+		return nil
+	}
+	return t.Actor
+}
+
+// GetAssignee returns the Assignee field.
+func (t *TimelineAssignEvent) GetAssignee() *User {
+	if t == nil {
+		return nil
+	}
+	return t.Assignee
+}
+
+// GetAssigner returns the Assigner field.
+func (t *TimelineAssignEvent) GetAssigner() *User {
+	if t == nil {
+		return nil
+	}
+	return t.Assigner
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (t *TimelineAssignEvent) GetCreatedAt() Timestamp {
+	if t == nil || t.CreatedAt == nil {
+		return Timestamp{}
+	}
+	return *t.CreatedAt
+}
+
+// GetBody returns the Body field if it's non-nil, zero value otherwise.
+func (t *TimelineCommentEvent) GetBody() string {
+	if t == nil || t.Body == nil {
+		return ""
+	}
+	return *t.Body
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (t *TimelineCommentEvent) GetCreatedAt() Timestamp {
+	if t == nil || t.CreatedAt == nil {
+		return Timestamp{}
+	}
+	return *t.CreatedAt
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (t *TimelineCommentEvent) GetID() int64 {
+	if t == nil || t.ID == nil {
+		return 0
+	}
+	return *t.ID
+}
+
+// GetUser returns the User field.
+func (t *TimelineCommentEvent) GetUser() *User {
+	if t == nil {
+		return nil
+	}
+	return t.User
+}
+
+// GetAuthor returns the Author field.
+func (t *TimelineCommitEvent) GetAuthor() *CommitAuthor {
+	if t == nil {
+		return nil
+	}
+	return t.Author
+}
+
+// GetCommitter returns the Committer field.
+func (t *TimelineCommitEvent) GetCommitter() *CommitAuthor {
+	if t == nil {
+		return nil
+	}
+	return t.Committer
+}
+
+// GetMessage returns the Message field if it's non-nil, zero value otherwise.
+func (t *TimelineCommitEvent) GetMessage() string {
+	if t == nil || t.Message == nil {
+		return ""
+	}
+	return *t.Message
+}
+
+// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
+func (t *TimelineCommitEvent) GetSHA() string {
+	if t == nil || t.SHA == nil {
+		return ""
+	}
+	return *t.SHA
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (t *TimelineCommitEvent) GetURL() string {
+	if t == nil || t.URL == nil {
+		return ""
+	}
+	return *t.URL
+}
+
+// GetActor returns the Actor field.
+func (t *TimelineCrossReferenceEvent) GetActor() *User {
+	if t == nil {
+		return nil
+	}
+	return t.Actor
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (t *TimelineCrossReferenceEvent) GetCreatedAt() Timestamp {
+	if t == nil || t.CreatedAt == nil {
+		return Timestamp{}
+	}
+	return *t.CreatedAt
+}
+
+// GetSource returns the Source field.
+func (t *TimelineCrossReferenceEvent) GetSource() *Source {
+	if t == nil {
+		return nil
+	}
+	return t.Source
+}
+
+// GetActor returns the Actor field.
+func (t *TimelineLabelEvent) GetActor() *User {
+	if t == nil {
+		return nil
+	}
+	return t.Actor
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (t *TimelineLabelEvent) GetCreatedAt() Timestamp {
+	if t == nil || t.CreatedAt == nil {
+		return Timestamp{}
+	}
+	return *t.CreatedAt
+}
+
+// GetLabel returns the Label field.
+func (t *TimelineLabelEvent) GetLabel() *Label {
+	if t == nil {
+		return nil
+	}
+	return t.Label
+}
+
+// GetActor returns the Actor field.
+func (t *TimelineMilestoneEvent) GetActor() *User {
+	if t == nil {
+		return nil
+	}
+	return t.Actor
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (t *TimelineMilestoneEvent) GetCreatedAt() Timestamp {
+	if t == nil || t.CreatedAt == nil {
+		return Timestamp{}
+	}
+	return *t.CreatedAt
+}
+
+// GetMilestone returns the Milestone field.
+func (t *TimelineMilestoneEvent) GetMilestone() *Milestone {
+	if t == nil {
+		return nil
+	}
+	return t.Milestone
+}
+
+// GetActor returns the Actor field.
+func (t *TimelineRenameEvent) GetActor() *User {
+	if t == nil {
+		return nil
+	}
+	return t.Actor
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (t *TimelineRenameEvent) GetCreatedAt() Timestamp {
+	if t == nil || t.CreatedAt == nil {
+		return Timestamp{}
+	}
+	return *t.CreatedAt
+}
+
+// GetRename returns the Rename field.
+func (t *TimelineRenameEvent) GetRename() *Rename {
+	if t == nil {
+		return nil
+	}
+	return t.Rename
+}
+
+// GetBody returns the Body field if it's non-nil, zero value otherwise.
+func (t *TimelineReviewEvent) GetBody() string {
+	if t == nil || t.Body == nil {
+		return ""
+	}
+	return *t.Body
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (t *TimelineReviewEvent) GetID() int64 {
+	if t == nil || t.ID == nil {
+		return 0
+	}
+	return *t.ID
+}
+
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (t *TimelineReviewEvent) GetState() string {
+	if t == nil || t.State == nil {
+		return ""
+	}
+	return *t.State
+}
+
+// GetSubmittedAt returns the SubmittedAt field if it's non-nil, zero value otherwise.
+func (t *TimelineReviewEvent) GetSubmittedAt() Timestamp {
+	if t == nil || t.SubmittedAt == nil {
+		return Timestamp{}
+	}
+	return *t.SubmittedAt
+}
+
+// GetUser returns the User field.
+func (t *TimelineReviewEvent) GetUser() *User {
+	if t == nil {
+		return nil
+	}
+	return t.User
+}
+
+// GetActor returns the Actor field.
+func (t *TimelineReviewRequestEvent) GetActor() *User {
+	if t == nil {
+		return nil
+	}
+	return t.Actor
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (t *TimelineReviewRequestEvent) GetCreatedAt() Timestamp {
+	if t == nil || t.CreatedAt == nil {
+		return Timestamp{}
+	}
+	return *t.CreatedAt
+}
+
+// GetRequestedTeam returns the RequestedTeam field.
+func (t *TimelineReviewRequestEvent) GetRequestedTeam() *Team {
+	if t == nil {
+		return nil
+	}
+	return t.RequestedTeam
+}
+
+// GetRequester returns the Requester field.
+func (t *TimelineReviewRequestEvent) GetRequester() *User {
+	if t == nil {
+		return nil
+	}
+	return t.Requester
+}
+
+// GetReviewer returns the Reviewer field.
+func (t *TimelineReviewRequestEvent) GetReviewer() *User {
+	if t == nil {
+		return nil
+	}
+	return t.Reviewer
+}
+
 // GetGUID returns the GUID field if it's non-nil, zero value otherwise.
 func (t *Tool) GetGUID() string {
 	if t == nil || t.GUID == nil {
@@ -1087,6 +1087,17 @@ func TestAppConfig_GetID(tt *testing.T) {
 	a.GetID()
 }
 
+func TestAppConfig_GetInstallationsCount(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue int
+	a := &AppConfig{InstallationsCount: &zeroValue}
+	a.GetInstallationsCount()
+	a = &AppConfig{}
+	a.GetInstallationsCount()
+	a = nil
+	a.GetInstallationsCount()
+}
+
 func TestAppConfig_GetName(tt *testing.T) {
 	tt.Parallel()
 	var zeroValue string
@@ -1128,6 +1139,14 @@ func TestAppConfig_GetPEM(tt *testing.T) {
 	a.GetPEM()
 }
 
+func TestAppConfig_GetPermissions(tt *testing.T) {
+	tt.Parallel()
+	a := &AppConfig{}
+	a.GetPermissions()
+	a = nil
+	a.GetPermissions()
+}
+
 func TestAppConfig_GetSlug(tt *testing.T) {
 	tt.Parallel()
 	var zeroValue string
@@ -4939,6 +4958,44 @@ func TestCommitFile_GetStatus(tt *testing.T) {
 	c.GetStatus()
 }
 
+func TestCommitOptions_GetAuthor(tt *testing.T) {
+	tt.Parallel()
+	c := &CommitOptions{}
+	c.GetAuthor()
+	c = nil
+	c.GetAuthor()
+}
+
+func TestCommitOptions_GetBranch(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue string
+	c := &CommitOptions{Branch: &zeroValue}
+	c.GetBranch()
+	c = &CommitOptions{}
+	c.GetBranch()
+	c = nil
+	c.GetBranch()
+}
+
+func TestCommitOptions_GetCommitter(tt *testing.T) {
+	tt.Parallel()
+	c := &CommitOptions{}
+	c.GetCommitter()
+	c = nil
+	c.GetCommitter()
+}
+
+func TestCommitOptions_GetMessage(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue string
+	c := &CommitOptions{Message: &zeroValue}
+	c.GetMessage()
+	c = &CommitOptions{}
+	c.GetMessage()
+	c = nil
+	c.GetMessage()
+}
+
 func TestCommitResult_GetAuthor(tt *testing.T) {
 	tt.Parallel()
 	c := &CommitResult{}
@@ -8031,6 +8088,33 @@ func TestCredit_GetUser(tt *testing.T) {
 	c.GetUser()
 }
 
+func TestCrossReference_GetActor(tt *testing.T) {
+	tt.Parallel()
+	c := &CrossReference{}
+	c.GetActor()
+	c = nil
+	c.GetActor()
+}
+
+func TestCrossReference_GetCreatedAt(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue Timestamp
+	c := &CrossReference{CreatedAt: &zeroValue}
+	c.GetCreatedAt()
+	c = &CrossReference{}
+	c.GetCreatedAt()
+	c = nil
+	c.GetCreatedAt()
+}
+
+func TestCrossReference_GetIssue(tt *testing.T) {
+	tt.Parallel()
+	c := &CrossReference{}
+	c.GetIssue()
+	c = nil
+	c.GetIssue()
+}
+
 func TestCustomDeploymentProtectionRule_GetApp(tt *testing.T) {
 	tt.Parallel()
 	c := &CustomDeploymentProtectionRule{}
@@ -12213,6 +12297,17 @@ func TestGistFile_GetSize(tt *testing.T) {
 	g.GetSize()
 }
 
+func TestGistFile_GetTruncated(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue bool
+	g := &GistFile{Truncated: &zeroValue}
+	g.GetTruncated()
+	g = &GistFile{}
+	g.GetTruncated()
+	g = nil
+	g.GetTruncated()
+}
+
 func TestGistFile_GetType(tt *testing.T) {
 	tt.Parallel()
 	var zeroValue string
@@ -16660,6 +16755,50 @@ func TestLicenseCheck_GetStatus(tt *testing.T) {
 	l.GetStatus()
 }
 
+func TestLicenseInfo_GetDaysUntilExpiration(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue int
+	l := &LicenseInfo{DaysUntilExpiration: &zeroValue}
+	l.GetDaysUntilExpiration()
+	l = &LicenseInfo{}
+	l.GetDaysUntilExpiration()
+	l = nil
+	l.GetDaysUntilExpiration()
+}
+
+func TestLicenseInfo_GetExpireAt(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue Timestamp
+	l := &LicenseInfo{ExpireAt: &zeroValue}
+	l.GetExpireAt()
+	l = &LicenseInfo{}
+	l.GetExpireAt()
+	l = nil
+	l.GetExpireAt()
+}
+
+func TestLicenseInfo_GetKind(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue string
+	l := &LicenseInfo{Kind: &zeroValue}
+	l.GetKind()
+	l = &LicenseInfo{}
+	l.GetKind()
+	l = nil
+	l.GetKind()
+}
+
+func TestLicenseInfo_GetSeatsUsed(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue int
+	l := &LicenseInfo{SeatsUsed: &zeroValue}
+	l.GetSeatsUsed()
+	l = &LicenseInfo{}
+	l.GetSeatsUsed()
+	l = nil
+	l.GetSeatsUsed()
+}
+
 func TestLicenseStatus_GetAdvancedSecurityEnabled(tt *testing.T) {
 	tt.Parallel()
 	var zeroValue bool
@@ -19233,6 +19372,14 @@ func TestNotificationSubject_GetURL(tt *testing.T) {
 	n.GetURL()
 }
 
+func TestNoVerifiedEmailError_GetPrimary(tt *testing.T) {
+	tt.Parallel()
+	n := &NoVerifiedEmailError{}
+	n.GetPrimary()
+	n = nil
+	n.GetPrimary()
+}
+
 func TestOAuthAPP_GetClientID(tt *testing.T) {
 	tt.Parallel()
 	var zeroValue string
@@ -21256,6 +21403,105 @@ func TestPagesBuild_GetURL(tt *testing.T) {
 	p.GetURL()
 }
 
+func TestPagesDeployment_GetPageURL(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue string
+	p := &PagesDeployment{PageURL: &zeroValue}
+	p.GetPageURL()
+	p = &PagesDeployment{}
+	p.GetPageURL()
+	p = nil
+	p.GetPageURL()
+}
+
+func TestPagesDeployment_GetPreviewURL(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue string
+	p := &PagesDeployment{PreviewURL: &zeroValue}
+	p.GetPreviewURL()
+	p = &PagesDeployment{}
+	p.GetPreviewURL()
+	p = nil
+	p.GetPreviewURL()
+}
+
+func TestPagesDeployment_GetStatusURL(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue string
+	p := &PagesDeployment{StatusURL: &zeroValue}
+	p.GetStatusURL()
+	p = &PagesDeployment{}
+	p.GetStatusURL()
+	p = nil
+	p.GetStatusURL()
+}
+
+func TestPagesDeploymentRequest_GetArtifactID(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue int64
+	p := &PagesDeploymentRequest{ArtifactID: &zeroValue}
+	p.GetArtifactID()
+	p = &PagesDeploymentRequest{}
+	p.GetArtifactID()
+	p = nil
+	p.GetArtifactID()
+}
+
+func TestPagesDeploymentRequest_GetArtifactURL(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue string
+	p := &PagesDeploymentRequest{ArtifactURL: &zeroValue}
+	p.GetArtifactURL()
+	p = &PagesDeploymentRequest{}
+	p.GetArtifactURL()
+	p = nil
+	p.GetArtifactURL()
+}
+
+func TestPagesDeploymentRequest_GetEnvironment(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue string
+	p := &PagesDeploymentRequest{Environment: &zeroValue}
+	p.GetEnvironment()
+	p = &PagesDeploymentRequest{}
+	p.GetEnvironment()
+	p = nil
+	p.GetEnvironment()
+}
+
+func TestPagesDeploymentRequest_GetOIDCToken(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue string
+	p := &PagesDeploymentRequest{OIDCToken: &zeroValue}
+	p.GetOIDCToken()
+	p = &PagesDeploymentRequest{}
+	p.GetOIDCToken()
+	p = nil
+	p.GetOIDCToken()
+}
+
+func TestPagesDeploymentRequest_GetPagesBuildVersion(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue string
+	p := &PagesDeploymentRequest{PagesBuildVersion: &zeroValue}
+	p.GetPagesBuildVersion()
+	p = &PagesDeploymentRequest{}
+	p.GetPagesBuildVersion()
+	p = nil
+	p.GetPagesBuildVersion()
+}
+
+func TestPagesDeploymentStatus_GetStatus(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue string
+	p := &PagesDeploymentStatus{Status: &zeroValue}
+	p.GetStatus()
+	p = &PagesDeploymentStatus{}
+	p.GetStatus()
+	p = nil
+	p.GetStatus()
+}
+
 func TestPagesDomain_GetCAAError(tt *testing.T) {
 	tt.Parallel()
 	var zeroValue string
@@ -22745,6 +22991,58 @@ func TestProjectV2Event_GetSender(tt *testing.T) {
 	p.GetSender()
 }
 
+func TestProjectV2FieldValue_GetDate(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue string
+	p := &ProjectV2FieldValue{Date: &zeroValue}
+	p.GetDate()
+	p = &ProjectV2FieldValue{}
+	p.GetDate()
+	p = nil
+	p.GetDate()
+}
+
+func TestProjectV2FieldValue_GetIterationID(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue string
+	p := &ProjectV2FieldValue{IterationID: &zeroValue}
+	p.GetIterationID()
+	p = &ProjectV2FieldValue{}
+	p.GetIterationID()
+	p = nil
+	p.GetIterationID()
+}
+
+func TestProjectV2FieldValue_GetNumber(tt *testing.T) {
+	tt.Parallel()
+	p := &ProjectV2FieldValue{}
+	p.GetNumber()
+	p = nil
+	p.GetNumber()
+}
+
+func TestProjectV2FieldValue_GetSingleSelectOptionID(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue string
+	p := &ProjectV2FieldValue{SingleSelectOptionID: &zeroValue}
+	p.GetSingleSelectOptionID()
+	p = &ProjectV2FieldValue{}
+	p.GetSingleSelectOptionID()
+	p = nil
+	p.GetSingleSelectOptionID()
+}
+
+func TestProjectV2FieldValue_GetText(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue string
+	p := &ProjectV2FieldValue{Text: &zeroValue}
+	p.GetText()
+	p = &ProjectV2FieldValue{}
+	p.GetText()
+	p = nil
+	p.GetText()
+}
+
 func TestProjectV2Item_GetArchivedAt(tt *testing.T) {
 	tt.Parallel()
 	var zeroValue Timestamp
@@ -26193,6 +26491,14 @@ func TestReleaseAsset_GetURL(tt *testing.T) {
 	r.GetURL()
 }
 
+func TestReleaseAssetSpec_GetOptions(tt *testing.T) {
+	tt.Parallel()
+	r := &ReleaseAssetSpec{}
+	r.GetOptions()
+	r = nil
+	r.GetOptions()
+}
+
 func TestReleaseEvent_GetAction(tt *testing.T) {
 	tt.Parallel()
 	var zeroValue string
@@ -26244,6 +26550,14 @@ func TestReleaseEvent_GetSender(tt *testing.T) {
 	r.GetSender()
 }
 
+func TestReleaseSpec_GetRelease(tt *testing.T) {
+	tt.Parallel()
+	r := &ReleaseSpec{}
+	r.GetRelease()
+	r = nil
+	r.GetRelease()
+}
+
 func TestReleaseVersion_GetBuildDate(tt *testing.T) {
 	tt.Parallel()
 	var zeroValue string
@@ -26406,6 +26720,17 @@ func TestRepoAdvisoryCreditDetailed_GetUser(tt *testing.T) {
 	r.GetUser()
 }
 
+func TestRepoDependencies_GetCopyrightText(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue string
+	r := &RepoDependencies{CopyrightText: &zeroValue}
+	r.GetCopyrightText()
+	r = &RepoDependencies{}
+	r.GetCopyrightText()
+	r = nil
+	r.GetCopyrightText()
+}
+
 func TestRepoDependencies_GetDownloadLocation(tt *testing.T) {
 	tt.Parallel()
 	var zeroValue string
@@ -29667,6 +29992,14 @@ func TestRepositoryVulnerabilityAlertEvent_GetSender(tt *testing.T) {
 	r.GetSender()
 }
 
+func TestRepoSnapshot_GetSettings(tt *testing.T) {
+	tt.Parallel()
+	r := &RepoSnapshot{}
+	r.GetSettings()
+	r = nil
+	r.GetSettings()
+}
+
 func TestRepoStats_GetForkRepos(tt *testing.T) {
 	tt.Parallel()
 	var zeroValue int
@@ -29994,6 +30327,28 @@ func TestRequiredWorkflowSelectedRepos_GetTotalCount(tt *testing.T) {
 	r.GetTotalCount()
 }
 
+func TestResponse_GetDeprecation(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue time.Time
+	r := &Response{Deprecation: &zeroValue}
+	r.GetDeprecation()
+	r = &Response{}
+	r.GetDeprecation()
+	r = nil
+	r.GetDeprecation()
+}
+
+func TestResponse_GetSunset(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue time.Time
+	r := &Response{Sunset: &zeroValue}
+	r.GetSunset()
+	r = &Response{}
+	r.GetSunset()
+	r = nil
+	r.GetSunset()
+}
+
 func TestReviewersRequest_GetNodeID(tt *testing.T) {
 	tt.Parallel()
 	var zeroValue string
@@ -30519,6 +30874,39 @@ func TestSBOM_GetSBOM(tt *testing.T) {
 	s.GetSBOM()
 }
 
+func TestSBOMExternalRef_GetReferenceCategory(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue string
+	s := &SBOMExternalRef{ReferenceCategory: &zeroValue}
+	s.GetReferenceCategory()
+	s = &SBOMExternalRef{}
+	s.GetReferenceCategory()
+	s = nil
+	s.GetReferenceCategory()
+}
+
+func TestSBOMExternalRef_GetReferenceLocator(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue string
+	s := &SBOMExternalRef{ReferenceLocator: &zeroValue}
+	s.GetReferenceLocator()
+	s = &SBOMExternalRef{}
+	s.GetReferenceLocator()
+	s = nil
+	s.GetReferenceLocator()
+}
+
+func TestSBOMExternalRef_GetReferenceType(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue string
+	s := &SBOMExternalRef{ReferenceType: &zeroValue}
+	s.GetReferenceType()
+	s = &SBOMExternalRef{}
+	s.GetReferenceType()
+	s = nil
+	s.GetReferenceType()
+}
+
 func TestSBOMInfo_GetCreationInfo(tt *testing.T) {
 	tt.Parallel()
 	s := &SBOMInfo{}
@@ -30582,6 +30970,39 @@ func TestSBOMInfo_GetSPDXVersion(tt *testing.T) {
 	s.GetSPDXVersion()
 }
 
+func TestSBOMRelationship_GetRelatedSPDXElement(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue string
+	s := &SBOMRelationship{RelatedSPDXElement: &zeroValue}
+	s.GetRelatedSPDXElement()
+	s = &SBOMRelationship{}
+	s.GetRelatedSPDXElement()
+	s = nil
+	s.GetRelatedSPDXElement()
+}
+
+func TestSBOMRelationship_GetRelationshipType(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue string
+	s := &SBOMRelationship{RelationshipType: &zeroValue}
+	s.GetRelationshipType()
+	s = &SBOMRelationship{}
+	s.GetRelationshipType()
+	s = nil
+	s.GetRelationshipType()
+}
+
+func TestSBOMRelationship_GetSPDXElementID(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue string
+	s := &SBOMRelationship{SPDXElementID: &zeroValue}
+	s.GetSPDXElementID()
+	s = &SBOMRelationship{}
+	s.GetSPDXElementID()
+	s = nil
+	s.GetSPDXElementID()
+}
+
 func TestScanningAnalysis_GetAnalysisKey(tt *testing.T) {
 	tt.Parallel()
 	var zeroValue string
@@ -33739,6 +34160,334 @@ func TestTimeline_GetUser(tt *testing.T) {
 	t.GetUser()
 }
 
+func TestTimelineAssignEvent_GetActor(tt *testing.T) {
+	tt.Parallel()
+	t := &TimelineAssignEvent{}
+	t.GetActor()
+	t = nil
+	t.GetActor()
+}
+
+func TestTimelineAssignEvent_GetAssignee(tt *testing.T) {
+	tt.Parallel()
+	t := &TimelineAssignEvent{}
+	t.GetAssignee()
+	t = nil
+	t.GetAssignee()
+}
+
+func TestTimelineAssignEvent_GetAssigner(tt *testing.T) {
+	tt.Parallel()
+	t := &TimelineAssignEvent{}
+	t.GetAssigner()
+	t = nil
+	t.GetAssigner()
+}
+
+func TestTimelineAssignEvent_GetCreatedAt(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue Timestamp
+	t := &TimelineAssignEvent{CreatedAt: &zeroValue}
+	t.GetCreatedAt()
+	t = &TimelineAssignEvent{}
+	t.GetCreatedAt()
+	t = nil
+	t.GetCreatedAt()
+}
+
+func TestTimelineCommentEvent_GetBody(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue string
+	t := &TimelineCommentEvent{Body: &zeroValue}
+	t.GetBody()
+	t = &TimelineCommentEvent{}
+	t.GetBody()
+	t = nil
+	t.GetBody()
+}
+
+func TestTimelineCommentEvent_GetCreatedAt(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue Timestamp
+	t := &TimelineCommentEvent{CreatedAt: &zeroValue}
+	t.GetCreatedAt()
+	t = &TimelineCommentEvent{}
+	t.GetCreatedAt()
+	t = nil
+	t.GetCreatedAt()
+}
+
+func TestTimelineCommentEvent_GetID(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue int64
+	t := &TimelineCommentEvent{ID: &zeroValue}
+	t.GetID()
+	t = &TimelineCommentEvent{}
+	t.GetID()
+	t = nil
+	t.GetID()
+}
+
+func TestTimelineCommentEvent_GetUser(tt *testing.T) {
+	tt.Parallel()
+	t := &TimelineCommentEvent{}
+	t.GetUser()
+	t = nil
+	t.GetUser()
+}
+
+func TestTimelineCommitEvent_GetAuthor(tt *testing.T) {
+	tt.Parallel()
+	t := &TimelineCommitEvent{}
+	t.GetAuthor()
+	t = nil
+	t.GetAuthor()
+}
+
+func TestTimelineCommitEvent_GetCommitter(tt *testing.T) {
+	tt.Parallel()
+	t := &TimelineCommitEvent{}
+	t.GetCommitter()
+	t = nil
+	t.GetCommitter()
+}
+
+func TestTimelineCommitEvent_GetMessage(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue string
+	t := &TimelineCommitEvent{Message: &zeroValue}
+	t.GetMessage()
+	t = &TimelineCommitEvent{}
+	t.GetMessage()
+	t = nil
+	t.GetMessage()
+}
+
+func TestTimelineCommitEvent_GetSHA(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue string
+	t := &TimelineCommitEvent{SHA: &zeroValue}
+	t.GetSHA()
+	t = &TimelineCommitEvent{}
+	t.GetSHA()
+	t = nil
+	t.GetSHA()
+}
+
+func TestTimelineCommitEvent_GetURL(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue string
+	t := &TimelineCommitEvent{URL: &zeroValue}
+	t.GetURL()
+	t = &TimelineCommitEvent{}
+	t.GetURL()
+	t = nil
+	t.GetURL()
+}
+
+func TestTimelineCrossReferenceEvent_GetActor(tt *testing.T) {
+	tt.Parallel()
+	t := &TimelineCrossReferenceEvent{}
+	t.GetActor()
+	t = nil
+	t.GetActor()
+}
+
+func TestTimelineCrossReferenceEvent_GetCreatedAt(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue Timestamp
+	t := &TimelineCrossReferenceEvent{CreatedAt: &zeroValue}
+	t.GetCreatedAt()
+	t = &TimelineCrossReferenceEvent{}
+	t.GetCreatedAt()
+	t = nil
+	t.GetCreatedAt()
+}
+
+func TestTimelineCrossReferenceEvent_GetSource(tt *testing.T) {
+	tt.Parallel()
+	t := &TimelineCrossReferenceEvent{}
+	t.GetSource()
+	t = nil
+	t.GetSource()
+}
+
+func TestTimelineLabelEvent_GetActor(tt *testing.T) {
+	tt.Parallel()
+	t := &TimelineLabelEvent{}
+	t.GetActor()
+	t = nil
+	t.GetActor()
+}
+
+func TestTimelineLabelEvent_GetCreatedAt(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue Timestamp
+	t := &TimelineLabelEvent{CreatedAt: &zeroValue}
+	t.GetCreatedAt()
+	t = &TimelineLabelEvent{}
+	t.GetCreatedAt()
+	t = nil
+	t.GetCreatedAt()
+}
+
+func TestTimelineLabelEvent_GetLabel(tt *testing.T) {
+	tt.Parallel()
+	t := &TimelineLabelEvent{}
+	t.GetLabel()
+	t = nil
+	t.GetLabel()
+}
+
+func TestTimelineMilestoneEvent_GetActor(tt *testing.T) {
+	tt.Parallel()
+	t := &TimelineMilestoneEvent{}
+	t.GetActor()
+	t = nil
+	t.GetActor()
+}
+
+func TestTimelineMilestoneEvent_GetCreatedAt(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue Timestamp
+	t := &TimelineMilestoneEvent{CreatedAt: &zeroValue}
+	t.GetCreatedAt()
+	t = &TimelineMilestoneEvent{}
+	t.GetCreatedAt()
+	t = nil
+	t.GetCreatedAt()
+}
+
+func TestTimelineMilestoneEvent_GetMilestone(tt *testing.T) {
+	tt.Parallel()
+	t := &TimelineMilestoneEvent{}
+	t.GetMilestone()
+	t = nil
+	t.GetMilestone()
+}
+
+func TestTimelineRenameEvent_GetActor(tt *testing.T) {
+	tt.Parallel()
+	t := &TimelineRenameEvent{}
+	t.GetActor()
+	t = nil
+	t.GetActor()
+}
+
+func TestTimelineRenameEvent_GetCreatedAt(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue Timestamp
+	t := &TimelineRenameEvent{CreatedAt: &zeroValue}
+	t.GetCreatedAt()
+	t = &TimelineRenameEvent{}
+	t.GetCreatedAt()
+	t = nil
+	t.GetCreatedAt()
+}
+
+func TestTimelineRenameEvent_GetRename(tt *testing.T) {
+	tt.Parallel()
+	t := &TimelineRenameEvent{}
+	t.GetRename()
+	t = nil
+	t.GetRename()
+}
+
+func TestTimelineReviewEvent_GetBody(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue string
+	t := &TimelineReviewEvent{Body: &zeroValue}
+	t.GetBody()
+	t = &TimelineReviewEvent{}
+	t.GetBody()
+	t = nil
+	t.GetBody()
+}
+
+func TestTimelineReviewEvent_GetID(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue int64
+	t := &TimelineReviewEvent{ID: &zeroValue}
+	t.GetID()
+	t = &TimelineReviewEvent{}
+	t.GetID()
+	t = nil
+	t.GetID()
+}
+
+func TestTimelineReviewEvent_GetState(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue string
+	t := &TimelineReviewEvent{State: &zeroValue}
+	t.GetState()
+	t = &TimelineReviewEvent{}
+	t.GetState()
+	t = nil
+	t.GetState()
+}
+
+func TestTimelineReviewEvent_GetSubmittedAt(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue Timestamp
+	t := &TimelineReviewEvent{SubmittedAt: &zeroValue}
+	t.GetSubmittedAt()
+	t = &TimelineReviewEvent{}
+	t.GetSubmittedAt()
+	t = nil
+	t.GetSubmittedAt()
+}
+
+func TestTimelineReviewEvent_GetUser(tt *testing.T) {
+	tt.Parallel()
+	t := &TimelineReviewEvent{}
+	t.GetUser()
+	t = nil
+	t.GetUser()
+}
+
+func TestTimelineReviewRequestEvent_GetActor(tt *testing.T) {
+	tt.Parallel()
+	t := &TimelineReviewRequestEvent{}
+	t.GetActor()
+	t = nil
+	t.GetActor()
+}
+
+func TestTimelineReviewRequestEvent_GetCreatedAt(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue Timestamp
+	t := &TimelineReviewRequestEvent{CreatedAt: &zeroValue}
+	t.GetCreatedAt()
+	t = &TimelineReviewRequestEvent{}
+	t.GetCreatedAt()
+	t = nil
+	t.GetCreatedAt()
+}
+
+func TestTimelineReviewRequestEvent_GetRequestedTeam(tt *testing.T) {
+	tt.Parallel()
+	t := &TimelineReviewRequestEvent{}
+	t.GetRequestedTeam()
+	t = nil
+	t.GetRequestedTeam()
+}
+
+func TestTimelineReviewRequestEvent_GetRequester(tt *testing.T) {
+	tt.Parallel()
+	t := &TimelineReviewRequestEvent{}
+	t.GetRequester()
+	t = nil
+	t.GetRequester()
+}
+
+func TestTimelineReviewRequestEvent_GetReviewer(tt *testing.T) {
+	tt.Parallel()
+	t := &TimelineReviewRequestEvent{}
+	t.GetReviewer()
+	t = nil
+	t.GetReviewer()
+}
+
 func TestTool_GetGUID(tt *testing.T) {
 	tt.Parallel()
 	var zeroValue string
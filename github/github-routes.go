@@ -0,0 +1,637 @@
+// Copyright 2026 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by gen-routes; DO NOT EDIT.
+// Instead, please run "go generate ./..." as described here:
+// https://github.com/google/go-github/blob/master/CONTRIBUTING.md#submitting-a-patch
+
+package github
+
+// routes lists the path templates, relative to the API root, of the endpoints
+// used by the methods of this package.
+var routes = []string{
+	"admin/ldap/teams/{team_id}/mapping",
+	"admin/ldap/users/{username}/mapping",
+	"admin/organizations",
+	"admin/organizations/{org}",
+	"admin/users",
+	"admin/users/{username}",
+	"admin/users/{username}/authorizations",
+	"advisories",
+	"advisories/{ghsa_id}",
+	"app",
+	"app-manifests/{code}/conversions",
+	"app/hook/config",
+	"app/hook/deliveries",
+	"app/hook/deliveries/{delivery_id}",
+	"app/hook/deliveries/{delivery_id}/attempts",
+	"app/installation-requests",
+	"app/installations",
+	"app/installations/{installation_id}",
+	"app/installations/{installation_id}/access_tokens",
+	"app/installations/{installation_id}/suspended",
+	"applications/{client_id}/grant",
+	"applications/{client_id}/token",
+	"apps/{app_slug}",
+	"codes_of_conduct",
+	"codes_of_conduct/{key}",
+	"emojis",
+	"enterprise/settings/license",
+	"enterprise/stats/all",
+	"enterprises/{enterprise}/actions/cache/usage",
+	"enterprises/{enterprise}/actions/permissions",
+	"enterprises/{enterprise}/actions/permissions/organizations",
+	"enterprises/{enterprise}/actions/permissions/organizations/{org_id}",
+	"enterprises/{enterprise}/actions/permissions/selected-actions",
+	"enterprises/{enterprise}/actions/permissions/workflow",
+	"enterprises/{enterprise}/actions/runner-groups",
+	"enterprises/{enterprise}/actions/runner-groups/{runner_group_id}",
+	"enterprises/{enterprise}/actions/runner-groups/{runner_group_id}/organizations",
+	"enterprises/{enterprise}/actions/runner-groups/{runner_group_id}/organizations/{org_id}",
+	"enterprises/{enterprise}/actions/runner-groups/{runner_group_id}/runners",
+	"enterprises/{enterprise}/actions/runner-groups/{runner_group_id}/runners/{runner_id}",
+	"enterprises/{enterprise}/actions/runners",
+	"enterprises/{enterprise}/actions/runners/downloads",
+	"enterprises/{enterprise}/actions/runners/generate-jitconfig",
+	"enterprises/{enterprise}/actions/runners/registration-token",
+	"enterprises/{enterprise}/actions/runners/{runner_id}",
+	"enterprises/{enterprise}/audit-log",
+	"enterprises/{enterprise}/code_security_and_analysis",
+	"enterprises/{enterprise}/copilot/billing/seats",
+	"enterprises/{enterprise}/copilot/metrics",
+	"enterprises/{enterprise}/properties/schema",
+	"enterprises/{enterprise}/properties/schema/{custom_property_name}",
+	"enterprises/{enterprise}/rulesets",
+	"enterprises/{enterprise}/rulesets/{ruleset_id}",
+	"enterprises/{enterprise}/secret-scanning/alerts",
+	"enterprises/{enterprise}/team/{team_slug}/copilot/metrics",
+	"enterprises/{enterprise}/{security_product}/{enablement}",
+	"events",
+	"feeds",
+	"gists",
+	"gists/public",
+	"gists/starred",
+	"gists/{gist_id}",
+	"gists/{gist_id}/comments",
+	"gists/{gist_id}/comments/{comment_id}",
+	"gists/{gist_id}/commits",
+	"gists/{gist_id}/forks",
+	"gists/{gist_id}/star",
+	"gists/{gist_id}/{sha}",
+	"gitignore/templates",
+	"gitignore/templates/{name}",
+	"graphql",
+	"hub",
+	"installation/repositories",
+	"installation/token",
+	"issues",
+	"licenses",
+	"licenses/{license}",
+	"manage/v1/access/ssh",
+	"manage/v1/checks/system-requirements",
+	"manage/v1/cluster/status",
+	"manage/v1/config/apply",
+	"manage/v1/config/apply/events",
+	"manage/v1/config/init",
+	"manage/v1/config/license",
+	"manage/v1/config/license/check",
+	"manage/v1/config/nodes",
+	"manage/v1/config/settings",
+	"manage/v1/maintenance",
+	"manage/v1/replication/status",
+	"manage/v1/version",
+	"markdown",
+	"marketplace_listing/accounts/{account_id}",
+	"marketplace_listing/plans",
+	"marketplace_listing/plans/{plan_id}/accounts",
+	"marketplace_listing/stubbed/accounts/{account_id}",
+	"marketplace_listing/stubbed/plans",
+	"marketplace_listing/stubbed/plans/{plan_id}/accounts",
+	"meta",
+	"networks/{owner}/{repo}/events",
+	"notifications",
+	"notifications/threads/{thread_id}",
+	"notifications/threads/{thread_id}/subscription",
+	"octocat",
+	"organizations",
+	"organizations/{organization_id}",
+	"orgs/{org}",
+	"orgs/{org}/actions/cache/usage",
+	"orgs/{org}/actions/cache/usage-by-repository",
+	"orgs/{org}/actions/oidc/customization/sub",
+	"orgs/{org}/actions/permissions",
+	"orgs/{org}/actions/permissions/repositories",
+	"orgs/{org}/actions/permissions/repositories/{repository_id}",
+	"orgs/{org}/actions/permissions/selected-actions",
+	"orgs/{org}/actions/permissions/workflow",
+	"orgs/{org}/actions/required_workflows",
+	"orgs/{org}/actions/required_workflows/{workflow_id}",
+	"orgs/{org}/actions/required_workflows/{workflow_id}/repositories",
+	"orgs/{org}/actions/required_workflows/{workflow_id}/repositories/{repository_id}",
+	"orgs/{org}/actions/runner-groups",
+	"orgs/{org}/actions/runner-groups/{runner_group_id}",
+	"orgs/{org}/actions/runner-groups/{runner_group_id}/repositories",
+	"orgs/{org}/actions/runner-groups/{runner_group_id}/repositories/{repository_id}",
+	"orgs/{org}/actions/runner-groups/{runner_group_id}/runners",
+	"orgs/{org}/actions/runner-groups/{runner_group_id}/runners/{runner_id}",
+	"orgs/{org}/actions/runners",
+	"orgs/{org}/actions/runners/downloads",
+	"orgs/{org}/actions/runners/generate-jitconfig",
+	"orgs/{org}/actions/runners/registration-token",
+	"orgs/{org}/actions/runners/remove-token",
+	"orgs/{org}/actions/runners/{runner_id}",
+	"orgs/{org}/actions/secrets",
+	"orgs/{org}/actions/secrets/public-key",
+	"orgs/{org}/actions/secrets/{secret_name}",
+	"orgs/{org}/actions/secrets/{secret_name}/repositories",
+	"orgs/{org}/actions/secrets/{secret_name}/repositories/{repository_id}",
+	"orgs/{org}/actions/variables",
+	"orgs/{org}/actions/variables/{name}",
+	"orgs/{org}/actions/variables/{name}/repositories",
+	"orgs/{org}/actions/variables/{name}/repositories/{repository_id}",
+	"orgs/{org}/attestations/{subject_digest}",
+	"orgs/{org}/audit-log",
+	"orgs/{org}/blocks",
+	"orgs/{org}/blocks/{username}",
+	"orgs/{org}/code-scanning/alerts",
+	"orgs/{org}/code-security/configurations",
+	"orgs/{org}/code-security/configurations/defaults",
+	"orgs/{org}/code-security/configurations/detach",
+	"orgs/{org}/code-security/configurations/{configuration_id}",
+	"orgs/{org}/code-security/configurations/{configuration_id}/attach",
+	"orgs/{org}/code-security/configurations/{configuration_id}/defaults",
+	"orgs/{org}/code-security/configurations/{configuration_id}/repositories",
+	"orgs/{org}/codespaces/secrets",
+	"orgs/{org}/codespaces/secrets/public-key",
+	"orgs/{org}/codespaces/secrets/{secret_name}",
+	"orgs/{org}/codespaces/secrets/{secret_name}/repositories",
+	"orgs/{org}/codespaces/secrets/{secret_name}/repositories/{repository_id}",
+	"orgs/{org}/copilot/billing",
+	"orgs/{org}/copilot/billing/seats",
+	"orgs/{org}/copilot/billing/selected_teams",
+	"orgs/{org}/copilot/billing/selected_users",
+	"orgs/{org}/copilot/metrics",
+	"orgs/{org}/credential-authorizations",
+	"orgs/{org}/credential-authorizations/{credential_id}",
+	"orgs/{org}/custom-repository-roles",
+	"orgs/{org}/custom-repository-roles/{role_id}",
+	"orgs/{org}/dependabot/alerts",
+	"orgs/{org}/dependabot/secrets",
+	"orgs/{org}/dependabot/secrets/public-key",
+	"orgs/{org}/dependabot/secrets/{secret_name}",
+	"orgs/{org}/dependabot/secrets/{secret_name}/repositories",
+	"orgs/{org}/dependabot/secrets/{secret_name}/repositories/{repository_id}",
+	"orgs/{org}/events",
+	"orgs/{org}/external-group/{group_id}",
+	"orgs/{org}/external-groups",
+	"orgs/{org}/failed_invitations",
+	"orgs/{org}/hooks",
+	"orgs/{org}/hooks/{hook_id}",
+	"orgs/{org}/hooks/{hook_id}/config",
+	"orgs/{org}/hooks/{hook_id}/deliveries",
+	"orgs/{org}/hooks/{hook_id}/deliveries/{delivery_id}",
+	"orgs/{org}/hooks/{hook_id}/deliveries/{delivery_id}/attempts",
+	"orgs/{org}/hooks/{hook_id}/pings",
+	"orgs/{org}/installation",
+	"orgs/{org}/installations",
+	"orgs/{org}/interaction-limits",
+	"orgs/{org}/invitations",
+	"orgs/{org}/invitations/{invitation_id}",
+	"orgs/{org}/invitations/{invitation_id}/teams",
+	"orgs/{org}/issues",
+	"orgs/{org}/members",
+	"orgs/{org}/members/{username}",
+	"orgs/{org}/members/{username}/copilot",
+	"orgs/{org}/memberships/{username}",
+	"orgs/{org}/migrations",
+	"orgs/{org}/migrations/{migration_id}",
+	"orgs/{org}/migrations/{migration_id}/archive",
+	"orgs/{org}/migrations/{migration_id}/repos/{repo_name}/lock",
+	"orgs/{org}/organization-roles",
+	"orgs/{org}/organization-roles/teams/{team_slug}/{role_id}",
+	"orgs/{org}/organization-roles/users/{username}/{role_id}",
+	"orgs/{org}/organization-roles/{role_id}",
+	"orgs/{org}/organization-roles/{role_id}/teams",
+	"orgs/{org}/organization-roles/{role_id}/users",
+	"orgs/{org}/outside_collaborators",
+	"orgs/{org}/outside_collaborators/{username}",
+	"orgs/{org}/packages",
+	"orgs/{org}/packages/{package_type}/{package_name}",
+	"orgs/{org}/packages/{package_type}/{package_name}/restore",
+	"orgs/{org}/packages/{package_type}/{package_name}/versions",
+	"orgs/{org}/packages/{package_type}/{package_name}/versions/{package_version_id}",
+	"orgs/{org}/packages/{package_type}/{package_name}/versions/{package_version_id}/restore",
+	"orgs/{org}/personal-access-token-requests/{pat_request_id}",
+	"orgs/{org}/personal-access-tokens",
+	"orgs/{org}/properties/schema",
+	"orgs/{org}/properties/schema/{custom_property_name}",
+	"orgs/{org}/properties/values",
+	"orgs/{org}/public_members",
+	"orgs/{org}/public_members/{username}",
+	"orgs/{org}/repos",
+	"orgs/{org}/rulesets",
+	"orgs/{org}/rulesets/{ruleset_id}",
+	"orgs/{org}/secret-scanning/alerts",
+	"orgs/{org}/security-advisories",
+	"orgs/{org}/security-managers",
+	"orgs/{org}/security-managers/teams/{team_slug}",
+	"orgs/{org}/settings/billing/actions",
+	"orgs/{org}/settings/billing/advanced-security",
+	"orgs/{org}/settings/billing/packages",
+	"orgs/{org}/settings/billing/shared-storage",
+	"orgs/{org}/team-sync/groups",
+	"orgs/{org}/team/{team_slug}/copilot/metrics",
+	"orgs/{org}/teams",
+	"orgs/{org}/teams/{team_slug}",
+	"orgs/{org}/teams/{team_slug}/discussions",
+	"orgs/{org}/teams/{team_slug}/discussions/{discussion_number}",
+	"orgs/{org}/teams/{team_slug}/discussions/{discussion_number}/comments",
+	"orgs/{org}/teams/{team_slug}/discussions/{discussion_number}/comments/{comment_number}",
+	"orgs/{org}/teams/{team_slug}/discussions/{discussion_number}/comments/{comment_number}/reactions",
+	"orgs/{org}/teams/{team_slug}/discussions/{discussion_number}/comments/{comment_number}/reactions/{reaction_id}",
+	"orgs/{org}/teams/{team_slug}/discussions/{discussion_number}/reactions",
+	"orgs/{org}/teams/{team_slug}/discussions/{discussion_number}/reactions/{reaction_id}",
+	"orgs/{org}/teams/{team_slug}/external-groups",
+	"orgs/{org}/teams/{team_slug}/invitations",
+	"orgs/{org}/teams/{team_slug}/members",
+	"orgs/{org}/teams/{team_slug}/memberships/{username}",
+	"orgs/{org}/teams/{team_slug}/projects",
+	"orgs/{org}/teams/{team_slug}/projects/{project_id}",
+	"orgs/{org}/teams/{team_slug}/repos",
+	"orgs/{org}/teams/{team_slug}/repos/{owner}/{repo}",
+	"orgs/{org}/teams/{team_slug}/team-sync/group-mappings",
+	"orgs/{org}/teams/{team_slug}/teams",
+	"rate_limit",
+	"repos/{owner}/{repo}",
+	"repos/{owner}/{repo}/actions/artifacts",
+	"repos/{owner}/{repo}/actions/artifacts/{artifact_id}",
+	"repos/{owner}/{repo}/actions/artifacts/{artifact_id}/{archive_format}",
+	"repos/{owner}/{repo}/actions/cache/usage",
+	"repos/{owner}/{repo}/actions/caches",
+	"repos/{owner}/{repo}/actions/caches/{cache_id}",
+	"repos/{owner}/{repo}/actions/jobs/{job_id}",
+	"repos/{owner}/{repo}/actions/jobs/{job_id}/logs",
+	"repos/{owner}/{repo}/actions/jobs/{job_id}/rerun",
+	"repos/{owner}/{repo}/actions/oidc/customization/sub",
+	"repos/{owner}/{repo}/actions/organization-secrets",
+	"repos/{owner}/{repo}/actions/organization-variables",
+	"repos/{owner}/{repo}/actions/permissions",
+	"repos/{owner}/{repo}/actions/permissions/access",
+	"repos/{owner}/{repo}/actions/permissions/selected-actions",
+	"repos/{owner}/{repo}/actions/permissions/workflow",
+	"repos/{owner}/{repo}/actions/required_workflows",
+	"repos/{owner}/{repo}/actions/runners",
+	"repos/{owner}/{repo}/actions/runners/downloads",
+	"repos/{owner}/{repo}/actions/runners/generate-jitconfig",
+	"repos/{owner}/{repo}/actions/runners/registration-token",
+	"repos/{owner}/{repo}/actions/runners/remove-token",
+	"repos/{owner}/{repo}/actions/runners/{runner_id}",
+	"repos/{owner}/{repo}/actions/runs",
+	"repos/{owner}/{repo}/actions/runs/{run_id}",
+	"repos/{owner}/{repo}/actions/runs/{run_id}/artifacts",
+	"repos/{owner}/{repo}/actions/runs/{run_id}/attempts/{attempt_number}",
+	"repos/{owner}/{repo}/actions/runs/{run_id}/attempts/{attempt_number}/jobs",
+	"repos/{owner}/{repo}/actions/runs/{run_id}/attempts/{attempt_number}/logs",
+	"repos/{owner}/{repo}/actions/runs/{run_id}/cancel",
+	"repos/{owner}/{repo}/actions/runs/{run_id}/deployment_protection_rule",
+	"repos/{owner}/{repo}/actions/runs/{run_id}/jobs",
+	"repos/{owner}/{repo}/actions/runs/{run_id}/logs",
+	"repos/{owner}/{repo}/actions/runs/{run_id}/pending_deployments",
+	"repos/{owner}/{repo}/actions/runs/{run_id}/rerun",
+	"repos/{owner}/{repo}/actions/runs/{run_id}/rerun-failed-jobs",
+	"repos/{owner}/{repo}/actions/runs/{run_id}/timing",
+	"repos/{owner}/{repo}/actions/secrets",
+	"repos/{owner}/{repo}/actions/secrets/public-key",
+	"repos/{owner}/{repo}/actions/secrets/{secret_name}",
+	"repos/{owner}/{repo}/actions/variables",
+	"repos/{owner}/{repo}/actions/variables/{name}",
+	"repos/{owner}/{repo}/actions/workflows",
+	"repos/{owner}/{repo}/actions/workflows/{workflow_id}",
+	"repos/{owner}/{repo}/actions/workflows/{workflow_id}/disable",
+	"repos/{owner}/{repo}/actions/workflows/{workflow_id}/dispatches",
+	"repos/{owner}/{repo}/actions/workflows/{workflow_id}/enable",
+	"repos/{owner}/{repo}/actions/workflows/{workflow_id}/runs",
+	"repos/{owner}/{repo}/actions/workflows/{workflow_id}/timing",
+	"repos/{owner}/{repo}/assignees",
+	"repos/{owner}/{repo}/assignees/{assignee}",
+	"repos/{owner}/{repo}/attestations/{subject_digest}",
+	"repos/{owner}/{repo}/autolinks",
+	"repos/{owner}/{repo}/autolinks/{autolink_id}",
+	"repos/{owner}/{repo}/automated-security-fixes",
+	"repos/{owner}/{repo}/branches",
+	"repos/{owner}/{repo}/branches/{branch}",
+	"repos/{owner}/{repo}/branches/{branch}/protection",
+	"repos/{owner}/{repo}/branches/{branch}/protection/enforce_admins",
+	"repos/{owner}/{repo}/branches/{branch}/protection/required_pull_request_reviews",
+	"repos/{owner}/{repo}/branches/{branch}/protection/required_signatures",
+	"repos/{owner}/{repo}/branches/{branch}/protection/required_status_checks",
+	"repos/{owner}/{repo}/branches/{branch}/protection/required_status_checks/contexts",
+	"repos/{owner}/{repo}/branches/{branch}/protection/restrictions/apps",
+	"repos/{owner}/{repo}/branches/{branch}/protection/restrictions/teams",
+	"repos/{owner}/{repo}/branches/{branch}/protection/restrictions/users",
+	"repos/{owner}/{repo}/branches/{branch}/rename",
+	"repos/{owner}/{repo}/check-runs",
+	"repos/{owner}/{repo}/check-runs/{check_run_id}",
+	"repos/{owner}/{repo}/check-runs/{check_run_id}/annotations",
+	"repos/{owner}/{repo}/check-runs/{check_run_id}/rerequest",
+	"repos/{owner}/{repo}/check-suites",
+	"repos/{owner}/{repo}/check-suites/preferences",
+	"repos/{owner}/{repo}/check-suites/{check_suite_id}",
+	"repos/{owner}/{repo}/check-suites/{check_suite_id}/check-runs",
+	"repos/{owner}/{repo}/check-suites/{check_suite_id}/rerequest",
+	"repos/{owner}/{repo}/code-scanning/alerts",
+	"repos/{owner}/{repo}/code-scanning/alerts/{alert_number}",
+	"repos/{owner}/{repo}/code-scanning/alerts/{alert_number}/instances",
+	"repos/{owner}/{repo}/code-scanning/analyses",
+	"repos/{owner}/{repo}/code-scanning/analyses/{analysis_id}",
+	"repos/{owner}/{repo}/code-scanning/codeql/databases",
+	"repos/{owner}/{repo}/code-scanning/codeql/databases/{language}",
+	"repos/{owner}/{repo}/code-scanning/default-setup",
+	"repos/{owner}/{repo}/code-scanning/sarifs",
+	"repos/{owner}/{repo}/code-scanning/sarifs/{sarif_id}",
+	"repos/{owner}/{repo}/code-security-configuration",
+	"repos/{owner}/{repo}/codeowners/errors",
+	"repos/{owner}/{repo}/codespaces",
+	"repos/{owner}/{repo}/codespaces/secrets",
+	"repos/{owner}/{repo}/codespaces/secrets/public-key",
+	"repos/{owner}/{repo}/codespaces/secrets/{secret_name}",
+	"repos/{owner}/{repo}/collaborators",
+	"repos/{owner}/{repo}/collaborators/{username}",
+	"repos/{owner}/{repo}/collaborators/{username}/permission",
+	"repos/{owner}/{repo}/comments",
+	"repos/{owner}/{repo}/comments/{comment_id}",
+	"repos/{owner}/{repo}/comments/{comment_id}/reactions",
+	"repos/{owner}/{repo}/comments/{comment_id}/reactions/{reaction_id}",
+	"repos/{owner}/{repo}/commits",
+	"repos/{owner}/{repo}/commits/{commit_sha}/branches-where-head",
+	"repos/{owner}/{repo}/commits/{commit_sha}/comments",
+	"repos/{owner}/{repo}/commits/{commit_sha}/pulls",
+	"repos/{owner}/{repo}/commits/{ref}",
+	"repos/{owner}/{repo}/commits/{ref}/check-runs",
+	"repos/{owner}/{repo}/commits/{ref}/check-suites",
+	"repos/{owner}/{repo}/commits/{ref}/status",
+	"repos/{owner}/{repo}/commits/{ref}/statuses",
+	"repos/{owner}/{repo}/community/profile",
+	"repos/{owner}/{repo}/compare/{basehead}",
+	"repos/{owner}/{repo}/content_references/{content_reference_id}/attachments",
+	"repos/{owner}/{repo}/contents/{path}",
+	"repos/{owner}/{repo}/contributors",
+	"repos/{owner}/{repo}/dependabot/alerts",
+	"repos/{owner}/{repo}/dependabot/alerts/{alert_number}",
+	"repos/{owner}/{repo}/dependabot/secrets",
+	"repos/{owner}/{repo}/dependabot/secrets/public-key",
+	"repos/{owner}/{repo}/dependabot/secrets/{secret_name}",
+	"repos/{owner}/{repo}/dependency-graph/sbom",
+	"repos/{owner}/{repo}/dependency-graph/snapshots",
+	"repos/{owner}/{repo}/deployments",
+	"repos/{owner}/{repo}/deployments/{deployment_id}",
+	"repos/{owner}/{repo}/deployments/{deployment_id}/statuses",
+	"repos/{owner}/{repo}/deployments/{deployment_id}/statuses/{status_id}",
+	"repos/{owner}/{repo}/dispatches",
+	"repos/{owner}/{repo}/environments",
+	"repos/{owner}/{repo}/environments/{environment_name}",
+	"repos/{owner}/{repo}/environments/{environment_name}/deployment-branch-policies",
+	"repos/{owner}/{repo}/environments/{environment_name}/deployment-branch-policies/{branch_policy_id}",
+	"repos/{owner}/{repo}/environments/{environment_name}/deployment_protection_rules",
+	"repos/{owner}/{repo}/environments/{environment_name}/deployment_protection_rules/apps",
+	"repos/{owner}/{repo}/environments/{environment_name}/deployment_protection_rules/{protection_rule_id}",
+	"repos/{owner}/{repo}/environments/{environment_name}/variables",
+	"repos/{owner}/{repo}/environments/{environment_name}/variables/{name}",
+	"repos/{owner}/{repo}/events",
+	"repos/{owner}/{repo}/forks",
+	"repos/{owner}/{repo}/git/blobs",
+	"repos/{owner}/{repo}/git/blobs/{file_sha}",
+	"repos/{owner}/{repo}/git/commits",
+	"repos/{owner}/{repo}/git/commits/{commit_sha}",
+	"repos/{owner}/{repo}/git/matching-refs/{ref}",
+	"repos/{owner}/{repo}/git/ref/{ref}",
+	"repos/{owner}/{repo}/git/refs",
+	"repos/{owner}/{repo}/git/refs/{ref}",
+	"repos/{owner}/{repo}/git/tags",
+	"repos/{owner}/{repo}/git/tags/{tag_sha}",
+	"repos/{owner}/{repo}/git/trees",
+	"repos/{owner}/{repo}/git/trees/{tree_sha}",
+	"repos/{owner}/{repo}/hooks",
+	"repos/{owner}/{repo}/hooks/{hook_id}",
+	"repos/{owner}/{repo}/hooks/{hook_id}/config",
+	"repos/{owner}/{repo}/hooks/{hook_id}/deliveries",
+	"repos/{owner}/{repo}/hooks/{hook_id}/deliveries/{delivery_id}",
+	"repos/{owner}/{repo}/hooks/{hook_id}/deliveries/{delivery_id}/attempts",
+	"repos/{owner}/{repo}/hooks/{hook_id}/pings",
+	"repos/{owner}/{repo}/hooks/{hook_id}/tests",
+	"repos/{owner}/{repo}/import",
+	"repos/{owner}/{repo}/import/authors",
+	"repos/{owner}/{repo}/import/authors/{author_id}",
+	"repos/{owner}/{repo}/import/issues",
+	"repos/{owner}/{repo}/import/issues/{issue_number}",
+	"repos/{owner}/{repo}/import/large_files",
+	"repos/{owner}/{repo}/import/lfs",
+	"repos/{owner}/{repo}/installation",
+	"repos/{owner}/{repo}/interaction-limits",
+	"repos/{owner}/{repo}/invitations",
+	"repos/{owner}/{repo}/invitations/{invitation_id}",
+	"repos/{owner}/{repo}/issues",
+	"repos/{owner}/{repo}/issues/comments",
+	"repos/{owner}/{repo}/issues/comments/{comment_id}",
+	"repos/{owner}/{repo}/issues/comments/{comment_id}/reactions",
+	"repos/{owner}/{repo}/issues/comments/{comment_id}/reactions/{reaction_id}",
+	"repos/{owner}/{repo}/issues/events",
+	"repos/{owner}/{repo}/issues/events/{event_id}",
+	"repos/{owner}/{repo}/issues/{issue_number}",
+	"repos/{owner}/{repo}/issues/{issue_number}/assignees",
+	"repos/{owner}/{repo}/issues/{issue_number}/comments",
+	"repos/{owner}/{repo}/issues/{issue_number}/events",
+	"repos/{owner}/{repo}/issues/{issue_number}/labels",
+	"repos/{owner}/{repo}/issues/{issue_number}/labels/{name}",
+	"repos/{owner}/{repo}/issues/{issue_number}/lock",
+	"repos/{owner}/{repo}/issues/{issue_number}/reactions",
+	"repos/{owner}/{repo}/issues/{issue_number}/reactions/{reaction_id}",
+	"repos/{owner}/{repo}/issues/{issue_number}/timeline",
+	"repos/{owner}/{repo}/keys",
+	"repos/{owner}/{repo}/keys/{key_id}",
+	"repos/{owner}/{repo}/labels",
+	"repos/{owner}/{repo}/labels/{name}",
+	"repos/{owner}/{repo}/languages",
+	"repos/{owner}/{repo}/lfs",
+	"repos/{owner}/{repo}/license",
+	"repos/{owner}/{repo}/merge-upstream",
+	"repos/{owner}/{repo}/merges",
+	"repos/{owner}/{repo}/milestones",
+	"repos/{owner}/{repo}/milestones/{milestone_number}",
+	"repos/{owner}/{repo}/milestones/{milestone_number}/labels",
+	"repos/{owner}/{repo}/notifications",
+	"repos/{owner}/{repo}/pages",
+	"repos/{owner}/{repo}/pages/builds",
+	"repos/{owner}/{repo}/pages/builds/latest",
+	"repos/{owner}/{repo}/pages/builds/{build_id}",
+	"repos/{owner}/{repo}/pages/deployments",
+	"repos/{owner}/{repo}/pages/deployments/{pages_deployment_id}",
+	"repos/{owner}/{repo}/pages/deployments/{pages_deployment_id}/cancel",
+	"repos/{owner}/{repo}/pages/health",
+	"repos/{owner}/{repo}/pre-receive-hooks",
+	"repos/{owner}/{repo}/pre-receive-hooks/{pre_receive_hook_id}",
+	"repos/{owner}/{repo}/private-vulnerability-reporting",
+	"repos/{owner}/{repo}/properties/values",
+	"repos/{owner}/{repo}/pulls",
+	"repos/{owner}/{repo}/pulls/comments",
+	"repos/{owner}/{repo}/pulls/comments/{comment_id}",
+	"repos/{owner}/{repo}/pulls/comments/{comment_id}/reactions",
+	"repos/{owner}/{repo}/pulls/comments/{comment_id}/reactions/{reaction_id}",
+	"repos/{owner}/{repo}/pulls/{pull_number}",
+	"repos/{owner}/{repo}/pulls/{pull_number}/comments",
+	"repos/{owner}/{repo}/pulls/{pull_number}/commits",
+	"repos/{owner}/{repo}/pulls/{pull_number}/files",
+	"repos/{owner}/{repo}/pulls/{pull_number}/merge",
+	"repos/{owner}/{repo}/pulls/{pull_number}/requested_reviewers",
+	"repos/{owner}/{repo}/pulls/{pull_number}/reviews",
+	"repos/{owner}/{repo}/pulls/{pull_number}/reviews/{review_id}",
+	"repos/{owner}/{repo}/pulls/{pull_number}/reviews/{review_id}/comments",
+	"repos/{owner}/{repo}/pulls/{pull_number}/reviews/{review_id}/dismissals",
+	"repos/{owner}/{repo}/pulls/{pull_number}/reviews/{review_id}/events",
+	"repos/{owner}/{repo}/pulls/{pull_number}/update-branch",
+	"repos/{owner}/{repo}/readme",
+	"repos/{owner}/{repo}/releases",
+	"repos/{owner}/{repo}/releases/assets/{asset_id}",
+	"repos/{owner}/{repo}/releases/generate-notes",
+	"repos/{owner}/{repo}/releases/latest",
+	"repos/{owner}/{repo}/releases/tags/{tag}",
+	"repos/{owner}/{repo}/releases/{release_id}",
+	"repos/{owner}/{repo}/releases/{release_id}/assets",
+	"repos/{owner}/{repo}/releases/{release_id}/reactions",
+	"repos/{owner}/{repo}/releases/{release_id}/reactions/{reaction_id}",
+	"repos/{owner}/{repo}/rules/branches/{branch}",
+	"repos/{owner}/{repo}/rulesets",
+	"repos/{owner}/{repo}/rulesets/{ruleset_id}",
+	"repos/{owner}/{repo}/secret-scanning/alerts",
+	"repos/{owner}/{repo}/secret-scanning/alerts/{alert_number}",
+	"repos/{owner}/{repo}/secret-scanning/alerts/{alert_number}/locations",
+	"repos/{owner}/{repo}/security-advisories",
+	"repos/{owner}/{repo}/security-advisories/{ghsa_id}/cve",
+	"repos/{owner}/{repo}/security-advisories/{ghsa_id}/forks",
+	"repos/{owner}/{repo}/stargazers",
+	"repos/{owner}/{repo}/stats/code_frequency",
+	"repos/{owner}/{repo}/stats/commit_activity",
+	"repos/{owner}/{repo}/stats/contributors",
+	"repos/{owner}/{repo}/stats/participation",
+	"repos/{owner}/{repo}/stats/punch_card",
+	"repos/{owner}/{repo}/statuses/{sha}",
+	"repos/{owner}/{repo}/subscribers",
+	"repos/{owner}/{repo}/subscription",
+	"repos/{owner}/{repo}/tags",
+	"repos/{owner}/{repo}/tags/protection",
+	"repos/{owner}/{repo}/tags/protection/{tag_protection_id}",
+	"repos/{owner}/{repo}/tarball/{ref}",
+	"repos/{owner}/{repo}/teams",
+	"repos/{owner}/{repo}/topics",
+	"repos/{owner}/{repo}/traffic/clones",
+	"repos/{owner}/{repo}/traffic/popular/paths",
+	"repos/{owner}/{repo}/traffic/popular/referrers",
+	"repos/{owner}/{repo}/traffic/views",
+	"repos/{owner}/{repo}/transfer",
+	"repos/{owner}/{repo}/vulnerability-alerts",
+	"repos/{owner}/{repo}/zipball/{ref}",
+	"repos/{template_owner}/{template_repo}/generate",
+	"repositories",
+	"repositories/{repository_id}",
+	"repositories/{repository_id}/environments/{environment_name}/secrets",
+	"repositories/{repository_id}/environments/{environment_name}/secrets/public-key",
+	"repositories/{repository_id}/environments/{environment_name}/secrets/{secret_name}",
+	"repositories/{repository_id}/installation",
+	"scim/v2/organizations/{org}/Users",
+	"scim/v2/organizations/{org}/Users/{scim_user_id}",
+	"search/code",
+	"search/commits",
+	"search/issues",
+	"search/labels",
+	"search/repositories",
+	"search/topics",
+	"search/users",
+	"teams/{team_id}/discussions/{discussion_number}/comments/{comment_number}/reactions",
+	"teams/{team_id}/discussions/{discussion_number}/reactions",
+	"user",
+	"user/blocks",
+	"user/blocks/{username}",
+	"user/codespaces",
+	"user/codespaces/secrets",
+	"user/codespaces/secrets/public-key",
+	"user/codespaces/secrets/{secret_name}",
+	"user/codespaces/secrets/{secret_name}/repositories",
+	"user/codespaces/secrets/{secret_name}/repositories/{repository_id}",
+	"user/codespaces/{codespace_name}",
+	"user/codespaces/{codespace_name}/start",
+	"user/codespaces/{codespace_name}/stop",
+	"user/email/visibility",
+	"user/emails",
+	"user/followers",
+	"user/following",
+	"user/following/{username}",
+	"user/gpg_keys",
+	"user/gpg_keys/{gpg_key_id}",
+	"user/installations",
+	"user/installations/{installation_id}/repositories",
+	"user/installations/{installation_id}/repositories/{repository_id}",
+	"user/interaction-limits",
+	"user/issues",
+	"user/keys",
+	"user/keys/{key_id}",
+	"user/marketplace_purchases",
+	"user/marketplace_purchases/stubbed",
+	"user/memberships/orgs",
+	"user/memberships/orgs/{org}",
+	"user/migrations",
+	"user/migrations/{migration_id}",
+	"user/migrations/{migration_id}/archive",
+	"user/migrations/{migration_id}/repos/{repo_name}/lock",
+	"user/orgs",
+	"user/packages",
+	"user/packages/{package_type}/{package_name}",
+	"user/packages/{package_type}/{package_name}/restore",
+	"user/packages/{package_type}/{package_name}/versions",
+	"user/packages/{package_type}/{package_name}/versions/{package_version_id}",
+	"user/packages/{package_type}/{package_name}/versions/{package_version_id}/restore",
+	"user/repos",
+	"user/repository_invitations",
+	"user/repository_invitations/{invitation_id}",
+	"user/ssh_signing_keys",
+	"user/ssh_signing_keys/{ssh_signing_key_id}",
+	"user/starred",
+	"user/starred/{owner}/{repo}",
+	"user/subscriptions",
+	"user/teams",
+	"user/{account_id}",
+	"users",
+	"users/{username}",
+	"users/{username}/attestations/{subject_digest}",
+	"users/{username}/events",
+	"users/{username}/events/orgs/{org}",
+	"users/{username}/events/public",
+	"users/{username}/followers",
+	"users/{username}/following",
+	"users/{username}/following/{target_user}",
+	"users/{username}/gists",
+	"users/{username}/gpg_keys",
+	"users/{username}/hovercard",
+	"users/{username}/installation",
+	"users/{username}/keys",
+	"users/{username}/orgs",
+	"users/{username}/packages",
+	"users/{username}/packages/{package_type}/{package_name}",
+	"users/{username}/packages/{package_type}/{package_name}/restore",
+	"users/{username}/packages/{package_type}/{package_name}/versions",
+	"users/{username}/packages/{package_type}/{package_name}/versions/{package_version_id}",
+	"users/{username}/packages/{package_type}/{package_name}/versions/{package_version_id}/restore",
+	"users/{username}/received_events",
+	"users/{username}/received_events/public",
+	"users/{username}/repos",
+	"users/{username}/settings/billing/actions",
+	"users/{username}/settings/billing/packages",
+	"users/{username}/settings/billing/shared-storage",
+	"users/{username}/site_admin",
+	"users/{username}/ssh_signing_keys",
+	"users/{username}/starred",
+	"users/{username}/subscriptions",
+	"users/{username}/suspended",
+	"zen",
+}
@@ -540,14 +540,15 @@ func TestGistCommit_String(t *testing.T) {
 func TestGistFile_String(t *testing.T) {
 	t.Parallel()
 	v := GistFile{
-		Size:     Ptr(0),
-		Filename: Ptr(""),
-		Language: Ptr(""),
-		Type:     Ptr(""),
-		RawURL:   Ptr(""),
-		Content:  Ptr(""),
+		Size:      Ptr(0),
+		Filename:  Ptr(""),
+		Language:  Ptr(""),
+		Type:      Ptr(""),
+		RawURL:    Ptr(""),
+		Content:   Ptr(""),
+		Truncated: Ptr(false),
 	}
-	want := `github.GistFile{Size:0, Filename:"", Language:"", Type:"", RawURL:"", Content:""}`
+	want := `github.GistFile{Size:0, Filename:"", Language:"", Type:"", RawURL:"", Content:"", Truncated:false}`
 	if got := v.String(); got != want {
 		t.Errorf("GistFile.String = %v, want %v", got, want)
 	}
@@ -845,6 +846,19 @@ func TestIssueComment_String(t *testing.T) {
 	}
 }
 
+func TestIssueReference_String(t *testing.T) {
+	t.Parallel()
+	v := IssueReference{
+		Owner:  "",
+		Repo:   "",
+		Number: 0,
+	}
+	want := `github.IssueReference{Owner:"", Repo:"", Number:0}`
+	if got := v.String(); got != want {
+		t.Errorf("IssueReference.String = %v, want %v", got, want)
+	}
+}
+
 func TestIssueStats_String(t *testing.T) {
 	t.Parallel()
 	v := IssueStats{
@@ -6,6 +6,7 @@
 //go:generate go run gen-accessors.go
 //go:generate go run gen-stringify-test.go
 //go:generate ../script/metadata.sh update-go
+//go:generate go run gen-routes.go
 
 package github
 
@@ -16,6 +17,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"mime"
 	"net/http"
 	"net/url"
 	"reflect"
@@ -46,6 +48,18 @@ const (
 
 	headerTokenExpiration = "Github-Authentication-Token-Expiration"
 
+	headerOAuthScopes         = "X-Oauth-Scopes"
+	headerAcceptedOAuthScopes = "X-Accepted-Oauth-Scopes"
+
+	headerPollInterval = "X-Poll-Interval"
+
+	headerContentDisposition = "Content-Disposition"
+
+	headerSSO = "X-Github-Sso"
+
+	headerDeprecation = "Deprecation"
+	headerSunset      = "Sunset"
+
 	mediaTypeV3                = "application/vnd.github.v3+json"
 	defaultMediaType           = "application/octet-stream"
 	mediaTypeV3SHA             = "application/vnd.github.v3.sha"
@@ -183,6 +197,40 @@ type Client struct {
 	// Whether to respect rate limit headers on endpoints that return 302 redirections to artifacts
 	RateLimitRedirectionalEndpoints bool
 
+	// defaultPerPage is the per_page value sent to list endpoints whose
+	// ListOptions don't specify one.
+	defaultPerPage int
+
+	// canonicalJSON makes NewRequest encode JSON bodies with sorted object keys.
+	canonicalJSON bool
+
+	// maxRequestBodySize, if positive, is the largest JSON body NewRequest accepts.
+	maxRequestBodySize int
+
+	// apiVersion, if set, is sent as the X-GitHub-Api-Version header instead
+	// of defaultAPIVersion.
+	apiVersion string
+
+	// basePath, if set, is inserted between BaseURL or UploadURL and the
+	// relative URL of every request. It has no leading slash and one
+	// trailing slash, such as "proxy/github/".
+	basePath string
+
+	// searchCompletionCheck makes SearchService methods return an
+	// *IncompleteSearchError for incomplete results.
+	searchCompletionCheck bool
+
+	// rateLimitFloor, if positive, is the number of requests of each rate
+	// limit category the client keeps in reserve.
+	rateLimitFloor int
+
+	// metrics, if set, receives observations about requests and rate limits.
+	metrics Metrics
+
+	// retryBudget, if set, limits how often the client retries requests. It is
+	// shared with the clients copied from this one.
+	retryBudget *retryBudget
+
 	common service // Reuse a single struct instead of allocating one for each service on the heap.
 
 	// Services used for talking to different parts of the GitHub API.
@@ -213,6 +261,7 @@ type Client struct {
 	Meta               *MetaService
 	Migrations         *MigrationService
 	Organizations      *OrganizationsService
+	ProjectsV2         *ProjectsV2Service
 	PullRequests       *PullRequestsService
 	RateLimit          *RateLimitService
 	Reactions          *ReactionsService
@@ -283,6 +332,9 @@ type UploadOptions struct {
 	MediaType string `url:"-"`
 }
 
+// maxPerPage is the largest page size accepted by the GitHub API.
+const maxPerPage = 100
+
 // RawType represents type of raw format of a request instead of JSON.
 type RawType uint8
 
@@ -321,6 +373,90 @@ func addOptions(s string, opts interface{}) (string, error) {
 	return u.String(), nil
 }
 
+// addOptions is like the addOptions function, but if opts is or embeds
+// ListOptions with PerPage unset, it also sets the per_page query parameter
+// to the client's default, if any. opts may be a nil pointer, which is
+// treated as unset options of its type.
+func (c *Client) addOptions(s string, opts interface{}) (string, error) {
+	s, err := addOptions(s, opts)
+	if err != nil || c.defaultPerPage <= 0 || !hasUnsetPerPage(reflect.ValueOf(opts)) {
+		return s, err
+	}
+
+	u, err := url.Parse(s)
+	if err != nil {
+		return s, err
+	}
+	q := u.Query()
+	q.Set("per_page", strconv.Itoa(c.defaultPerPage))
+	u.RawQuery = q.Encode()
+	return u.String(), nil
+}
+
+var listOptionsType = reflect.TypeOf(ListOptions{})
+
+// hasUnsetPerPage reports whether v is, or points to, ListOptions or a struct
+// embedding ListOptions, possibly indirectly, whose PerPage is zero.
+func hasUnsetPerPage(v reflect.Value) bool {
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return embedsListOptions(v.Type().Elem())
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return false
+	}
+	if v.Type() == listOptionsType {
+		return v.Interface().(ListOptions).PerPage == 0
+	}
+	for i := 0; i < v.NumField(); i++ {
+		if v.Type().Field(i).Anonymous && hasUnsetPerPage(v.Field(i)) {
+			return true
+		}
+	}
+	return false
+}
+
+// embedsListOptions reports whether t is, or points to, ListOptions or a
+// struct embedding ListOptions, possibly indirectly.
+func embedsListOptions(t reflect.Type) bool {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == listOptionsType {
+		return true
+	}
+	if t.Kind() != reflect.Struct {
+		return false
+	}
+	for i := 0; i < t.NumField(); i++ {
+		if f := t.Field(i); f.Anonymous && embedsListOptions(f.Type) {
+			return true
+		}
+	}
+	return false
+}
+
+// canonicalizeJSON re-encodes the JSON document data with the keys of every
+// object sorted. Numbers are preserved exactly as written.
+func canonicalizeJSON(data []byte) ([]byte, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+
+	buf := &bytes.Buffer{}
+	enc := json.NewEncoder(buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
 // NewClient returns a new GitHub API client. If a nil httpClient is
 // provided, a new http.Client will be used. To use API methods which require
 // authentication, either use Client.WithAuthToken or provide NewClient with
@@ -354,6 +490,79 @@ func (c *Client) WithAuthToken(token string) *Client {
 	return c2
 }
 
+// TokenSource supplies the access tokens used by a client configured with
+// WithTokenSource, such as GitHub App installation tokens or tokens obtained
+// through an OIDC exchange.
+type TokenSource interface {
+	// Token returns a token along with the time it expires. A zero expiresAt
+	// means the token does not expire.
+	Token(ctx context.Context) (token string, expiresAt time.Time, err error)
+}
+
+// defaultTokenRefreshSkew is how long before expiry WithTokenSource refreshes
+// a token when no skew is given.
+const defaultTokenRefreshSkew = time.Minute
+
+// WithTokenSource returns a copy of the client that sets the Authorization
+// header of each request to a token from ts. The token is cached and ts is
+// only asked for a new one once the cached token is within refreshSkew of its
+// expiry, so long-running programs can keep using the same client as tokens
+// rotate. If refreshSkew is zero or negative, one minute is used. The client
+// may be used concurrently; at most one refresh is in flight at a time.
+func (c *Client) WithTokenSource(ts TokenSource, refreshSkew time.Duration) *Client {
+	if refreshSkew <= 0 {
+		refreshSkew = defaultTokenRefreshSkew
+	}
+	cache := &cachedTokenSource{src: ts, skew: refreshSkew}
+
+	c2 := c.copy()
+	defer c2.initialize()
+	transport := c2.client.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	c2.client.Transport = roundTripperFunc(
+		func(req *http.Request) (*http.Response, error) {
+			token, err := cache.token(req.Context())
+			if err != nil {
+				return nil, err
+			}
+			req = req.Clone(req.Context())
+			req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+			return transport.RoundTrip(req)
+		},
+	)
+	return c2
+}
+
+// cachedTokenSource caches the token returned by a TokenSource until it is
+// within skew of its expiry.
+type cachedTokenSource struct {
+	src  TokenSource
+	skew time.Duration
+
+	mu        sync.Mutex
+	cached    string
+	expiresAt time.Time
+	valid     bool
+}
+
+func (s *cachedTokenSource) token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.valid && (s.expiresAt.IsZero() || time.Until(s.expiresAt) > s.skew) {
+		return s.cached, nil
+	}
+
+	token, expiresAt, err := s.src.Token(ctx)
+	if err != nil {
+		return "", err
+	}
+	s.cached, s.expiresAt, s.valid = token, expiresAt, true
+	return token, nil
+}
+
 // WithEnterpriseURLs returns a copy of the client configured to use the provided base and
 // upload URLs. If the base URL does not have the suffix "/api/v3/", it will be added
 // automatically. If the upload URL does not have the suffix "/api/uploads", it will be
@@ -399,6 +608,117 @@ func (c *Client) WithEnterpriseURLs(baseURL, uploadURL string) (*Client, error)
 	return c2, nil
 }
 
+// WithDefaultPerPage returns a copy of the client that requests perPage results
+// per page from list endpoints whose options are or embed ListOptions whenever
+// the caller leaves ListOptions.PerPage unset, including when the options are
+// nil. Explicitly set values are never overridden, and other requests are not
+// changed. perPage is capped at 100, the maximum allowed by GitHub, and a
+// value of zero or less disables the default.
+func (c *Client) WithDefaultPerPage(perPage int) *Client {
+	c2 := c.copy()
+	defer c2.initialize()
+	c2.defaultPerPage = min(max(perPage, 0), maxPerPage)
+	return c2
+}
+
+// WithCanonicalJSON returns a copy of the client that, if enabled is true,
+// encodes JSON request bodies canonically: object keys are sorted at every
+// level, including within struct fields and json.RawMessage values that would
+// otherwise be sent as given. Identical requests then have byte-for-byte
+// identical bodies, which allows them to be signed, hashed, or cached.
+func (c *Client) WithCanonicalJSON(enabled bool) *Client {
+	c2 := c.copy()
+	defer c2.initialize()
+	c2.canonicalJSON = enabled
+	return c2
+}
+
+// WithMaxRequestBodySize returns a copy of the client whose NewRequest returns
+// a *RequestTooLargeError, instead of a request, when the encoded JSON body is
+// larger than n bytes. This turns the generic error responses GitHub returns
+// for oversized bodies, such as large trees passed to GitService.CreateTree,
+// into an error that names the endpoint before anything is sent. A value of
+// zero or less removes the limit.
+func (c *Client) WithMaxRequestBodySize(n int) *Client {
+	c2 := c.copy()
+	defer c2.initialize()
+	c2.maxRequestBodySize = max(n, 0)
+	return c2
+}
+
+// WithAPIVersion returns a copy of the client that sends version, such as
+// "2022-11-28", as the X-GitHub-Api-Version header of every request, pinning
+// the REST API behavior the caller depends on. An empty version restores the
+// default, which is the version the types in this package are modeled on.
+// Individual requests can still override it with the WithVersion
+// RequestOption.
+func (c *Client) WithAPIVersion(version string) *Client {
+	c2 := c.copy()
+	defer c2.initialize()
+	c2.apiVersion = version
+	return c2
+}
+
+// WithBasePath returns a copy of the client that inserts prefix, such as
+// "proxy/github", between BaseURL or UploadURL and the path of every request
+// made with a relative URL, for APIs served behind a reverse proxy that adds
+// a path prefix. Leading, trailing, and repeated slashes in prefix are
+// ignored, and an empty prefix removes a previously set one. Requests made
+// with an absolute URL, such as the UploadURL of a release, are not changed.
+func (c *Client) WithBasePath(prefix string) *Client {
+	c2 := c.copy()
+	defer c2.initialize()
+	var segments []string
+	for _, segment := range strings.Split(prefix, "/") {
+		if segment != "" {
+			segments = append(segments, segment)
+		}
+	}
+	c2.basePath = ""
+	if len(segments) > 0 {
+		c2.basePath = strings.Join(segments, "/") + "/"
+	}
+	return c2
+}
+
+// WithSearchCompletionCheck returns a copy of the client whose SearchService
+// methods, if enabled is true, return an *IncompleteSearchError holding the
+// partial results when GitHub reports them as incomplete, such as when a
+// search times out, instead of returning the partial results as if they were
+// complete. It is disabled by default.
+func (c *Client) WithSearchCompletionCheck(enabled bool) *Client {
+	c2 := c.copy()
+	defer c2.initialize()
+	c2.searchCompletionCheck = enabled
+	return c2
+}
+
+// WithRateLimitFloor returns a copy of the client that keeps n requests of
+// each rate limit in reserve, so that services sharing a token leave headroom
+// for critical operations. Before each request, the client checks the last
+// remaining count it observed for the rate limit category of the endpoint,
+// such as "core" or "search", and if it is below n, either returns a
+// *RateLimitFloorError without making the request or, if the request context
+// carries SleepUntilPrimaryRateLimitResetWhenRateLimited, waits until the rate
+// limit resets. Requests whose context carries BypassRateLimitCheck, such as
+// those of RateLimitService.Get, are not affected. A value of zero or less
+// removes the floor.
+func (c *Client) WithRateLimitFloor(n int) *Client {
+	c2 := c.copy()
+	defer c2.initialize()
+	c2.rateLimitFloor = max(n, 0)
+	return c2
+}
+
+// resolveURL resolves urlStr relative to base, which is BaseURL or
+// UploadURL, followed by the client's base path, if any.
+func (c *Client) resolveURL(base *url.URL, urlStr string) (*url.URL, error) {
+	if c.basePath != "" {
+		base = base.JoinPath(c.basePath)
+	}
+	return base.Parse(urlStr)
+}
+
 // initialize sets default values and initializes services.
 func (c *Client) initialize() {
 	if c.client == nil {
@@ -449,6 +769,7 @@ func (c *Client) initialize() {
 	c.Meta = (*MetaService)(&c.common)
 	c.Migrations = (*MigrationService)(&c.common)
 	c.Organizations = (*OrganizationsService)(&c.common)
+	c.ProjectsV2 = (*ProjectsV2Service)(&c.common)
 	c.PullRequests = (*PullRequestsService)(&c.common)
 	c.RateLimit = (*RateLimitService)(&c.common)
 	c.Reactions = (*ReactionsService)(&c.common)
@@ -472,6 +793,15 @@ func (c *Client) copy() *Client {
 		UploadURL:                       c.UploadURL,
 		RateLimitRedirectionalEndpoints: c.RateLimitRedirectionalEndpoints,
 		secondaryRateLimitReset:         c.secondaryRateLimitReset,
+		defaultPerPage:                  c.defaultPerPage,
+		canonicalJSON:                   c.canonicalJSON,
+		maxRequestBodySize:              c.maxRequestBodySize,
+		apiVersion:                      c.apiVersion,
+		basePath:                        c.basePath,
+		searchCompletionCheck:           c.searchCompletionCheck,
+		rateLimitFloor:                  c.rateLimitFloor,
+		metrics:                         c.metrics,
+		retryBudget:                     c.retryBudget,
 	}
 	c.clientMu.Unlock()
 	if c.client != nil {
@@ -506,6 +836,14 @@ func NewEnterpriseClient(baseURL, uploadURL string, httpClient *http.Client) (*C
 	return NewClient(httpClient).WithEnterpriseURLs(baseURL, uploadURL)
 }
 
+// requestAPIVersion returns the API version sent with requests made by c.
+func (c *Client) requestAPIVersion() string {
+	if c.apiVersion != "" {
+		return c.apiVersion
+	}
+	return defaultAPIVersion
+}
+
 // RequestOption represents an option that can modify an http.Request.
 type RequestOption func(req *http.Request)
 
@@ -518,6 +856,31 @@ func WithVersion(version string) RequestOption {
 	}
 }
 
+// WithMediaType adds the media types of the given API previews to the Accept
+// header of this individual request, keeping any media types already present.
+// Each preview is given by name, such as "machine-man-preview", and is sent as
+// "application/vnd.github.<preview>+json"; values that already start with
+// "application/" are sent unchanged.
+func WithMediaType(previews ...string) RequestOption {
+	return func(req *http.Request) {
+		var accept []string
+		if v := req.Header.Get("Accept"); v != "" {
+			accept = append(accept, v)
+		}
+		for _, p := range previews {
+			switch {
+			case p == "":
+				continue
+			case strings.HasPrefix(p, "application/"):
+				accept = append(accept, p)
+			default:
+				accept = append(accept, "application/vnd.github."+p+"+json")
+			}
+		}
+		req.Header.Set("Accept", strings.Join(accept, ", "))
+	}
+}
+
 // NewRequest creates an API request. A relative URL can be provided in urlStr,
 // in which case it is resolved relative to the BaseURL of the Client.
 // Relative URLs should always be specified without a preceding slash. If
@@ -528,7 +891,11 @@ func (c *Client) NewRequest(method, urlStr string, body interface{}, opts ...Req
 		return nil, fmt.Errorf("baseURL must have a trailing slash, but %q does not", c.BaseURL)
 	}
 
-	u, err := c.BaseURL.Parse(urlStr)
+	if err := checkRepoSlug(urlStr); err != nil {
+		return nil, err
+	}
+
+	u, err := c.resolveURL(c.BaseURL, urlStr)
 	if err != nil {
 		return nil, err
 	}
@@ -542,6 +909,16 @@ func (c *Client) NewRequest(method, urlStr string, body interface{}, opts ...Req
 		if err != nil {
 			return nil, err
 		}
+		if c.canonicalJSON {
+			b, err := canonicalizeJSON(buf.(*bytes.Buffer).Bytes())
+			if err != nil {
+				return nil, err
+			}
+			buf = bytes.NewBuffer(b)
+		}
+		if size := buf.(*bytes.Buffer).Len(); c.maxRequestBodySize > 0 && size > c.maxRequestBodySize {
+			return nil, &RequestTooLargeError{Method: method, URL: u, Size: size, Limit: c.maxRequestBodySize}
+		}
 	}
 
 	req, err := http.NewRequest(method, u.String(), buf)
@@ -556,7 +933,7 @@ func (c *Client) NewRequest(method, urlStr string, body interface{}, opts ...Req
 	if c.UserAgent != "" {
 		req.Header.Set("User-Agent", c.UserAgent)
 	}
-	req.Header.Set(headerAPIVersion, defaultAPIVersion)
+	req.Header.Set(headerAPIVersion, c.requestAPIVersion())
 
 	for _, opt := range opts {
 		opt(req)
@@ -570,16 +947,34 @@ func (c *Client) NewRequest(method, urlStr string, body interface{}, opts ...Req
 // Relative URLs should always be specified without a preceding slash.
 // Body is sent with Content-Type: application/x-www-form-urlencoded.
 func (c *Client) NewFormRequest(urlStr string, body io.Reader, opts ...RequestOption) (*http.Request, error) {
+	return c.newFormRequest(http.MethodPost, urlStr, body, opts...)
+}
+
+// NewFormValuesRequest creates an API request with the given method whose body
+// is form encoded with Content-Type: application/x-www-form-urlencoded. It is
+// useful for the few endpoints that do not accept JSON. A relative URL can be
+// provided in urlStr, in which case it is resolved relative to the BaseURL of
+// the Client. Relative URLs should always be specified without a preceding
+// slash. If form is nil, the request has no body.
+func (c *Client) NewFormValuesRequest(method, urlStr string, form url.Values, opts ...RequestOption) (*http.Request, error) {
+	var body io.Reader
+	if form != nil {
+		body = strings.NewReader(form.Encode())
+	}
+	return c.newFormRequest(method, urlStr, body, opts...)
+}
+
+func (c *Client) newFormRequest(method, urlStr string, body io.Reader, opts ...RequestOption) (*http.Request, error) {
 	if !strings.HasSuffix(c.BaseURL.Path, "/") {
 		return nil, fmt.Errorf("baseURL must have a trailing slash, but %q does not", c.BaseURL)
 	}
 
-	u, err := c.BaseURL.Parse(urlStr)
+	u, err := c.resolveURL(c.BaseURL, urlStr)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, u.String(), body)
+	req, err := http.NewRequest(method, u.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -589,7 +984,7 @@ func (c *Client) NewFormRequest(urlStr string, body io.Reader, opts ...RequestOp
 	if c.UserAgent != "" {
 		req.Header.Set("User-Agent", c.UserAgent)
 	}
-	req.Header.Set(headerAPIVersion, defaultAPIVersion)
+	req.Header.Set(headerAPIVersion, c.requestAPIVersion())
 
 	for _, opt := range opts {
 		opt(req)
@@ -605,7 +1000,7 @@ func (c *Client) NewUploadRequest(urlStr string, reader io.Reader, size int64, m
 	if !strings.HasSuffix(c.UploadURL.Path, "/") {
 		return nil, fmt.Errorf("uploadURL must have a trailing slash, but %q does not", c.UploadURL)
 	}
-	u, err := c.UploadURL.Parse(urlStr)
+	u, err := c.resolveURL(c.UploadURL, urlStr)
 	if err != nil {
 		return nil, err
 	}
@@ -623,7 +1018,7 @@ func (c *Client) NewUploadRequest(urlStr string, reader io.Reader, size int64, m
 	req.Header.Set("Content-Type", mediaType)
 	req.Header.Set("Accept", mediaTypeV3)
 	req.Header.Set("User-Agent", c.UserAgent)
-	req.Header.Set(headerAPIVersion, defaultAPIVersion)
+	req.Header.Set(headerAPIVersion, c.requestAPIVersion())
 
 	for _, opt := range opts {
 		opt(req)
@@ -679,6 +1074,56 @@ type Response struct {
 	// token's expiration date. Timestamp is 0001-01-01 when token doesn't expire.
 	// So it is valid for TokenExpiration.Equal(Timestamp{}) or TokenExpiration.Time.After(time.Now())
 	TokenExpiration Timestamp
+
+	// TokenScopes lists the OAuth scopes granted to the token used for the
+	// request, as reported by the X-OAuth-Scopes header.
+	TokenScopes []string
+
+	// RequiredScopes lists the OAuth scopes that the endpoint accepts, as
+	// reported by the X-Accepted-OAuth-Scopes header.
+	RequiredScopes []string
+
+	// PollInterval is how long GitHub asks clients to wait before polling
+	// the endpoint again, as reported by the X-Poll-Interval header of
+	// endpoints such as those of ActivityService that list events. It is zero
+	// if the response did not include the header.
+	PollInterval time.Duration
+
+	// SuggestedFilename is the file name the server suggests saving the
+	// response body as, parsed from the Content-Disposition header of
+	// downloads such as artifacts, archives, and release assets. RFC 5987
+	// encoded filename* parameters take precedence over filename. Any
+	// directory components are removed, so it is safe to join to a
+	// destination directory. It is empty if the response did not suggest a
+	// file name.
+	SuggestedFilename string
+
+	// SSORequired reports whether GitHub withheld data from the response
+	// because the token has not been authorized for SAML single sign-on, as
+	// reported by the X-GitHub-SSO header. A 403 Forbidden response with
+	// this header is returned as an *SSOError. A successful list response,
+	// such as that of OrganizationsService.List, may instead omit resources
+	// of the organizations listed in SSOOrganizationIDs, so it can look
+	// incomplete or empty.
+	SSORequired bool
+
+	// SSOAuthorizationURL is the URL at which the user can authorize the
+	// token for SAML single sign-on, if GitHub provided one.
+	SSOAuthorizationURL string
+
+	// SSOOrganizationIDs lists the IDs of the organizations whose resources
+	// were left out of a partial response because the token has not been
+	// authorized for their SAML single sign-on.
+	SSOOrganizationIDs []int64
+
+	// Deprecation is when the endpoint was or will be deprecated, as
+	// announced by the Deprecation header. It is nil if the endpoint is not
+	// deprecated, and points to the zero time if the header gives no date.
+	Deprecation *time.Time
+
+	// Sunset is when the endpoint is expected to stop responding, as
+	// announced by the Sunset header. It is nil if no date was announced.
+	Sunset *time.Time
 }
 
 // newResponse creates a new Response for the provided http.Response.
@@ -688,9 +1133,113 @@ func newResponse(r *http.Response) *Response {
 	response.populatePageValues()
 	response.Rate = parseRate(r)
 	response.TokenExpiration = parseTokenExpiration(r)
+	response.TokenScopes = parseScopes(r.Header.Get(headerOAuthScopes))
+	response.RequiredScopes = parseScopes(r.Header.Get(headerAcceptedOAuthScopes))
+	response.PollInterval = parsePollInterval(r)
+	response.SuggestedFilename = parseSuggestedFilename(r.Header.Get(headerContentDisposition))
+	response.SSORequired, response.SSOAuthorizationURL, response.SSOOrganizationIDs = parseSSO(r.Header.Get(headerSSO))
+	response.Deprecation = parseDeprecation(r.Header.Get(headerDeprecation))
+	response.Sunset = parseHTTPDate(r.Header.Get(headerSunset))
 	return response
 }
 
+// parseDeprecation parses a Deprecation header, which holds either a date,
+// such as "@1688169599" (RFC 9745) or an HTTP date, or, in older drafts of
+// the specification, "true".
+func parseDeprecation(header string) *time.Time {
+	header = strings.TrimSpace(header)
+	if header == "" {
+		return nil
+	}
+	if unix, ok := strings.CutPrefix(header, "@"); ok {
+		if sec, err := strconv.ParseInt(unix, 10, 64); err == nil {
+			t := time.Unix(sec, 0)
+			return &t
+		}
+	}
+	if t := parseHTTPDate(header); t != nil {
+		return t
+	}
+	return &time.Time{}
+}
+
+// parseHTTPDate parses an HTTP date, such as that of a Sunset header. It
+// returns nil if header is empty or not a valid date.
+func parseHTTPDate(header string) *time.Time {
+	t, err := http.ParseTime(strings.TrimSpace(header))
+	if err != nil {
+		return nil
+	}
+	return &t
+}
+
+// parseSSO parses an X-GitHub-SSO header, which is either of the form
+// "required; url=https://github.com/orgs/o/sso?authorization_request=..." or
+// "partial-results; organizations=1,2".
+func parseSSO(header string) (required bool, authURL string, orgIDs []int64) {
+	kind, params, _ := strings.Cut(header, ";")
+	switch strings.TrimSpace(kind) {
+	case "required", "partial-results":
+	default:
+		return false, "", nil
+	}
+	for _, param := range strings.Split(params, ";") {
+		key, value, _ := strings.Cut(strings.TrimSpace(param), "=")
+		switch key {
+		case "url":
+			authURL = value
+		case "organizations":
+			for _, id := range strings.Split(value, ",") {
+				if n, err := strconv.ParseInt(strings.TrimSpace(id), 10, 64); err == nil {
+					orgIDs = append(orgIDs, n)
+				}
+			}
+		}
+	}
+	return true, authURL, orgIDs
+}
+
+// parseSuggestedFilename returns the base name of the file name given by a
+// Content-Disposition header, or "" if there is none.
+func parseSuggestedFilename(header string) string {
+	if header == "" {
+		return ""
+	}
+	// ParseMediaType decodes filename* and reports it as filename.
+	_, params, err := mime.ParseMediaType(header)
+	if err != nil {
+		return ""
+	}
+	name := params["filename"]
+	name = name[strings.LastIndexAny(name, `/\`)+1:]
+	if name == "." || name == ".." {
+		return ""
+	}
+	return name
+}
+
+// parsePollInterval parses the X-Poll-Interval header, given in seconds.
+func parsePollInterval(r *http.Response) time.Duration {
+	seconds, err := strconv.Atoi(r.Header.Get(headerPollInterval))
+	if err != nil || seconds < 0 {
+		return 0
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+// HasScope reports whether the token used for the request was granted the
+// OAuth scope s. It always returns false if the response did not include
+// an X-OAuth-Scopes header, such as for requests made with a GitHub App
+// installation token.
+func (r *Response) HasScope(s string) bool {
+	for _, scope := range r.TokenScopes {
+		if scope == s {
+			return true
+		}
+	}
+	return false
+}
+
 // populatePageValues parses the HTTP Link response headers and populates the
 // various pagination link values in the Response.
 func (r *Response) populatePageValues() {
@@ -823,6 +1372,19 @@ func parseTokenExpiration(r *http.Response) Timestamp {
 	return Timestamp{} // 0001-01-01 00:00:00
 }
 
+// parseScopes parses a comma-separated list of OAuth scopes as found in the
+// X-OAuth-Scopes and X-Accepted-OAuth-Scopes headers.
+// Returns nil if the list is empty.
+func parseScopes(v string) []string {
+	var scopes []string
+	for _, scope := range strings.Split(v, ",") {
+		if scope = strings.TrimSpace(scope); scope != "" {
+			scopes = append(scopes, scope)
+		}
+	}
+	return scopes
+}
+
 type requestContext uint8
 
 const (
@@ -834,6 +1396,21 @@ const (
 	SleepUntilPrimaryRateLimitResetWhenRateLimited
 )
 
+// rateLimitPath returns the request path p without the base path set by
+// WithBasePath, so that GetRateLimitCategory sees the same path as it would
+// without it.
+func (c *Client) rateLimitPath(p string) string {
+	if c.basePath == "" {
+		return p
+	}
+	for _, base := range []*url.URL{c.BaseURL, c.UploadURL} {
+		if base != nil && strings.HasPrefix(p, base.Path+c.basePath) {
+			return base.Path + p[len(base.Path+c.basePath):]
+		}
+	}
+	return p
+}
+
 // bareDo sends an API request using `caller` http.Client passed in the parameters
 // and lets you handle the api response. If an error or API Error occurs, the error
 // will contain more information. Otherwise you are supposed to read and close the
@@ -849,7 +1426,7 @@ func (c *Client) bareDo(ctx context.Context, caller *http.Client, req *http.Requ
 
 	req = withContext(ctx, req)
 
-	rateLimitCategory := GetRateLimitCategory(req.Method, req.URL.Path)
+	rateLimitCategory := GetRateLimitCategory(req.Method, c.rateLimitPath(req.URL.Path))
 
 	if bypass := ctx.Value(BypassRateLimitCheck); bypass == nil {
 		// If we've hit rate limit, don't make further requests before Reset time.
@@ -859,6 +1436,10 @@ func (c *Client) bareDo(ctx context.Context, caller *http.Client, req *http.Requ
 				Rate:     err.Rate,
 			}, err
 		}
+		// Keep the requests below the rate limit floor in reserve.
+		if err := c.checkRateLimitFloorBeforeDo(req, rateLimitCategory); err != nil {
+			return nil, err
+		}
 		// If we've hit a secondary rate limit, don't make further requests before Retry After.
 		if err := c.checkSecondaryRateLimitBeforeDo(req); err != nil {
 			return &Response{
@@ -867,11 +1448,19 @@ func (c *Client) bareDo(ctx context.Context, caller *http.Client, req *http.Requ
 		}
 	}
 
+	start := time.Now()
 	resp, err := caller.Do(req)
 	var response *Response
 	if resp != nil {
 		response = newResponse(resp)
 	}
+	if c.metrics != nil {
+		var status int
+		if resp != nil {
+			status = resp.StatusCode
+		}
+		c.metrics.ObserveRequest(req.Method, c.metricsPath(req.URL.Path), status, time.Since(start))
+	}
 
 	if err != nil {
 		// If we got an error, and the context has been canceled,
@@ -893,12 +1482,21 @@ func (c *Client) bareDo(ctx context.Context, caller *http.Client, req *http.Requ
 		return response, err
 	}
 
-	// Don't update the rate limits if this was a cached response.
+	// Don't update the rate limits if this was a cached response, or if the
+	// response carries no rate limit headers.
 	// X-From-Cache is set by https://github.com/gregjones/httpcache
-	if response.Header.Get("X-From-Cache") == "" {
+	if response.Header.Get("X-From-Cache") == "" && response.Header.Get(headerRateLimit) != "" {
 		c.rateMu.Lock()
 		c.rateLimits[rateLimitCategory] = response.Rate
 		c.rateMu.Unlock()
+
+		if c.metrics != nil {
+			resource := response.Rate.Resource
+			if resource == "" {
+				resource = rateLimitCategoryNames[rateLimitCategory]
+			}
+			c.metrics.ObserveRateLimit(resource, response.Rate.Remaining, response.Rate.Limit)
+		}
 	}
 
 	err = CheckResponse(resp)
@@ -921,7 +1519,7 @@ func (c *Client) bareDo(ctx context.Context, caller *http.Client, req *http.Requ
 		}
 
 		rateLimitError, ok := err.(*RateLimitError)
-		if ok && req.Context().Value(SleepUntilPrimaryRateLimitResetWhenRateLimited) != nil {
+		if ok && req.Context().Value(SleepUntilPrimaryRateLimitResetWhenRateLimited) != nil && c.allowRetry() {
 			if err := sleepUntilResetWithBuffer(req.Context(), rateLimitError.Rate.Reset.Time); err != nil {
 				return response, err
 			}
@@ -1043,6 +1641,43 @@ func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Res
 	return resp, err
 }
 
+// CountPages returns the number of items that the paginated list endpoint
+// requested by req would return, without fetching every page. It sends a copy
+// of req with per_page set to 1 and page removed, so that the last page number
+// in the Link header of the response is the total number of items.
+//
+// Note that with per_page=1 the count is of items, not of pages of any other
+// size; divide it by the page size you intend to use, rounding up, to get a
+// page count. The count is a snapshot and may change before the items are
+// listed. Only endpoints that respond with a JSON array are supported;
+// endpoints that wrap their results in an object usually report the count
+// directly in a TotalCount field.
+func (c *Client) CountPages(ctx context.Context, req *http.Request) (pages int, resp *Response, err error) {
+	r := req.Clone(ctx)
+	q := r.URL.Query()
+	q.Set("per_page", "1")
+	q.Del("page")
+	r.URL.RawQuery = q.Encode()
+
+	var body json.RawMessage
+	resp, err = c.Do(ctx, r, &body)
+	if err != nil {
+		return 0, resp, err
+	}
+	if resp.LastPage > 0 {
+		return resp.LastPage, resp, nil
+	}
+
+	// Without a last page, everything fit on the first page.
+	var items []json.RawMessage
+	if len(body) > 0 {
+		if err := json.Unmarshal(body, &items); err != nil {
+			return 0, resp, fmt.Errorf("counting items of %v: response is not a JSON array", req.URL.Path)
+		}
+	}
+	return len(items), resp, nil
+}
+
 // checkRateLimitBeforeDo does not make any network calls, but uses existing knowledge from
 // current client state in order to quickly check if *RateLimitError can be immediately returned
 // from Client.Do, and if so, returns it so that Client.Do can skip making a network API call unnecessarily.
@@ -1082,6 +1717,28 @@ func (c *Client) checkRateLimitBeforeDo(req *http.Request, rateLimitCategory Rat
 	return nil
 }
 
+// checkRateLimitFloorBeforeDo does not make any network calls, but uses the
+// last observed rate limit of rateLimitCategory to check whether a request
+// would eat into the requests kept in reserve by WithRateLimitFloor. If so, it
+// waits for the rate limit to reset when the request context asks for it, and
+// otherwise returns a *RateLimitFloorError.
+func (c *Client) checkRateLimitFloorBeforeDo(req *http.Request, rateLimitCategory RateLimitCategory) error {
+	if c.rateLimitFloor <= 0 {
+		return nil
+	}
+	c.rateMu.Lock()
+	rate := c.rateLimits[rateLimitCategory]
+	c.rateMu.Unlock()
+	if rate.Reset.Time.IsZero() || rate.Remaining >= c.rateLimitFloor || !time.Now().Before(rate.Reset.Time) {
+		return nil
+	}
+
+	if req.Context().Value(SleepUntilPrimaryRateLimitResetWhenRateLimited) != nil {
+		return sleepUntilResetWithBuffer(req.Context(), rate.Reset.Time)
+	}
+	return &RateLimitFloorError{Rate: rate, Floor: c.rateLimitFloor}
+}
+
 // checkSecondaryRateLimitBeforeDo does not make any network calls, but uses existing knowledge from
 // current client state in order to quickly check if *AbuseRateLimitError can be immediately returned
 // from Client.Do, and if so, returns it so that Client.Do can skip making a network API call unnecessarily.
@@ -1141,6 +1798,10 @@ type ErrorResponse struct {
 	// to some content that might help you resolve the error, see
 	// https://docs.github.com/rest/#client-errors
 	DocumentationURL string `json:"documentation_url,omitempty"`
+
+	// emptyRepository is set by the methods that can report an empty
+	// repository. See ErrEmptyRepository.
+	emptyRepository bool
 }
 
 // ErrorBlock contains a further explanation for the reason of an error.
@@ -1165,8 +1826,13 @@ func (r *ErrorResponse) Error() string {
 	return fmt.Sprintf("%v %+v", r.Message, r.Errors)
 }
 
-// Is returns whether the provided error equals this error.
+// Is returns whether the provided error equals this error. It also matches
+// ErrEmptyRepository if the error reports an empty repository.
 func (r *ErrorResponse) Is(target error) bool {
+	if target == ErrEmptyRepository {
+		return r.emptyRepository
+	}
+
 	v, ok := target.(*ErrorResponse)
 	if !ok {
 		return false
@@ -1209,6 +1875,28 @@ func (r *ErrorResponse) Is(target error) bool {
 	return true
 }
 
+// FieldError returns the first error in r.Errors that was caused by the given
+// field, or nil if there is none.
+func (r *ErrorResponse) FieldError(field string) *Error {
+	for i := range r.Errors {
+		if r.Errors[i].Field == field {
+			return &r.Errors[i]
+		}
+	}
+	return nil
+}
+
+// HasCode reports whether any error in r.Errors has the given validation error
+// code, such as ErrorCodeAlreadyExists.
+func (r *ErrorResponse) HasCode(code string) bool {
+	for _, e := range r.Errors {
+		if e.Code == code {
+			return true
+		}
+	}
+	return false
+}
+
 // TwoFactorAuthError occurs when using HTTP Basic Authentication for a user
 // that has two-factor authentication enabled. The request can be reattempted
 // by providing a one-time password in the request.
@@ -1242,6 +1930,71 @@ func (r *RateLimitError) Is(target error) bool {
 		compareHTTPResponse(r.Response, v.Response)
 }
 
+// RateLimitFloorError occurs when a client created with WithRateLimitFloor
+// refuses to make a request because fewer requests than the floor remain in
+// the rate limit of the endpoint. No request is made.
+type RateLimitFloorError struct {
+	Rate  Rate // Rate specifies last known rate limit for the endpoint
+	Floor int  // Floor is the number of requests kept in reserve
+}
+
+func (r *RateLimitFloorError) Error() string {
+	return fmt.Sprintf("rate limit floor of %v reached: %v of %v requests remaining %v",
+		r.Floor, r.Rate.Remaining, r.Rate.Limit, formatRateReset(time.Until(r.Rate.Reset.Time)))
+}
+
+// SSOError occurs when GitHub returns a 403 Forbidden response because the
+// token used for the request has not been authorized for the SAML single
+// sign-on of the organization that owns the resource. It wraps the
+// *ErrorResponse returned by GitHub.
+//
+// GitHub API docs: https://docs.github.com/rest/authentication/authenticating-to-the-rest-api#personal-access-tokens-and-saml-sso
+type SSOError struct {
+	*ErrorResponse
+
+	// AuthorizationURL is the URL at which the user can authorize the token,
+	// or "" if GitHub did not provide one.
+	AuthorizationURL string
+}
+
+func (r *SSOError) Error() string {
+	msg := r.ErrorResponse.Error()
+	if r.AuthorizationURL != "" {
+		msg += " (authorize the token at " + r.AuthorizationURL + ")"
+	}
+	return msg
+}
+
+// Unwrap returns the underlying *ErrorResponse.
+func (r *SSOError) Unwrap() error { return r.ErrorResponse }
+
+// Is returns whether the provided error equals this error.
+func (r *SSOError) Is(target error) bool {
+	v, ok := target.(*SSOError)
+	if !ok {
+		return false
+	}
+
+	return r.AuthorizationURL == v.AuthorizationURL &&
+		(r.ErrorResponse == v.ErrorResponse ||
+			r.ErrorResponse != nil && v.ErrorResponse != nil && r.ErrorResponse.Is(v.ErrorResponse))
+}
+
+// RequestTooLargeError is returned by NewRequest, and so by the methods that
+// call it, when the JSON body of a request is larger than the limit set with
+// Client.WithMaxRequestBodySize. No request is sent.
+type RequestTooLargeError struct {
+	Method string   // HTTP method of the request.
+	URL    *url.URL // URL of the endpoint the request was for.
+	Size   int      // Size of the encoded body, in bytes.
+	Limit  int      // Maximum body size allowed by the client, in bytes.
+}
+
+func (e *RequestTooLargeError) Error() string {
+	return fmt.Sprintf("%v %v: request body of %d bytes exceeds the limit of %d bytes",
+		e.Method, sanitizeURL(e.URL), e.Size, e.Limit)
+}
+
 // AcceptedError occurs when GitHub returns 202 Accepted response with an
 // empty body, which means a job was scheduled on the GitHub side to process
 // the information needed and cache it.
@@ -1373,6 +2126,15 @@ type Error struct {
 	Message  string `json:"message"`  // Message describing the error. Errors with Code == "custom" will always have this set.
 }
 
+// Validation error codes reported in Error.Code.
+const (
+	ErrorCodeMissing       = "missing"
+	ErrorCodeMissingField  = "missing_field"
+	ErrorCodeInvalid       = "invalid"
+	ErrorCodeAlreadyExists = "already_exists"
+	ErrorCodeCustom        = "custom"
+)
+
 func (e *Error) Error() string {
 	return fmt.Sprintf("%v error caused by %v field on %v resource",
 		e.Code, e.Field, e.Resource)
@@ -1395,6 +2157,7 @@ func (e *Error) UnmarshalJSON(data []byte) error {
 // The error type will be *RateLimitError for rate limit exceeded errors,
 // *AcceptedError for 202 Accepted status codes,
 // *TwoFactorAuthError for two-factor authentication errors,
+// *SSOError for tokens not authorized for SAML single sign-on,
 // and *RedirectionError for redirect status codes (only happens when ignoring redirections).
 func CheckResponse(r *http.Response) error {
 	if r.StatusCode == http.StatusAccepted {
@@ -1420,6 +2183,9 @@ func CheckResponse(r *http.Response) error {
 	switch {
 	case r.StatusCode == http.StatusUnauthorized && strings.HasPrefix(r.Header.Get(headerOTP), "required"):
 		return (*TwoFactorAuthError)(errorResponse)
+	case r.StatusCode == http.StatusForbidden && strings.HasPrefix(r.Header.Get(headerSSO), "required"):
+		_, authURL, _ := parseSSO(r.Header.Get(headerSSO))
+		return &SSOError{ErrorResponse: errorResponse, AuthorizationURL: authURL}
 	case r.StatusCode == http.StatusForbidden && r.Header.Get(headerRateRemaining) == "0":
 		return &RateLimitError{
 			Rate:     parseRate(r),
@@ -1554,6 +2320,43 @@ func (c *Client) RateLimits(ctx context.Context) (*RateLimits, *Response, error)
 	return c.RateLimit.Get(ctx)
 }
 
+// rateLimitCategoryNames maps each RateLimitCategory to the name of its
+// resource in RateLimits.
+var rateLimitCategoryNames = [Categories]string{
+	CoreCategory:                      "core",
+	SearchCategory:                    "search",
+	GraphqlCategory:                   "graphql",
+	IntegrationManifestCategory:       "integration_manifest",
+	SourceImportCategory:              "source_import",
+	CodeScanningUploadCategory:        "code_scanning_upload",
+	ActionsRunnerRegistrationCategory: "actions_runner_registration",
+	ScimCategory:                      "scim",
+	DependencySnapshotsCategory:       "dependency_snapshots",
+	CodeSearchCategory:                "code_search",
+	AuditLogCategory:                  "audit_log",
+}
+
+// LastRateLimits returns the rate limits most recently observed by the client,
+// either from the headers of an API response or from RateLimitService.Get,
+// without making a request. The map is keyed by resource name as used by the
+// RateLimits JSON fields (e.g. "core", "search", "graphql"); resources for which
+// no rate limit has been observed yet are omitted.
+//
+// It is safe to call LastRateLimits concurrently with other methods on the client.
+func (c *Client) LastRateLimits() map[string]Rate {
+	c.rateMu.Lock()
+	defer c.rateMu.Unlock()
+
+	limits := make(map[string]Rate)
+	for category, rate := range c.rateLimits {
+		if rate == (Rate{}) {
+			continue
+		}
+		limits[rateLimitCategoryNames[category]] = rate
+	}
+	return limits
+}
+
 func setCredentialsAsHeaders(req *http.Request, id, secret string) *http.Request {
 	// To set extra headers, we must make a copy of the Request so
 	// that we don't modify the Request we were given. This is required by the
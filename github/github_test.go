@@ -19,6 +19,7 @@ import (
 	"reflect"
 	"strconv"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -372,6 +373,113 @@ func TestWithAuthToken(t *testing.T) {
 	})
 }
 
+type testTokenSource struct {
+	mu        sync.Mutex
+	calls     int
+	expiresIn time.Duration
+	err       error
+}
+
+func (s *testTokenSource) Token(context.Context) (string, time.Time, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.err != nil {
+		return "", time.Time{}, s.err
+	}
+	s.calls++
+	var expiresAt time.Time
+	if s.expiresIn != 0 {
+		expiresAt = time.Now().Add(s.expiresIn)
+	}
+	return fmt.Sprintf("token-%v", s.calls), expiresAt, nil
+}
+
+func TestWithTokenSource(t *testing.T) {
+	t.Parallel()
+
+	var mu sync.Mutex
+	var got []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		got = append(got, r.Header.Get("Authorization"))
+	}))
+	t.Cleanup(srv.Close)
+
+	get := func(t *testing.T, c *Client) {
+		t.Helper()
+		resp, err := c.Client().Get(srv.URL)
+		assertNilError(t, err)
+		resp.Body.Close()
+	}
+	reset := func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		g := got
+		got = nil
+		return g
+	}
+
+	// A token far from expiry is reused.
+	ts := &testTokenSource{expiresIn: time.Hour}
+	c := NewClient(nil).WithTokenSource(ts, 0)
+	get(t, c)
+	get(t, c)
+	if headers, want := reset(), []string{"Bearer token-1", "Bearer token-1"}; !cmp.Equal(headers, want) {
+		t.Errorf("Authorization headers = %v, want %v", headers, want)
+	}
+
+	// A token within the skew of its expiry is refreshed before each request.
+	ts = &testTokenSource{expiresIn: 30 * time.Second}
+	c = NewClient(nil).WithTokenSource(ts, 0)
+	get(t, c)
+	get(t, c)
+	if headers, want := reset(), []string{"Bearer token-1", "Bearer token-2"}; !cmp.Equal(headers, want) {
+		t.Errorf("Authorization headers = %v, want %v", headers, want)
+	}
+
+	// A custom skew shorter than the remaining lifetime avoids the refresh.
+	ts = &testTokenSource{expiresIn: 30 * time.Second}
+	c = NewClient(nil).WithTokenSource(ts, time.Second)
+	get(t, c)
+	get(t, c)
+	if headers, want := reset(), []string{"Bearer token-1", "Bearer token-1"}; !cmp.Equal(headers, want) {
+		t.Errorf("Authorization headers = %v, want %v", headers, want)
+	}
+
+	// A token without an expiry is fetched once, even under concurrent use.
+	ts = &testTokenSource{}
+	c = NewClient(nil).WithTokenSource(ts, 0)
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			get(t, c)
+		}()
+	}
+	wg.Wait()
+	reset()
+	if ts.calls != 1 {
+		t.Errorf("Token called %v times, want 1", ts.calls)
+	}
+}
+
+func TestWithTokenSource_error(t *testing.T) {
+	t.Parallel()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request sent despite token error")
+	}))
+	t.Cleanup(srv.Close)
+
+	wantErr := errors.New("token error")
+	c := NewClient(nil).WithTokenSource(&testTokenSource{err: wantErr}, 0)
+	_, err := c.Client().Get(srv.URL)
+	if !errors.Is(err, wantErr) {
+		t.Errorf("Get returned error %v, want %v", err, wantErr)
+	}
+}
+
 func TestWithEnterpriseURLs(t *testing.T) {
 	t.Parallel()
 	for _, test := range []struct {
@@ -493,6 +601,281 @@ func TestWithEnterpriseURLs(t *testing.T) {
 	}
 }
 
+func TestWithDefaultPerPage(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name    string
+		perPage int
+		opts    interface{}
+		want    string
+	}{
+		{
+			name:    "adds per_page for nil options",
+			perPage: 50,
+			opts:    (*ListOptions)(nil),
+			want:    "repos/o/r/issues?per_page=50",
+		},
+		{
+			name:    "keeps other query parameters",
+			perPage: 50,
+			opts:    &ListOptions{Page: 2},
+			want:    "repos/o/r/issues?page=2&per_page=50",
+		},
+		{
+			name:    "does not override explicit per_page",
+			perPage: 50,
+			opts:    &ListOptions{PerPage: 10},
+			want:    "repos/o/r/issues?per_page=10",
+		},
+		{
+			name:    "adds per_page for embedded ListOptions",
+			perPage: 50,
+			opts:    &RepositoryListByOrgOptions{Type: "all"},
+			want:    "repos/o/r/issues?per_page=50&type=all",
+		},
+		{
+			name:    "adds per_page for indirectly embedded ListOptions",
+			perPage: 50,
+			opts:    (*ListReposMatchingOptions)(nil),
+			want:    "repos/o/r/issues?per_page=50",
+		},
+		{
+			name:    "ignores options without ListOptions",
+			perPage: 50,
+			opts:    &RepositoryContentGetOptions{Ref: "main"},
+			want:    "repos/o/r/issues?ref=main",
+		},
+		{
+			name:    "caps per_page at maximum",
+			perPage: 500,
+			opts:    &ListOptions{},
+			want:    "repos/o/r/issues?per_page=100",
+		},
+		{
+			name:    "zero disables default",
+			perPage: 0,
+			opts:    &ListOptions{},
+			want:    "repos/o/r/issues",
+		},
+	} {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			c := NewClient(nil).WithDefaultPerPage(test.perPage)
+			got, err := c.addOptions("repos/o/r/issues", test.opts)
+			if err != nil {
+				t.Fatalf("addOptions returned unexpected error: %v", err)
+			}
+			if got != test.want {
+				t.Errorf("addOptions returned %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestWithDefaultPerPage_request(t *testing.T) {
+	t.Parallel()
+	orig := NewClient(nil)
+	c := orig.WithDefaultPerPage(100)
+	if orig.defaultPerPage != 0 {
+		t.Errorf("WithDefaultPerPage modified the original client")
+	}
+
+	req, _ := c.NewRequest("GET", "repos/o/r/contents/a.txt", nil)
+	if got, want := req.URL.String(), defaultBaseURL+"repos/o/r/contents/a.txt"; got != want {
+		t.Errorf("NewRequest URL is %v, want %v", got, want)
+	}
+
+	client, mux, _ := setup(t)
+	client = client.WithDefaultPerPage(100)
+	mux.HandleFunc("/repos/o/r/issues", func(w http.ResponseWriter, r *http.Request) {
+		testFormValues(t, r, values{"per_page": "100"})
+		fmt.Fprint(w, `[]`)
+	})
+	mux.HandleFunc("/repos/o/r", func(w http.ResponseWriter, r *http.Request) {
+		testFormValues(t, r, values{})
+		fmt.Fprint(w, `{}`)
+	})
+	ctx := context.Background()
+	if _, _, err := client.Issues.ListByRepo(ctx, "o", "r", nil); err != nil {
+		t.Errorf("Issues.ListByRepo returned error: %v", err)
+	}
+	if _, _, err := client.Repositories.Get(ctx, "o", "r"); err != nil {
+		t.Errorf("Repositories.Get returned error: %v", err)
+	}
+}
+
+func TestWithCanonicalJSON(t *testing.T) {
+	t.Parallel()
+	type payload struct {
+		Zeta   string          `json:"zeta"`
+		Alpha  int64           `json:"alpha"`
+		Config json.RawMessage `json:"config"`
+	}
+	body := &payload{
+		Zeta:   "<z>",
+		Alpha:  12345678901234567,
+		Config: json.RawMessage(`{"b": [ {"y":1,"x":2} ], "a": 1.50}`),
+	}
+
+	orig := NewClient(nil)
+	c := orig.WithCanonicalJSON(true)
+	if orig.canonicalJSON {
+		t.Errorf("WithCanonicalJSON modified the original client")
+	}
+
+	req, err := c.NewRequest("POST", ".", body)
+	if err != nil {
+		t.Fatalf("NewRequest returned unexpected error: %v", err)
+	}
+	got, _ := io.ReadAll(req.Body)
+	want := `{"alpha":12345678901234567,"config":{"a":1.50,"b":[{"x":2,"y":1}]},"zeta":"<z>"}` + "\n"
+	if string(got) != want {
+		t.Errorf("NewRequest body is %s, want %s", got, want)
+	}
+
+	req, _ = c.WithCanonicalJSON(false).NewRequest("POST", ".", body)
+	got, _ = io.ReadAll(req.Body)
+	want = `{"zeta":"<z>","alpha":12345678901234567,"config":{"b":[{"y":1,"x":2}],"a":1.50}}` + "\n"
+	if string(got) != want {
+		t.Errorf("NewRequest body without canonical JSON is %s, want %s", got, want)
+	}
+}
+
+func TestWithMaxRequestBodySize(t *testing.T) {
+	t.Parallel()
+	body := &User{Login: Ptr("l")} // Encoded as {"login":"l"} plus a newline: 14 bytes.
+
+	orig := NewClient(nil)
+	c := orig.WithMaxRequestBodySize(13)
+	if orig.maxRequestBodySize != 0 {
+		t.Errorf("WithMaxRequestBodySize modified the original client")
+	}
+
+	_, err := c.NewRequest("POST", "repos/o/r/git/trees", body)
+	var tooLarge *RequestTooLargeError
+	if !errors.As(err, &tooLarge) {
+		t.Fatalf("NewRequest returned error %v, want *RequestTooLargeError", err)
+	}
+	if tooLarge.Size != 14 || tooLarge.Limit != 13 {
+		t.Errorf("NewRequest returned error with size %v and limit %v, want 14 and 13", tooLarge.Size, tooLarge.Limit)
+	}
+	want := "POST " + defaultBaseURL + "repos/o/r/git/trees: request body of 14 bytes exceeds the limit of 13 bytes"
+	if got := err.Error(); got != want {
+		t.Errorf("RequestTooLargeError.Error returned %q, want %q", got, want)
+	}
+
+	if _, err := c.WithMaxRequestBodySize(14).NewRequest("POST", ".", body); err != nil {
+		t.Errorf("NewRequest returned error %v for a body at the limit", err)
+	}
+	if _, err := c.WithMaxRequestBodySize(0).NewRequest("POST", ".", body); err != nil {
+		t.Errorf("NewRequest returned error %v without a limit", err)
+	}
+	if _, err := c.NewRequest("GET", ".", nil); err != nil {
+		t.Errorf("NewRequest returned error %v for a request without a body", err)
+	}
+}
+
+func TestWithBasePath(t *testing.T) {
+	t.Parallel()
+	orig := NewClient(nil)
+	c := orig.WithBasePath("/proxy//github/")
+	if orig.basePath != "" {
+		t.Errorf("WithBasePath modified the original client")
+	}
+
+	tests := []struct {
+		name       string
+		newRequest func(c *Client) (*http.Request, error)
+		want       string
+	}{
+		{
+			name:       "NewRequest",
+			newRequest: func(c *Client) (*http.Request, error) { return c.NewRequest("GET", "repos/o/r?page=2", nil) },
+			want:       defaultBaseURL + "proxy/github/repos/o/r?page=2",
+		},
+		{
+			name:       "NewFormRequest",
+			newRequest: func(c *Client) (*http.Request, error) { return c.NewFormRequest("login/oauth", nil) },
+			want:       defaultBaseURL + "proxy/github/login/oauth",
+		},
+		{
+			name:       "NewUploadRequest",
+			newRequest: func(c *Client) (*http.Request, error) { return c.NewUploadRequest("repos/o/r/assets", nil, 0, "") },
+			want:       uploadBaseURL + "proxy/github/repos/o/r/assets",
+		},
+		{
+			name:       "absolute URL",
+			newRequest: func(c *Client) (*http.Request, error) { return c.NewRequest("GET", "https://example.com/a", nil) },
+			want:       "https://example.com/a",
+		},
+	}
+	for _, tt := range tests {
+		req, err := tt.newRequest(c)
+		if err != nil {
+			t.Fatalf("%v returned error: %v", tt.name, err)
+		}
+		if got := req.URL.String(); got != tt.want {
+			t.Errorf("%v URL = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+
+	c, err := orig.WithBasePath("proxy").WithEnterpriseURLs("https://ghe.example.com/", "https://ghe.example.com/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	req, _ := c.NewRequest("GET", "user", nil)
+	if got, want := req.URL.String(), "https://ghe.example.com/api/v3/proxy/user"; got != want {
+		t.Errorf("NewRequest URL = %v, want %v", got, want)
+	}
+
+	req, _ = c.WithBasePath("").NewRequest("GET", "user", nil)
+	if got, want := req.URL.String(), "https://ghe.example.com/api/v3/user"; got != want {
+		t.Errorf("NewRequest URL after WithBasePath(\"\") = %v, want %v", got, want)
+	}
+}
+
+func TestWithAPIVersion(t *testing.T) {
+	t.Parallel()
+	orig := NewClient(nil)
+	c := orig.WithAPIVersion("2026-03-10")
+	if orig.apiVersion != "" {
+		t.Errorf("WithAPIVersion modified the original client")
+	}
+
+	requests := map[string]func(c *Client, opts ...RequestOption) (*http.Request, error){
+		"NewRequest": func(c *Client, opts ...RequestOption) (*http.Request, error) {
+			return c.NewRequest("GET", ".", nil, opts...)
+		},
+		"NewFormRequest": func(c *Client, opts ...RequestOption) (*http.Request, error) {
+			return c.NewFormRequest(".", nil, opts...)
+		},
+		"NewUploadRequest": func(c *Client, opts ...RequestOption) (*http.Request, error) {
+			return c.NewUploadRequest(".", nil, 0, "", opts...)
+		},
+	}
+	for name, newRequest := range requests {
+		for _, tt := range []struct {
+			c    *Client
+			opts []RequestOption
+			want string
+		}{
+			{c: orig, want: defaultAPIVersion},
+			{c: c, want: "2026-03-10"},
+			{c: c, opts: []RequestOption{WithVersion("2022-11-29")}, want: "2022-11-29"},
+			{c: c.WithAPIVersion(""), want: defaultAPIVersion},
+		} {
+			req, err := newRequest(tt.c, tt.opts...)
+			if err != nil {
+				t.Fatalf("%v returned error: %v", name, err)
+			}
+			if got := req.Header.Get(headerAPIVersion); got != tt.want {
+				t.Errorf("%v %v = %q, want %q", name, headerAPIVersion, got, tt.want)
+			}
+		}
+	}
+}
+
 // Ensure that length of Client.rateLimits is the same as number of fields in RateLimits struct.
 func TestClient_rateLimits(t *testing.T) {
 	t.Parallel()
@@ -726,6 +1109,93 @@ func TestNewFormRequest_errorForNoTrailingSlash(t *testing.T) {
 	}
 }
 
+func TestNewFormValuesRequest(t *testing.T) {
+	t.Parallel()
+	c := NewClient(nil)
+
+	form := url.Values{}
+	form.Add("b", "2")
+	form.Add("a", "1 2")
+	req, err := c.NewFormValuesRequest("PATCH", "foo", form, WithVersion("2022-11-29"))
+	if err != nil {
+		t.Fatalf("NewFormValuesRequest returned unexpected error: %v", err)
+	}
+
+	if got, want := req.Method, "PATCH"; got != want {
+		t.Errorf("NewFormValuesRequest() Method is %v, want %v", got, want)
+	}
+	if got, want := req.URL.String(), defaultBaseURL+"foo"; got != want {
+		t.Errorf("NewFormValuesRequest() URL is %v, want %v", got, want)
+	}
+	body, _ := io.ReadAll(req.Body)
+	if got, want := string(body), "a=1+2&b=2"; got != want {
+		t.Errorf("NewFormValuesRequest() Body is %v, want %v", got, want)
+	}
+	if got, want := req.Header.Get("Content-Type"), "application/x-www-form-urlencoded"; got != want {
+		t.Errorf("NewFormValuesRequest() Content-Type is %v, want %v", got, want)
+	}
+	if got, want := req.Header.Get(headerAPIVersion), "2022-11-29"; got != want {
+		t.Errorf("NewFormValuesRequest() %v header is %v, want %v", headerAPIVersion, got, want)
+	}
+}
+
+func TestNewFormValuesRequest_nilForm(t *testing.T) {
+	t.Parallel()
+	c := NewClient(nil)
+	req, err := c.NewFormValuesRequest("DELETE", ".", nil)
+	if err != nil {
+		t.Fatalf("NewFormValuesRequest returned unexpected error: %v", err)
+	}
+	if req.Body != nil {
+		t.Fatalf("constructed request contains a non-nil Body")
+	}
+}
+
+func TestNewFormValuesRequest_badURL(t *testing.T) {
+	t.Parallel()
+	c := NewClient(nil)
+	_, err := c.NewFormValuesRequest("POST", ":", nil)
+	testURLParseError(t, err)
+}
+
+func TestNewRequest_WithMediaType(t *testing.T) {
+	t.Parallel()
+	c := NewClient(nil)
+
+	tests := []struct {
+		previews []string
+		want     string
+	}{
+		{previews: nil, want: mediaTypeV3},
+		{previews: []string{""}, want: mediaTypeV3},
+		{
+			previews: []string{"machine-man-preview"},
+			want:     mediaTypeV3 + ", application/vnd.github.machine-man-preview+json",
+		},
+		{
+			previews: []string{"machine-man-preview", "", "application/vnd.github.antiope-preview+json"},
+			want:     mediaTypeV3 + ", application/vnd.github.machine-man-preview+json, application/vnd.github.antiope-preview+json",
+		},
+	}
+
+	for _, tt := range tests {
+		req, err := c.NewRequest("GET", ".", nil, WithMediaType(tt.previews...))
+		if err != nil {
+			t.Fatalf("NewRequest returned unexpected error: %v", err)
+		}
+		if got := req.Header.Get("Accept"); got != tt.want {
+			t.Errorf("WithMediaType(%q) Accept header is %q, want %q", tt.previews, got, tt.want)
+		}
+	}
+
+	// Options are applied in order, so previews from several options accumulate.
+	req, _ := c.NewRequest("GET", ".", nil, WithMediaType("a-preview"), WithMediaType("b-preview"))
+	want := mediaTypeV3 + ", application/vnd.github.a-preview+json, application/vnd.github.b-preview+json"
+	if got := req.Header.Get("Accept"); got != want {
+		t.Errorf("Accept header is %q, want %q", got, want)
+	}
+}
+
 func TestNewUploadRequest_WithVersion(t *testing.T) {
 	t.Parallel()
 	c := NewClient(nil)
@@ -1211,6 +1681,44 @@ func TestDo_rateLimit(t *testing.T) {
 	}
 }
 
+func TestClient_LastRateLimits(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	if got := client.LastRateLimits(); len(got) != 0 {
+		t.Errorf("LastRateLimits = %v, want empty", got)
+	}
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(headerRateLimit, "60")
+		w.Header().Set(headerRateRemaining, "59")
+		w.Header().Set(headerRateUsed, "1")
+		w.Header().Set(headerRateReset, "1372700873")
+		w.Header().Set(headerRateResource, "core")
+	})
+	mux.HandleFunc("/rate_limit", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"resources":{"search":{"limit":30,"remaining":29,"used":1,"reset":1372700873}}}`)
+	})
+
+	ctx := context.Background()
+	req, _ := client.NewRequest("GET", ".", nil)
+	if _, err := client.Do(ctx, req, nil); err != nil {
+		t.Fatalf("Do returned unexpected error: %v", err)
+	}
+	if _, _, err := client.RateLimit.Get(ctx); err != nil {
+		t.Fatalf("RateLimit.Get returned unexpected error: %v", err)
+	}
+
+	reset := Timestamp{time.Date(2013, time.July, 1, 17, 47, 53, 0, time.UTC)}
+	want := map[string]Rate{
+		"core":   {Limit: 60, Remaining: 59, Used: 1, Reset: reset, Resource: "core"},
+		"search": {Limit: 30, Remaining: 29, Used: 1, Reset: reset},
+	}
+	if got := client.LastRateLimits(); !cmp.Equal(got, want) {
+		t.Errorf("LastRateLimits = %+v, want %+v", got, want)
+	}
+}
+
 func TestDo_rateLimitCategory(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -1288,6 +1796,51 @@ func TestDo_rateLimitCategory(t *testing.T) {
 	}
 }
 
+// Ensure the rate limit category ignores the base path set by WithBasePath.
+func TestDo_rateLimitCategory_basePath(t *testing.T) {
+	t.Parallel()
+	client, mux, serverURL := setup(t)
+	client.BaseURL, _ = url.Parse(serverURL + "/")
+	client = client.WithBasePath(baseURLPath).WithRateLimitFloor(10)
+
+	reset := time.Now().UTC().Add(time.Minute).Truncate(time.Second)
+	requestCount := 0
+	mux.HandleFunc("/search/issues", func(w http.ResponseWriter, r *http.Request) {
+		requestCount++
+		w.Header().Set(headerRateLimit, "30")
+		w.Header().Set(headerRateRemaining, "5")
+		w.Header().Set(headerRateReset, fmt.Sprint(reset.Unix()))
+		fmt.Fprint(w, `{}`)
+	})
+	mux.HandleFunc("/user", func(w http.ResponseWriter, r *http.Request) {
+		requestCount++
+		fmt.Fprint(w, `{}`)
+	})
+
+	ctx := context.Background()
+	if _, _, err := client.Search.Issues(ctx, "q", nil); err != nil {
+		t.Fatalf("Search.Issues returned error: %v", err)
+	}
+	want := map[string]Rate{
+		"search": {Limit: 30, Remaining: 5, Reset: Timestamp{reset}},
+	}
+	if got := client.LastRateLimits(); !cmp.Equal(got, want) {
+		t.Errorf("LastRateLimits = %+v, want %+v", got, want)
+	}
+
+	_, _, err := client.Search.Issues(ctx, "q", nil)
+	var floorErr *RateLimitFloorError
+	if !errors.As(err, &floorErr) {
+		t.Errorf("Search.Issues below the floor returned error %v, want *RateLimitFloorError", err)
+	}
+	if _, _, err := client.Users.Get(ctx, ""); err != nil {
+		t.Errorf("Users.Get returned error: %v", err)
+	}
+	if got, want := requestCount, 2; got != want {
+		t.Errorf("Expected %v requests, got %v", want, got)
+	}
+}
+
 // Ensure rate limit is still parsed, even for error responses.
 func TestDo_rateLimit_errorResponse(t *testing.T) {
 	t.Parallel()
@@ -1673,6 +2226,65 @@ func TestDo_rateLimit_abortSleepContextCancelledClientLimit(t *testing.T) {
 	}
 }
 
+// Ensure requests below the rate limit floor are kept in reserve, per category.
+func TestDo_rateLimitFloor(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+	client = client.WithRateLimitFloor(10)
+
+	reset := time.Now().UTC().Add(time.Minute)
+	client.rateLimits[CoreCategory] = Rate{Limit: 5000, Remaining: 9, Reset: Timestamp{reset}}
+	client.rateLimits[SearchCategory] = Rate{Limit: 30, Remaining: 10, Reset: Timestamp{reset}}
+	requestCount := 0
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		requestCount++
+		fmt.Fprintln(w, `{}`)
+	})
+
+	ctx := context.Background()
+	req, _ := client.NewRequest("GET", ".", nil)
+	_, err := client.Do(ctx, req, nil)
+	var floorErr *RateLimitFloorError
+	if !errors.As(err, &floorErr) {
+		t.Fatalf("Expected a *RateLimitFloorError; got %#v.", err)
+	}
+	if got, want := floorErr.Floor, 10; got != want {
+		t.Errorf("RateLimitFloorError.Floor = %v, want %v", got, want)
+	}
+	if got, want := floorErr.Rate.Remaining, 9; got != want {
+		t.Errorf("RateLimitFloorError.Rate.Remaining = %v, want %v", got, want)
+	}
+	if got, want := requestCount, 0; got != want {
+		t.Errorf("Expected %v requests, got %v", want, got)
+	}
+
+	req, _ = client.NewRequest("GET", ".", nil)
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	_, err = client.Do(context.WithValue(timeoutCtx, SleepUntilPrimaryRateLimitResetWhenRateLimited, true), req, nil)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected context deadline exceeded error; got %v.", err)
+	}
+
+	req, _ = client.NewRequest("GET", ".", nil)
+	if _, err := client.Do(context.WithValue(ctx, BypassRateLimitCheck, true), req, nil); err != nil {
+		t.Errorf("Do with BypassRateLimitCheck returned error: %v", err)
+	}
+
+	if err := client.checkRateLimitFloorBeforeDo(req, SearchCategory); err != nil {
+		t.Errorf("checkRateLimitFloorBeforeDo for search category returned error: %v", err)
+	}
+	if got, want := requestCount, 1; got != want {
+		t.Errorf("Expected %v requests, got %v", want, got)
+	}
+
+	client = client.WithRateLimitFloor(0)
+	req, _ = client.NewRequest("GET", ".", nil)
+	if _, err := client.Do(ctx, req, nil); err != nil {
+		t.Errorf("Do without floor returned error: %v", err)
+	}
+}
+
 // Ensure *AbuseRateLimitError is returned when the response indicates that
 // the client has triggered an abuse detection mechanism.
 func TestDo_rateLimit_abuseRateLimitError(t *testing.T) {
@@ -2033,6 +2645,41 @@ func TestCheckResponse_RateLimit(t *testing.T) {
 	}
 }
 
+func TestCheckResponse_SSO(t *testing.T) {
+	t.Parallel()
+	res := &http.Response{
+		Request:    &http.Request{Method: "GET", URL: &url.URL{Path: "/orgs/o/repos"}},
+		StatusCode: http.StatusForbidden,
+		Header:     http.Header{},
+		Body:       io.NopCloser(strings.NewReader(`{"message":"m"}`)),
+	}
+	res.Header.Set(headerSSO, "required; url=https://github.com/orgs/o/sso?authorization_request=x")
+
+	err := CheckResponse(res)
+
+	want := &SSOError{
+		ErrorResponse:    &ErrorResponse{Response: res, Message: "m"},
+		AuthorizationURL: "https://github.com/orgs/o/sso?authorization_request=x",
+	}
+	if !errors.Is(err, want) {
+		t.Errorf("Error = %#v, want %#v", err, want)
+	}
+	if got := err.Error(); !strings.Contains(got, want.AuthorizationURL) {
+		t.Errorf("Error() = %q, want it to contain the authorization URL", got)
+	}
+	if errors.Is(err, &SSOError{ErrorResponse: &ErrorResponse{Response: res, Message: "other"}, AuthorizationURL: want.AuthorizationURL}) {
+		t.Error("errors.Is matched an SSOError with a different message")
+	}
+
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) {
+		t.Fatalf("errors.As(%v, *ErrorResponse) = false, want true", err)
+	}
+	if errResp.Response.StatusCode != http.StatusForbidden || errResp.Message != "m" {
+		t.Errorf("errors.As returned %#v, want the 403 error response", errResp)
+	}
+}
+
 func TestCheckResponse_AbuseRateLimit(t *testing.T) {
 	t.Parallel()
 	res := &http.Response{
@@ -2126,6 +2773,43 @@ func TestCompareHttpResponse(t *testing.T) {
 	}
 }
 
+func TestErrorResponse_FieldError(t *testing.T) {
+	t.Parallel()
+	err := &ErrorResponse{
+		Errors: []Error{
+			{Resource: "Label", Field: "name", Code: ErrorCodeAlreadyExists},
+			{Resource: "Label", Field: "color", Code: ErrorCodeInvalid},
+		},
+	}
+
+	want := &Error{Resource: "Label", Field: "color", Code: ErrorCodeInvalid}
+	if got := err.FieldError("color"); !cmp.Equal(got, want) {
+		t.Errorf("FieldError(color) = %+v, want %+v", got, want)
+	}
+	if got := err.FieldError("description"); got != nil {
+		t.Errorf("FieldError(description) = %+v, want nil", got)
+	}
+}
+
+func TestErrorResponse_HasCode(t *testing.T) {
+	t.Parallel()
+	err := &ErrorResponse{
+		Errors: []Error{
+			{Resource: "Label", Field: "name", Code: ErrorCodeAlreadyExists},
+		},
+	}
+
+	if !err.HasCode(ErrorCodeAlreadyExists) {
+		t.Errorf("HasCode(%v) = false, want true", ErrorCodeAlreadyExists)
+	}
+	if err.HasCode(ErrorCodeMissingField) {
+		t.Errorf("HasCode(%v) = true, want false", ErrorCodeMissingField)
+	}
+	if (&ErrorResponse{}).HasCode(ErrorCodeCustom) {
+		t.Errorf("HasCode(%v) on empty Errors = true, want false", ErrorCodeCustom)
+	}
+}
+
 func TestErrorResponse_Is(t *testing.T) {
 	t.Parallel()
 	err := &ErrorResponse{
@@ -3057,6 +3741,279 @@ func TestParseTokenExpiration(t *testing.T) {
 	}
 }
 
+func TestParseScopes(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		header string
+		want   []string
+	}{
+		{header: "", want: nil},
+		{header: "repo", want: []string{"repo"}},
+		{header: "repo, user", want: []string{"repo", "user"}},
+		{header: " repo ,, read:org ", want: []string{"repo", "read:org"}},
+	}
+
+	for _, tt := range tests {
+		if got := parseScopes(tt.header); !cmp.Equal(got, tt.want) {
+			t.Errorf("parseScopes(%q) = %#v, want %#v", tt.header, got, tt.want)
+		}
+	}
+}
+
+func TestDo_oauthScopes(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(headerOAuthScopes, "repo, user")
+		w.Header().Set(headerAcceptedOAuthScopes, "admin:org, write:org")
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"message":"Resource not accessible by integration"}`)
+	})
+
+	req, _ := client.NewRequest("GET", ".", nil)
+	ctx := context.Background()
+	resp, err := client.Do(ctx, req, nil)
+	if err == nil {
+		t.Fatal("Expected error to be returned.")
+	}
+
+	if want := []string{"repo", "user"}; !cmp.Equal(resp.TokenScopes, want) {
+		t.Errorf("TokenScopes = %v, want %v", resp.TokenScopes, want)
+	}
+	if want := []string{"admin:org", "write:org"}; !cmp.Equal(resp.RequiredScopes, want) {
+		t.Errorf("RequiredScopes = %v, want %v", resp.RequiredScopes, want)
+	}
+	if !resp.HasScope("repo") {
+		t.Error("HasScope(repo) = false, want true")
+	}
+	if resp.HasScope("admin:org") {
+		t.Error("HasScope(admin:org) = true, want false")
+	}
+}
+
+func TestCountPages(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		link    string
+		body    string
+		want    int
+		wantErr bool
+	}{
+		{name: "last page", link: `<https://api.github.com/user/repos?page=42&per_page=1>; rel="last"`, body: `[{}]`, want: 42},
+		{name: "single item", body: `[{}]`, want: 1},
+		{name: "empty", body: `[]`, want: 0},
+		{name: "object", body: `{"total_count":3}`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			client, mux, _ := setup(t)
+
+			mux.HandleFunc("/user/repos", func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, "GET")
+				testFormValues(t, r, values{"per_page": "1", "type": "owner"})
+				if tt.link != "" {
+					w.Header().Set("Link", tt.link)
+				}
+				fmt.Fprint(w, tt.body)
+			})
+
+			req, err := client.NewRequest("GET", "user/repos?type=owner&page=3&per_page=50", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			ctx := context.Background()
+			got, _, err := client.CountPages(ctx, req)
+			if tt.wantErr {
+				if err == nil {
+					t.Error("CountPages returned nil error, want error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("CountPages returned error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("CountPages = %v, want %v", got, tt.want)
+			}
+			if q := req.URL.Query(); q.Get("page") != "3" || q.Get("per_page") != "50" {
+				t.Errorf("CountPages modified the request URL to %v", req.URL)
+			}
+		})
+	}
+}
+
+func TestDo_pollInterval(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(headerPollInterval, "60")
+		fmt.Fprint(w, `[]`)
+	})
+
+	req, _ := client.NewRequest("GET", ".", nil)
+	ctx := context.Background()
+	resp, err := client.Do(ctx, req, nil)
+	if err != nil {
+		t.Fatalf("Do returned unexpected error: %v", err)
+	}
+	if got, want := resp.PollInterval, time.Minute; got != want {
+		t.Errorf("PollInterval = %v, want %v", got, want)
+	}
+}
+
+func TestParseSuggestedFilename(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		header string
+		want   string
+	}{
+		{header: "", want: ""},
+		{header: "attachment", want: ""},
+		{header: "attachment; filename=hello-world.txt", want: "hello-world.txt"},
+		{header: `attachment; filename="hello world.zip"`, want: "hello world.zip"},
+		{header: `attachment; filename="fallback.txt"; filename*=UTF-8''na%C3%AFve%20file.txt`, want: "naïve file.txt"},
+		{header: `attachment; filename="../../etc/passwd"`, want: "passwd"},
+		{header: `attachment; filename="dir\\name.txt"`, want: "name.txt"},
+		{header: `attachment; filename=".."`, want: ""},
+		{header: "attachment; filename=", want: ""},
+		{header: `attachment; filename="unterminated`, want: ""},
+	}
+
+	for _, tt := range tests {
+		if got := parseSuggestedFilename(tt.header); got != tt.want {
+			t.Errorf("parseSuggestedFilename(%q) = %q, want %q", tt.header, got, tt.want)
+		}
+	}
+}
+
+func TestDo_suggestedFilename(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(headerContentDisposition, "attachment; filename=artifact.zip")
+	})
+
+	req, _ := client.NewRequest("GET", ".", nil)
+	ctx := context.Background()
+	resp, err := client.Do(ctx, req, nil)
+	if err != nil {
+		t.Fatalf("Do returned unexpected error: %v", err)
+	}
+	if got, want := resp.SuggestedFilename, "artifact.zip"; got != want {
+		t.Errorf("SuggestedFilename = %q, want %q", got, want)
+	}
+}
+
+func TestParseSSO(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		header   string
+		required bool
+		url      string
+		orgIDs   []int64
+	}{
+		{header: ""},
+		{header: "bogus; url=https://example.com"},
+		{header: "required; url=https://github.com/orgs/o/sso?authorization_request=a=b", required: true, url: "https://github.com/orgs/o/sso?authorization_request=a=b"},
+		{header: "required", required: true},
+		{header: "partial-results; organizations=21955855,20582480", required: true, orgIDs: []int64{21955855, 20582480}},
+	}
+
+	for _, tt := range tests {
+		required, url, orgIDs := parseSSO(tt.header)
+		if required != tt.required || url != tt.url || !cmp.Equal(orgIDs, tt.orgIDs) {
+			t.Errorf("parseSSO(%q) = %v, %q, %v, want %v, %q, %v", tt.header, required, url, orgIDs, tt.required, tt.url, tt.orgIDs)
+		}
+	}
+}
+
+func TestDo_ssoPartialResults(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/user/orgs", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(headerSSO, "partial-results; organizations=1,2")
+		fmt.Fprint(w, `[{"id":3}]`)
+	})
+
+	ctx := context.Background()
+	orgs, resp, err := client.Organizations.List(ctx, "", nil)
+	if err != nil {
+		t.Fatalf("Organizations.List returned error: %v", err)
+	}
+	if len(orgs) != 1 {
+		t.Errorf("Organizations.List returned %v organizations, want 1", len(orgs))
+	}
+	if !resp.SSORequired {
+		t.Error("SSORequired = false, want true")
+	}
+	if got, want := resp.SSOOrganizationIDs, []int64{1, 2}; !cmp.Equal(got, want) {
+		t.Errorf("SSOOrganizationIDs = %v, want %v", got, want)
+	}
+}
+
+func TestParseDeprecation(t *testing.T) {
+	t.Parallel()
+	date := time.Date(2023, time.June, 30, 23, 59, 59, 0, time.UTC)
+	tests := []struct {
+		header string
+		want   *time.Time
+	}{
+		{header: ""},
+		{header: "@1688169599", want: &date},
+		{header: "Fri, 30 Jun 2023 23:59:59 GMT", want: &date},
+		{header: "true", want: &time.Time{}},
+	}
+
+	for _, tt := range tests {
+		got := parseDeprecation(tt.header)
+		if (got == nil) != (tt.want == nil) || got != nil && !got.Equal(*tt.want) {
+			t.Errorf("parseDeprecation(%q) = %v, want %v", tt.header, got, tt.want)
+		}
+	}
+}
+
+func TestDo_deprecationHeaders(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("deprecated") != "" {
+			w.Header().Set(headerDeprecation, "@1688169599")
+			w.Header().Set(headerSunset, "Sun, 30 Jun 2024 23:59:59 GMT")
+		}
+		fmt.Fprint(w, `{}`)
+	})
+
+	ctx := context.Background()
+	req, _ := client.NewRequest("GET", "?deprecated=1", nil)
+	resp, err := client.Do(ctx, req, nil)
+	if err != nil {
+		t.Fatalf("Do returned error: %v", err)
+	}
+	if want := time.Date(2023, time.June, 30, 23, 59, 59, 0, time.UTC); resp.Deprecation == nil || !resp.Deprecation.Equal(want) {
+		t.Errorf("Deprecation = %v, want %v", resp.Deprecation, want)
+	}
+	if want := time.Date(2024, time.June, 30, 23, 59, 59, 0, time.UTC); resp.Sunset == nil || !resp.Sunset.Equal(want) {
+		t.Errorf("Sunset = %v, want %v", resp.Sunset, want)
+	}
+
+	req, _ = client.NewRequest("GET", ".", nil)
+	resp, err = client.Do(ctx, req, nil)
+	if err != nil {
+		t.Fatalf("Do returned error: %v", err)
+	}
+	if resp.Deprecation != nil || resp.Sunset != nil {
+		t.Errorf("Deprecation, Sunset = %v, %v, want nil", resp.Deprecation, resp.Sunset)
+	}
+}
+
 func TestClientCopy_leak_transport(t *testing.T) {
 	t.Parallel()
 	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -0,0 +1,80 @@
+// Copyright 2026 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// GraphQLError is an error reported in the "errors" field of a GraphQL API
+// response, which GitHub sends with a 200 OK status. Methods backed by the
+// GraphQL API return one, or several joined with errors.Join, when a query
+// fails.
+//
+// GitHub API docs: https://docs.github.com/graphql/guides/forming-calls-with-graphql
+type GraphQLError struct {
+	// Type is a machine-readable error type, such as "NOT_FOUND" or
+	// "FORBIDDEN". It may be empty.
+	Type    string `json:"type,omitempty"`
+	Message string `json:"message"`
+	// Path lists the field names and list indexes leading to the field that
+	// caused the error.
+	Path []interface{} `json:"path,omitempty"`
+}
+
+func (e *GraphQLError) Error() string {
+	if e.Type == "" {
+		return "graphql: " + e.Message
+	}
+	return fmt.Sprintf("graphql: %v: %v", e.Type, e.Message)
+}
+
+// graphQL sends query to the GraphQL API with the given variables and decodes
+// the "data" field of the response into v. It returns the errors reported by
+// the response, if any, as *GraphQLError values.
+func (c *Client) graphQL(ctx context.Context, query string, variables map[string]interface{}, v interface{}) (*Response, error) {
+	// GitHub Enterprise Server serves the GraphQL API at /api/graphql
+	// rather than under the REST API's /api/v3/.
+	u := "graphql"
+	if strings.HasSuffix(c.BaseURL.Path, "/api/v3/") {
+		u = "../graphql"
+	}
+
+	body := struct {
+		Query     string                 `json:"query"`
+		Variables map[string]interface{} `json:"variables,omitempty"`
+	}{query, variables}
+	req, err := c.NewRequest("POST", u, body)
+	if err != nil {
+		return nil, err
+	}
+
+	var result struct {
+		Data   json.RawMessage `json:"data"`
+		Errors []*GraphQLError `json:"errors"`
+	}
+	resp, err := c.Do(ctx, req, &result)
+	if err != nil {
+		return resp, err
+	}
+	if len(result.Errors) > 0 {
+		errs := make([]error, len(result.Errors))
+		for i, e := range result.Errors {
+			errs[i] = e
+		}
+		return resp, errors.Join(errs...)
+	}
+	if v != nil && len(result.Data) > 0 {
+		if err := json.Unmarshal(result.Data, v); err != nil {
+			return resp, err
+		}
+	}
+	return resp, nil
+}
@@ -0,0 +1,92 @@
+// Copyright 2026 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestClient_graphQL(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"query":"query { viewer { login } }","variables":{"a":1}}`+"\n")
+		fmt.Fprint(w, `{"data":{"viewer":{"login":"l"}}}`)
+	})
+
+	var data struct {
+		Viewer struct {
+			Login string `json:"login"`
+		} `json:"viewer"`
+	}
+	ctx := context.Background()
+	if _, err := client.graphQL(ctx, "query { viewer { login } }", map[string]interface{}{"a": 1}, &data); err != nil {
+		t.Fatalf("graphQL returned error: %v", err)
+	}
+	if got, want := data.Viewer.Login, "l"; got != want {
+		t.Errorf("graphQL decoded login %q, want %q", got, want)
+	}
+}
+
+func TestClient_graphQL_errors(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data":null,"errors":[
+			{"type":"NOT_FOUND","message":"not found","path":["node",0]},
+			{"message":"bad"}
+		]}`)
+	})
+
+	ctx := context.Background()
+	_, err := client.graphQL(ctx, "query { node }", nil, nil)
+	if err == nil {
+		t.Fatal("graphQL returned nil error")
+	}
+	var gqlErr *GraphQLError
+	if !errors.As(err, &gqlErr) {
+		t.Fatalf("graphQL returned %T, want *GraphQLError", err)
+	}
+	want := &GraphQLError{Type: "NOT_FOUND", Message: "not found", Path: []interface{}{"node", float64(0)}}
+	if !cmp.Equal(gqlErr, want) {
+		t.Errorf("graphQL returned %+v, want %+v", gqlErr, want)
+	}
+	if got, want := err.Error(), "graphql: NOT_FOUND: not found\ngraphql: bad"; got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+}
+
+func TestClient_graphQL_enterprise(t *testing.T) {
+	t.Parallel()
+	var gotPath string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		fmt.Fprint(w, `{"data":{}}`)
+	}))
+	t.Cleanup(srv.Close)
+
+	client, err := NewClient(nil).WithEnterpriseURLs(srv.URL+"/", srv.URL+"/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	if _, err := client.graphQL(ctx, "query { viewer { login } }", nil, nil); err != nil {
+		t.Fatalf("graphQL returned error: %v", err)
+	}
+	if want := "/api/graphql"; gotPath != want {
+		t.Errorf("graphQL requested %v, want %v", gotPath, want)
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright 2026 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+)
+
+// GetRestrictionsForUser fetches the interaction restrictions for the
+// authenticated user's public repositories.
+//
+// GitHub API docs: https://docs.github.com/rest/interactions/user#get-interaction-restrictions-for-your-public-repositories
+//
+//meta:operation GET /user/interaction-limits
+func (s *InteractionsService) GetRestrictionsForUser(ctx context.Context) (*InteractionRestriction, *Response, error) {
+	req, err := s.client.NewRequest("GET", "user/interaction-limits", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	userInteractions := new(InteractionRestriction)
+
+	resp, err := s.client.Do(ctx, req, userInteractions)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return userInteractions, resp, nil
+}
+
+// UpdateRestrictionsForUser adds or updates the interaction restrictions for
+// the authenticated user's public repositories. Restrictions set at the user
+// level override any set on the individual repositories.
+//
+// limit specifies the group of GitHub users who can comment, open issues, or create pull requests
+// in the authenticated user's public repositories.
+// Possible values are: "existing_users", "contributors_only", "collaborators_only".
+//
+// GitHub API docs: https://docs.github.com/rest/interactions/user#set-interaction-restrictions-for-your-public-repositories
+//
+//meta:operation PUT /user/interaction-limits
+func (s *InteractionsService) UpdateRestrictionsForUser(ctx context.Context, limit string) (*InteractionRestriction, *Response, error) {
+	interaction := &InteractionRestriction{Limit: Ptr(limit)}
+
+	req, err := s.client.NewRequest("PUT", "user/interaction-limits", interaction)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	userInteractions := new(InteractionRestriction)
+
+	resp, err := s.client.Do(ctx, req, userInteractions)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return userInteractions, resp, nil
+}
+
+// RemoveRestrictionsFromUser removes the interaction restrictions for the
+// authenticated user's public repositories.
+//
+// GitHub API docs: https://docs.github.com/rest/interactions/user#remove-interaction-restrictions-from-your-public-repositories
+//
+//meta:operation DELETE /user/interaction-limits
+func (s *InteractionsService) RemoveRestrictionsFromUser(ctx context.Context) (*Response, error) {
+	req, err := s.client.NewRequest("DELETE", "user/interaction-limits", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
@@ -0,0 +1,109 @@
+// Copyright 2026 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestInteractionsService_GetRestrictionsForUser(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/user/interaction-limits", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"limit":"collaborators_only","origin":"user","expires_at":"2006-01-02T15:04:05Z"}`)
+	})
+
+	ctx := context.Background()
+	userInteractions, _, err := client.Interactions.GetRestrictionsForUser(ctx)
+	if err != nil {
+		t.Errorf("Interactions.GetRestrictionsForUser returned error: %v", err)
+	}
+
+	want := &InteractionRestriction{
+		Limit:     Ptr("collaborators_only"),
+		Origin:    Ptr("user"),
+		ExpiresAt: &Timestamp{referenceTime},
+	}
+	if !cmp.Equal(userInteractions, want) {
+		t.Errorf("Interactions.GetRestrictionsForUser returned %+v, want %+v", userInteractions, want)
+	}
+
+	const methodName = "GetRestrictionsForUser"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Interactions.GetRestrictionsForUser(ctx)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestInteractionsService_UpdateRestrictionsForUser(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	input := &InteractionRestriction{Limit: Ptr("existing_users")}
+
+	mux.HandleFunc("/user/interaction-limits", func(w http.ResponseWriter, r *http.Request) {
+		v := new(InteractionRestriction)
+		assertNilError(t, json.NewDecoder(r.Body).Decode(v))
+
+		testMethod(t, r, "PUT")
+		if !cmp.Equal(v, input) {
+			t.Errorf("Request body = %+v, want %+v", v, input)
+		}
+		fmt.Fprint(w, `{"origin":"user"}`)
+	})
+
+	ctx := context.Background()
+	userInteractions, _, err := client.Interactions.UpdateRestrictionsForUser(ctx, input.GetLimit())
+	if err != nil {
+		t.Errorf("Interactions.UpdateRestrictionsForUser returned error: %v", err)
+	}
+
+	want := &InteractionRestriction{Origin: Ptr("user")}
+	if !cmp.Equal(userInteractions, want) {
+		t.Errorf("Interactions.UpdateRestrictionsForUser returned %+v, want %+v", userInteractions, want)
+	}
+
+	const methodName = "UpdateRestrictionsForUser"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Interactions.UpdateRestrictionsForUser(ctx, input.GetLimit())
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestInteractionsService_RemoveRestrictionsFromUser(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/user/interaction-limits", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	_, err := client.Interactions.RemoveRestrictionsFromUser(ctx)
+	if err != nil {
+		t.Errorf("Interactions.RemoveRestrictionsFromUser returned error: %v", err)
+	}
+
+	const methodName = "RemoveRestrictionsFromUser"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Interactions.RemoveRestrictionsFromUser(ctx)
+	})
+}
@@ -8,6 +8,8 @@ package github
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"strings"
 	"time"
 )
 
@@ -32,6 +34,113 @@ func (i IssueComment) String() string {
 	return Stringify(i)
 }
 
+// mentionRE matches @user and @org/team mentions. The leading group rejects
+// matches that are part of an email address, URL, or another word.
+var mentionRE = regexp.MustCompile(`(?:^|[^A-Za-z0-9_/@.` + "`" + `])@([A-Za-z0-9](?:-?[A-Za-z0-9]){0,38}(?:/[A-Za-z0-9][A-Za-z0-9_-]*)?)`)
+
+// Mentions returns the users and teams mentioned in the comment body, in
+// order of first appearance and without duplicates. Users are returned as
+// "login" and teams as "org/team-slug". Mentions inside Markdown code spans
+// and fenced code blocks are ignored, matching how GitHub renders them.
+func (i *IssueComment) Mentions() []string {
+	return parseMentions(i.GetBody())
+}
+
+// parseMentions returns the @-mentions found in the Markdown text body.
+func parseMentions(body string) []string {
+	var mentions []string
+	seen := map[string]bool{}
+	for _, line := range strings.Split(stripMarkdownCode(body), "\n") {
+		for _, m := range mentionRE.FindAllStringSubmatch(line, -1) {
+			if !seen[m[1]] {
+				seen[m[1]] = true
+				mentions = append(mentions, m[1])
+			}
+		}
+	}
+	return mentions
+}
+
+// stripMarkdownCode returns body with the contents of fenced code blocks and
+// inline code spans blanked out, so that text inside them is not mistaken for
+// references such as mentions. Line structure is preserved.
+func stripMarkdownCode(body string) string {
+	lines := strings.Split(body, "\n")
+	var fence string
+	for i, line := range lines {
+		trimmed := strings.TrimLeft(line, " ")
+		if fence != "" {
+			if strings.HasPrefix(trimmed, fence) && strings.Trim(trimmed, fence[:1]+" \t\r") == "" {
+				fence = ""
+			}
+			lines[i] = ""
+			continue
+		}
+		if len(line)-len(trimmed) <= 3 {
+			if f := codeFence(trimmed); f != "" {
+				fence = f
+				lines[i] = ""
+				continue
+			}
+		}
+		lines[i] = stripCodeSpans(line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// codeFence returns the opening code fence (three or more backticks or
+// tildes) at the start of line, or "" if there is none.
+func codeFence(line string) string {
+	for _, c := range []string{"`", "~"} {
+		n := len(line) - len(strings.TrimLeft(line, c))
+		if n >= 3 {
+			return strings.Repeat(c, n)
+		}
+	}
+	return ""
+}
+
+// stripCodeSpans removes inline code spans from line. A code span starts with
+// a run of backticks and ends at the next run of the same length; unmatched
+// backticks are left as is.
+func stripCodeSpans(line string) string {
+	var b strings.Builder
+	for {
+		start := strings.IndexByte(line, '`')
+		if start < 0 {
+			b.WriteString(line)
+			return b.String()
+		}
+		n := len(line[start:]) - len(strings.TrimLeft(line[start:], "`"))
+		open := line[start : start+n]
+		rest := line[start+n:]
+
+		end := -1
+		for off := 0; off < len(rest); {
+			i := strings.Index(rest[off:], open)
+			if i < 0 {
+				break
+			}
+			i += off
+			run := len(rest[i:]) - len(strings.TrimLeft(rest[i:], "`"))
+			if run == n {
+				end = i
+				break
+			}
+			off = i + run
+		}
+
+		if end < 0 {
+			b.WriteString(line[:start+n])
+			line = rest
+			continue
+		}
+		b.WriteString(line[:start])
+		b.WriteByte(' ')
+		line = rest[end+n:]
+	}
+}
+
 // IssueListCommentsOptions specifies the optional parameters to the
 // IssuesService.ListComments method.
 type IssueListCommentsOptions struct {
@@ -62,7 +171,7 @@ func (s *IssuesService) ListComments(ctx context.Context, owner string, repo str
 	} else {
 		u = fmt.Sprintf("repos/%v/%v/issues/%d/comments", owner, repo, number)
 	}
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -110,6 +219,8 @@ func (s *IssuesService) GetComment(ctx context.Context, owner string, repo strin
 }
 
 // CreateComment creates a new comment on the specified issue.
+// The returned comment is fully populated, including HTMLURL and Reactions,
+// so callers can use IssueComment.Mentions without fetching it again.
 //
 // GitHub API docs: https://docs.github.com/rest/issues/comments#create-an-issue-comment
 //
@@ -129,6 +240,30 @@ func (s *IssuesService) CreateComment(ctx context.Context, owner string, repo st
 	return c, resp, nil
 }
 
+// CreateCommentChunked creates one or more comments on the specified issue
+// or pull request with the given body. A body longer than MaxBodyLength
+// characters, which CreateComment would fail to post, is split with SplitBody
+// and posted as consecutive comments. It returns the comments created so far
+// and the response of the last request; on error, the remaining parts are not
+// posted.
+//
+// GitHub API docs: https://docs.github.com/rest/issues/comments#create-an-issue-comment
+//
+//meta:operation POST /repos/{owner}/{repo}/issues/{issue_number}/comments
+func (s *IssuesService) CreateCommentChunked(ctx context.Context, owner, repo string, number int, body string) ([]*IssueComment, *Response, error) {
+	var comments []*IssueComment
+	var resp *Response
+	for _, part := range SplitBody(body, MaxBodyLength) {
+		c, r, err := s.CreateComment(ctx, owner, repo, number, &IssueComment{Body: Ptr(part)})
+		resp = r
+		if err != nil {
+			return comments, resp, err
+		}
+		comments = append(comments, c)
+	}
+	return comments, resp, nil
+}
+
 // EditComment updates an issue comment.
 // A non-nil comment.Body must be provided. Other comment fields should be left nil.
 //
@@ -10,6 +10,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
 	"testing"
 	"time"
 
@@ -199,6 +200,47 @@ func TestIssuesService_CreateComment(t *testing.T) {
 	})
 }
 
+func TestIssuesService_CreateCommentChunked(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	var bodies []string
+	mux.HandleFunc("/repos/o/r/issues/1/comments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		v := new(IssueComment)
+		assertNilError(t, json.NewDecoder(r.Body).Decode(v))
+		bodies = append(bodies, v.GetBody())
+		if len(bodies) > 2 {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			return
+		}
+		fmt.Fprintf(w, `{"id":%v}`, len(bodies))
+	})
+
+	ctx := context.Background()
+	para := strings.Repeat("x", MaxBodyLength-10)
+	comments, _, err := client.Issues.CreateCommentChunked(ctx, "o", "r", 1, para+"\n\n"+para)
+	if err != nil {
+		t.Fatalf("Issues.CreateCommentChunked returned error: %v", err)
+	}
+	if want := []*IssueComment{{ID: Ptr(int64(1))}, {ID: Ptr(int64(2))}}; !cmp.Equal(comments, want) {
+		t.Errorf("Issues.CreateCommentChunked returned %+v, want %+v", comments, want)
+	}
+	if want := []string{para, para}; !cmp.Equal(bodies, want) {
+		t.Errorf("Issues.CreateCommentChunked posted %v comments, want 2 paragraphs", len(bodies))
+	}
+
+	// A failing part stops the remaining parts from being posted.
+	bodies = nil
+	comments, _, err = client.Issues.CreateCommentChunked(ctx, "o", "r", 1, para+"\n\n"+para+"\n\n"+para)
+	if err == nil {
+		t.Error("Issues.CreateCommentChunked returned nil error, want error")
+	}
+	if len(comments) != 2 || len(bodies) != 3 {
+		t.Errorf("Issues.CreateCommentChunked created %v comments in %v requests, want 2 in 3", len(comments), len(bodies))
+	}
+}
+
 func TestIssuesService_CreateComment_invalidOrg(t *testing.T) {
 	t.Parallel()
 	client, _, _ := setup(t)
@@ -369,3 +411,49 @@ func TestIssueComment_Marshal(t *testing.T) {
 
 	testJSONMarshal(t, u, want)
 }
+
+func TestIssueComment_Mentions(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		body string
+		want []string
+	}{
+		{name: "empty", body: "", want: nil},
+		{name: "single", body: "@octocat please review", want: []string{"octocat"}},
+		{name: "multiple and dedup", body: "cc @a, @b-c and @a again", want: []string{"a", "b-c"}},
+		{name: "team", body: "/cc @github/docs-team.", want: []string{"github/docs-team"}},
+		{name: "punctuation", body: "(@octocat) thanks @hubot!", want: []string{"octocat", "hubot"}},
+		{name: "email", body: "mail me at octo@example.com", want: nil},
+		{name: "url", body: "see https://example.com/@octocat", want: nil},
+		{name: "inline code", body: "run `git blame @file` then ping @octocat", want: []string{"octocat"}},
+		{name: "double backtick code", body: "``a ` @nope`` @yes", want: []string{"yes"}},
+		{name: "unmatched backtick", body: "a ` @yes", want: []string{"yes"}},
+		{
+			name: "fenced code block",
+			body: "before @a\n```go\n// @nope\n```\nafter @b",
+			want: []string{"a", "b"},
+		},
+		{
+			name: "tilde fence",
+			body: "~~~~\n@nope\n~~~\n@nope2\n~~~~\n@yes",
+			want: []string{"yes"},
+		},
+		{
+			name: "unterminated fence",
+			body: "@a\n```\n@nope",
+			want: []string{"a"},
+		},
+	}
+
+	for _, tt := range tests {
+		c := &IssueComment{Body: Ptr(tt.body)}
+		if got := c.Mentions(); !cmp.Equal(got, tt.want) {
+			t.Errorf("%v: Mentions() = %#v, want %#v", tt.name, got, tt.want)
+		}
+	}
+
+	if got := new(IssueComment).Mentions(); got != nil {
+		t.Errorf("Mentions() on nil body = %#v, want nil", got)
+	}
+}
@@ -7,6 +7,7 @@ package github
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strings"
 )
@@ -160,6 +161,20 @@ type Timeline struct {
 	SubmittedAt *Timestamp `json:"submitted_at,omitempty"`
 
 	PerformedViaGithubApp *App `json:"performed_via_github_app,omitempty"`
+
+	// raw holds the event as returned by the API, for Typed.
+	raw json.RawMessage
+}
+
+func (t *Timeline) UnmarshalJSON(data []byte) error {
+	type timelineAlias Timeline
+	var v timelineAlias
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*t = Timeline(v)
+	t.raw = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // Source represents a reference's source.
@@ -171,6 +186,128 @@ type Source struct {
 	Issue *Issue  `json:"issue,omitempty"`
 }
 
+// TimelineLabelEvent is the typed form of a "labeled" or "unlabeled" Timeline event.
+type TimelineLabelEvent struct {
+	Event     string
+	Actor     *User
+	Label     *Label
+	CreatedAt *Timestamp
+}
+
+// TimelineAssignEvent is the typed form of an "assigned" or "unassigned" Timeline event.
+type TimelineAssignEvent struct {
+	Event     string
+	Actor     *User
+	Assignee  *User
+	Assigner  *User
+	CreatedAt *Timestamp
+}
+
+// TimelineMilestoneEvent is the typed form of a "milestoned" or "demilestoned" Timeline event.
+type TimelineMilestoneEvent struct {
+	Event     string
+	Actor     *User
+	Milestone *Milestone
+	CreatedAt *Timestamp
+}
+
+// TimelineRenameEvent is the typed form of a "renamed" Timeline event.
+type TimelineRenameEvent struct {
+	Actor     *User
+	Rename    *Rename
+	CreatedAt *Timestamp
+}
+
+// TimelineCrossReferenceEvent is the typed form of a "cross-referenced"
+// Timeline event. Source.Issue is the issue or pull request the reference
+// was made from.
+type TimelineCrossReferenceEvent struct {
+	Actor     *User
+	Source    *Source
+	CreatedAt *Timestamp
+}
+
+// TimelineCommitEvent is the typed form of a "committed" Timeline event.
+type TimelineCommitEvent struct {
+	SHA       *string
+	URL       *string
+	Message   *string
+	Author    *CommitAuthor
+	Committer *CommitAuthor
+	Parents   []*Commit
+}
+
+// TimelineCommentEvent is the typed form of a "commented" Timeline event.
+type TimelineCommentEvent struct {
+	ID        *int64
+	User      *User
+	Body      *string
+	CreatedAt *Timestamp
+}
+
+// TimelineReviewEvent is the typed form of a "reviewed" Timeline event.
+type TimelineReviewEvent struct {
+	ID          *int64
+	User        *User
+	State       *string
+	Body        *string
+	SubmittedAt *Timestamp
+}
+
+// TimelineReviewRequestEvent is the typed form of a "review_requested" or
+// "review_request_removed" Timeline event. Either Reviewer or RequestedTeam
+// is set.
+type TimelineReviewRequestEvent struct {
+	Event         string
+	Actor         *User
+	Reviewer      *User
+	RequestedTeam *Team
+	Requester     *User
+	CreatedAt     *Timestamp
+}
+
+// TimelineRawEvent is the typed form of a Timeline event without a dedicated
+// type. Raw holds the event as returned by the API, including any fields that
+// Timeline does not have.
+type TimelineRawEvent struct {
+	Event string
+	Raw   json.RawMessage
+}
+
+// Typed returns the fields of t relevant to its Event as one of the
+// Timeline*Event types, such as *TimelineLabelEvent for "labeled" and
+// "unlabeled" events, so that callers can use a type switch instead of
+// checking which fields are set. Events without a dedicated type are returned
+// as a *TimelineRawEvent.
+func (t *Timeline) Typed() interface{} {
+	switch event := t.GetEvent(); event {
+	case "labeled", "unlabeled":
+		return &TimelineLabelEvent{Event: event, Actor: t.Actor, Label: t.Label, CreatedAt: t.CreatedAt}
+	case "assigned", "unassigned":
+		return &TimelineAssignEvent{Event: event, Actor: t.Actor, Assignee: t.Assignee, Assigner: t.Assigner, CreatedAt: t.CreatedAt}
+	case "milestoned", "demilestoned":
+		return &TimelineMilestoneEvent{Event: event, Actor: t.Actor, Milestone: t.Milestone, CreatedAt: t.CreatedAt}
+	case "renamed":
+		return &TimelineRenameEvent{Actor: t.Actor, Rename: t.Rename, CreatedAt: t.CreatedAt}
+	case "cross-referenced":
+		return &TimelineCrossReferenceEvent{Actor: t.Actor, Source: t.Source, CreatedAt: t.CreatedAt}
+	case "committed":
+		return &TimelineCommitEvent{SHA: t.SHA, URL: t.URL, Message: t.Message, Author: t.Author, Committer: t.Committer, Parents: t.Parents}
+	case "commented":
+		return &TimelineCommentEvent{ID: t.ID, User: t.User, Body: t.Body, CreatedAt: t.CreatedAt}
+	case "reviewed":
+		return &TimelineReviewEvent{ID: t.ID, User: t.User, State: t.State, Body: t.Body, SubmittedAt: t.SubmittedAt}
+	case "review_requested", "review_request_removed":
+		return &TimelineReviewRequestEvent{Event: event, Actor: t.Actor, Reviewer: t.Reviewer, RequestedTeam: t.RequestedTeam, Requester: t.Requester, CreatedAt: t.CreatedAt}
+	}
+	raw := t.raw
+	if raw == nil {
+		// t was not unmarshaled from an API response.
+		raw, _ = json.Marshal(t)
+	}
+	return &TimelineRawEvent{Event: t.GetEvent(), Raw: raw}
+}
+
 // ListIssueTimeline lists events for the specified issue.
 //
 // GitHub API docs: https://docs.github.com/rest/issues/timeline#list-timeline-events-for-an-issue
@@ -178,7 +315,7 @@ type Source struct {
 //meta:operation GET /repos/{owner}/{repo}/issues/{issue_number}/timeline
 func (s *IssuesService) ListIssueTimeline(ctx context.Context, owner, repo string, number int, opts *ListOptions) ([]*Timeline, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/issues/%v/timeline", owner, repo, number)
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -200,3 +337,89 @@ func (s *IssuesService) ListIssueTimeline(ctx context.Context, owner, repo strin
 
 	return events, resp, nil
 }
+
+// CrossReference is an issue or pull request that references another issue,
+// as resolved from a "cross-referenced" Timeline event by
+// IssuesService.ListCrossReferences.
+type CrossReference struct {
+	Owner  string
+	Repo   string
+	Number int
+	// Type is "issue" or "pull_request".
+	Type string
+
+	// Issue is the referencing issue or pull request, as included in the event.
+	Issue     *Issue
+	Actor     *User
+	CreatedAt *Timestamp
+}
+
+// ListCrossReferences returns the issues and pull requests, in this or other
+// repositories, that reference the specified issue or pull request, in the
+// order the references were made. It pages through the whole timeline of the
+// issue. An issue or pull request that referenced it several times is only
+// returned once, for its first reference.
+//
+// GitHub API docs: https://docs.github.com/rest/issues/timeline#list-timeline-events-for-an-issue
+//
+//meta:operation GET /repos/{owner}/{repo}/issues/{issue_number}/timeline
+func (s *IssuesService) ListCrossReferences(ctx context.Context, owner, repo string, number int) ([]*CrossReference, *Response, error) {
+	var refs []*CrossReference
+	seen := make(map[string]bool)
+	opts := &ListOptions{PerPage: 100}
+	for {
+		events, resp, err := s.ListIssueTimeline(ctx, owner, repo, number, opts)
+		if err != nil {
+			return nil, resp, err
+		}
+		for _, event := range events {
+			ref, ok := newCrossReference(event)
+			if !ok {
+				continue
+			}
+			key := fmt.Sprintf("%v/%v#%v", ref.Owner, ref.Repo, ref.Number)
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			refs = append(refs, ref)
+		}
+		if resp.NextPage == 0 {
+			return refs, resp, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+// newCrossReference resolves the source of a "cross-referenced" Timeline
+// event. It reports false for other events, and for sources it cannot
+// resolve.
+func newCrossReference(t *Timeline) (*CrossReference, bool) {
+	if t.GetEvent() != "cross-referenced" || t.GetSource().Issue == nil {
+		return nil, false
+	}
+	issue := t.Source.Issue
+
+	owner, repo, number, ok := parseIssueURL(issue.GetURL())
+	if !ok {
+		// Fall back to the embedded repository, if there is one.
+		owner, repo, number = issue.GetRepository().GetOwner().GetLogin(), issue.GetRepository().GetName(), issue.GetNumber()
+		if owner == "" || repo == "" || number == 0 {
+			return nil, false
+		}
+	}
+
+	typ := "issue"
+	if issue.IsPullRequest() {
+		typ = "pull_request"
+	}
+	return &CrossReference{
+		Owner:     owner,
+		Repo:      repo,
+		Number:    number,
+		Type:      typ,
+		Issue:     issue,
+		Actor:     t.Actor,
+		CreatedAt: t.CreatedAt,
+	}, true
+}
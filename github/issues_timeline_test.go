@@ -7,12 +7,14 @@ package github
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 )
 
 func TestIssuesService_ListIssueTimeline(t *testing.T) {
@@ -38,7 +40,7 @@ func TestIssuesService_ListIssueTimeline(t *testing.T) {
 	}
 
 	want := []*Timeline{{ID: Ptr(int64(1))}}
-	if !cmp.Equal(events, want) {
+	if !cmp.Equal(events, want, cmpopts.IgnoreUnexported(Timeline{})) {
 		t.Errorf("Issues.ListIssueTimeline = %+v, want %+v", events, want)
 	}
 
@@ -337,9 +339,111 @@ func TestTimeline_ReviewRequests(t *testing.T) {
 		},
 	}
 
-	if !cmp.Equal(events, want) {
+	if !cmp.Equal(events, want, cmpopts.IgnoreUnexported(Timeline{})) {
 		t.Errorf("Issues.ListIssueTimeline review request events = %+v, want %+v", events, want)
-		diff := cmp.Diff(events, want)
+		diff := cmp.Diff(events, want, cmpopts.IgnoreUnexported(Timeline{}))
 		t.Errorf("Difference: %s", diff)
 	}
 }
+
+func TestTimeline_Typed(t *testing.T) {
+	t.Parallel()
+	var events []*Timeline
+	assertNilError(t, json.Unmarshal([]byte(`[
+		{"event":"labeled","actor":{"login":"a"},"label":{"name":"bug"}},
+		{"event":"unassigned","actor":{"login":"a"},"assignee":{"login":"b"}},
+		{"event":"demilestoned","milestone":{"title":"v1"}},
+		{"event":"renamed","rename":{"from":"x","to":"y"}},
+		{"event":"cross-referenced","actor":{"login":"a"},"source":{"type":"issue","issue":{"number":7,"repository":{"full_name":"o/other"}}}},
+		{"event":"committed","sha":"s","message":"m","author":{"name":"n"}},
+		{"event":"commented","id":1,"user":{"login":"c"},"body":"hi"},
+		{"event":"reviewed","id":2,"user":{"login":"d"},"state":"approved"},
+		{"event":"review_requested","requested_team":{"slug":"t"}},
+		{"event":"head_ref_deleted","actor":{"login":"a"},"extra":1}
+	]`), &events))
+
+	want := []interface{}{
+		&TimelineLabelEvent{Event: "labeled", Actor: &User{Login: Ptr("a")}, Label: &Label{Name: Ptr("bug")}},
+		&TimelineAssignEvent{Event: "unassigned", Actor: &User{Login: Ptr("a")}, Assignee: &User{Login: Ptr("b")}},
+		&TimelineMilestoneEvent{Event: "demilestoned", Milestone: &Milestone{Title: Ptr("v1")}},
+		&TimelineRenameEvent{Rename: &Rename{From: Ptr("x"), To: Ptr("y")}},
+		&TimelineCrossReferenceEvent{Actor: &User{Login: Ptr("a")}, Source: &Source{Type: Ptr("issue"), Issue: &Issue{Number: Ptr(7), Repository: &Repository{FullName: Ptr("o/other")}}}},
+		&TimelineCommitEvent{SHA: Ptr("s"), Message: Ptr("m"), Author: &CommitAuthor{Name: Ptr("n")}},
+		&TimelineCommentEvent{ID: Ptr(int64(1)), User: &User{Login: Ptr("c")}, Body: Ptr("hi")},
+		&TimelineReviewEvent{ID: Ptr(int64(2)), User: &User{Login: Ptr("d")}, State: Ptr("approved")},
+		&TimelineReviewRequestEvent{Event: "review_requested", RequestedTeam: &Team{Slug: Ptr("t")}},
+		&TimelineRawEvent{Event: "head_ref_deleted", Raw: json.RawMessage(`{"event":"head_ref_deleted","actor":{"login":"a"},"extra":1}`)},
+	}
+
+	for i, event := range events {
+		if got := event.Typed(); !cmp.Equal(got, want[i]) {
+			t.Errorf("Timeline.Typed for %v returned %+v, want %+v", event.GetEvent(), got, want[i])
+		}
+	}
+
+	event := &Timeline{Event: Ptr("locked"), Actor: &User{Login: Ptr("a")}}
+	want0 := &TimelineRawEvent{Event: "locked", Raw: json.RawMessage(`{"actor":{"login":"a"},"event":"locked"}`)}
+	if got := event.Typed(); !cmp.Equal(got, want0) {
+		t.Errorf("Timeline.Typed for an event not unmarshaled returned %+v, want %+v", got, want0)
+	}
+}
+
+func TestIssuesService_ListCrossReferences(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/issues/1/timeline", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if r.FormValue("page") == "" {
+			w.Header().Set("Link", `<https://api.github.com/repos/o/r/issues/1/timeline?page=2>; rel="next"`)
+			fmt.Fprint(w, `[
+				{"event":"labeled"},
+				{"event":"cross-referenced","actor":{"login":"a"},"source":{"type":"issue","issue":{"number":5,"url":"https://api.github.com/repos/other/repo/issues/5"}}},
+				{"event":"cross-referenced","source":{"type":"issue","issue":{"number":7,"url":"https://api.github.com/repos/o/r/issues/7","pull_request":{"url":"https://api.github.com/repos/o/r/pulls/7"}}}}
+			]`)
+			return
+		}
+		fmt.Fprint(w, `[
+			{"event":"cross-referenced","source":{"type":"issue","issue":{"number":5,"url":"https://api.github.com/repos/other/repo/issues/5"}}},
+			{"event":"cross-referenced","source":{"type":"issue","issue":{"number":9,"repository":{"name":"x","owner":{"login":"y"}}}}},
+			{"event":"cross-referenced","source":{"type":"issue"}}
+		]`)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Issues.ListCrossReferences(ctx, "o", "r", 1)
+	if err != nil {
+		t.Fatalf("Issues.ListCrossReferences returned error: %v", err)
+	}
+
+	type ref struct {
+		Owner, Repo, Type, Actor string
+		Number                   int
+	}
+	var refs []ref
+	for _, r := range got {
+		refs = append(refs, ref{Owner: r.Owner, Repo: r.Repo, Type: r.Type, Actor: r.Actor.GetLogin(), Number: r.Number})
+	}
+	want := []ref{
+		{Owner: "other", Repo: "repo", Type: "issue", Actor: "a", Number: 5},
+		{Owner: "o", Repo: "r", Type: "pull_request", Number: 7},
+		{Owner: "y", Repo: "x", Type: "issue", Number: 9},
+	}
+	if !cmp.Equal(refs, want) {
+		t.Errorf("Issues.ListCrossReferences returned %+v, want %+v", refs, want)
+	}
+
+	const methodName = "ListCrossReferences"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Issues.ListCrossReferences(ctx, "\n", "\n", -1)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Issues.ListCrossReferences(ctx, "o", "r", 1)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
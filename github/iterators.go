@@ -0,0 +1,1041 @@
+// Copyright 2026 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.23
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"iter"
+	"path"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// listIter returns an iterator over every item of a paginated list endpoint.
+// Before each call to fetch, *page is set to the page to retrieve; callers
+// should point it at the Page field of a copy of their options so that the
+// caller's value is not modified. Iteration ends after the last page, when
+// the consumer stops early, or after the first error, which is yielded
+// together with the zero value of T. ctx is checked before every item, not
+// only when fetching a page, so that a consumer canceling ctx mid-page gets
+// ctx.Err() back on its next step instead of the rest of the page.
+func listIter[T any](ctx context.Context, page *int, fetch func() ([]T, *Response, error)) iter.Seq2[T, error] {
+	return pagedIter(ctx, fetch, func(resp *Response) bool {
+		if resp == nil || resp.NextPage == 0 {
+			return false
+		}
+		*page = resp.NextPage
+		return true
+	})
+}
+
+// cursorListIter is like listIter for endpoints that use cursor pagination,
+// setting *cursor (typically ListCursorOptions.Cursor) before each fetch.
+func cursorListIter[T any](ctx context.Context, cursor *string, fetch func() ([]T, *Response, error)) iter.Seq2[T, error] {
+	return pagedIter(ctx, fetch, func(resp *Response) bool {
+		if resp == nil || resp.Cursor == "" {
+			return false
+		}
+		*cursor = resp.Cursor
+		return true
+	})
+}
+
+// pagedIter implements listIter and cursorListIter. After each page, advance
+// is called with its response to point fetch at the next page, and reports
+// whether there is one.
+func pagedIter[T any](ctx context.Context, fetch func() ([]T, *Response, error), advance func(*Response) bool) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		for {
+			items, resp, err := fetch()
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+			for _, item := range items {
+				if err := ctx.Err(); err != nil {
+					yield(zero, err)
+					return
+				}
+				if !yield(item, nil) {
+					return
+				}
+			}
+			if !advance(resp) {
+				return
+			}
+		}
+	}
+}
+
+// WriteNDJSON writes each item of seq, such as an iterator returned by a
+// ListAll method, to w as a line of JSON (newline-delimited JSON).
+// If w has a Flush method, such as that of *bufio.Writer or
+// http.ResponseWriter, it is called after each item, so that items are
+// written as they are fetched. WriteNDJSON stops at, and returns, the first
+// error from seq or from writing to w; the items before it have already been
+// written.
+func WriteNDJSON[T any](w io.Writer, seq iter.Seq2[T, error]) error {
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+	for item, err := range seq {
+		if err != nil {
+			return err
+		}
+		if err := enc.Encode(item); err != nil {
+			return err
+		}
+		switch f := w.(type) {
+		case interface{ Flush() error }:
+			if err := f.Flush(); err != nil {
+				return err
+			}
+		case interface{ Flush() }:
+			f.Flush()
+		}
+	}
+	return nil
+}
+
+// ListDiscussionsBySlugAll returns an iterator over all discussions on a team's
+// page, fetching further pages as needed. See ListDiscussionsBySlug.
+//
+// GitHub API docs: https://docs.github.com/rest/teams/discussions#list-discussions
+//
+//meta:operation GET /orgs/{org}/teams/{team_slug}/discussions
+func (s *TeamsService) ListDiscussionsBySlugAll(ctx context.Context, org, slug string, opts *DiscussionListOptions) iter.Seq2[*TeamDiscussion, error] {
+	o := new(DiscussionListOptions)
+	if opts != nil {
+		*o = *opts
+	}
+	return listIter(ctx, &o.Page, func() ([]*TeamDiscussion, *Response, error) {
+		return s.ListDiscussionsBySlug(ctx, org, slug, o)
+	})
+}
+
+// ListCommentsBySlugAll returns an iterator over all comments on a team
+// discussion, fetching further pages as needed. See ListCommentsBySlug.
+//
+// GitHub API docs: https://docs.github.com/rest/teams/discussion-comments#list-discussion-comments
+//
+//meta:operation GET /orgs/{org}/teams/{team_slug}/discussions/{discussion_number}/comments
+func (s *TeamsService) ListCommentsBySlugAll(ctx context.Context, org, slug string, discussionNumber int, opts *DiscussionCommentListOptions) iter.Seq2[*DiscussionComment, error] {
+	o := new(DiscussionCommentListOptions)
+	if opts != nil {
+		*o = *opts
+	}
+	return listIter(ctx, &o.Page, func() ([]*DiscussionComment, *Response, error) {
+		return s.ListCommentsBySlug(ctx, org, slug, discussionNumber, o)
+	})
+}
+
+// ListCopilotSeatsAll returns an iterator over all Copilot seat assignments
+// for an organization, fetching further pages as needed. See ListCopilotSeats.
+//
+// GitHub API docs: https://docs.github.com/rest/copilot/copilot-user-management#list-all-copilot-seat-assignments-for-an-organization
+//
+//meta:operation GET /orgs/{org}/copilot/billing/seats
+func (s *CopilotService) ListCopilotSeatsAll(ctx context.Context, org string, opts *ListOptions) iter.Seq2[*CopilotSeatDetails, error] {
+	o := new(ListOptions)
+	if opts != nil {
+		*o = *opts
+	}
+	return listIter(ctx, &o.Page, func() ([]*CopilotSeatDetails, *Response, error) {
+		seats, resp, err := s.ListCopilotSeats(ctx, org, o)
+		if err != nil {
+			return nil, resp, err
+		}
+		return seats.Seats, resp, nil
+	})
+}
+
+// ListCopilotEnterpriseSeatsAll returns an iterator over all Copilot seat
+// assignments for an enterprise, fetching further pages as needed. See
+// ListCopilotEnterpriseSeats.
+//
+// GitHub API docs: https://docs.github.com/enterprise-cloud@latest/rest/copilot/copilot-user-management#list-all-copilot-seat-assignments-for-an-enterprise
+//
+//meta:operation GET /enterprises/{enterprise}/copilot/billing/seats
+func (s *CopilotService) ListCopilotEnterpriseSeatsAll(ctx context.Context, enterprise string, opts *ListOptions) iter.Seq2[*CopilotSeatDetails, error] {
+	o := new(ListOptions)
+	if opts != nil {
+		*o = *opts
+	}
+	return listIter(ctx, &o.Page, func() ([]*CopilotSeatDetails, *Response, error) {
+		seats, resp, err := s.ListCopilotEnterpriseSeats(ctx, enterprise, o)
+		if err != nil {
+			return nil, resp, err
+		}
+		return seats.Seats, resp, nil
+	})
+}
+
+// ListCachesAll returns an iterator over all GitHub Actions caches for a
+// repository, fetching further pages as needed. See ListCaches.
+//
+// GitHub API docs: https://docs.github.com/rest/actions/cache#list-github-actions-caches-for-a-repository
+//
+//meta:operation GET /repos/{owner}/{repo}/actions/caches
+func (s *ActionsService) ListCachesAll(ctx context.Context, owner, repo string, opts *ActionsCacheListOptions) iter.Seq2[*ActionsCache, error] {
+	o := new(ActionsCacheListOptions)
+	if opts != nil {
+		*o = *opts
+	}
+	return listIter(ctx, &o.Page, func() ([]*ActionsCache, *Response, error) {
+		caches, resp, err := s.ListCaches(ctx, owner, repo, o)
+		if err != nil {
+			return nil, resp, err
+		}
+		return caches.ActionsCaches, resp, nil
+	})
+}
+
+// ListCacheUsageByRepoForOrgAll returns an iterator over the GitHub Actions
+// cache usage of every repository in an organization, fetching further pages
+// as needed. See ListCacheUsageByRepoForOrg.
+//
+// GitHub API docs: https://docs.github.com/rest/actions/cache#list-repositories-with-github-actions-cache-usage-for-an-organization
+//
+//meta:operation GET /orgs/{org}/actions/cache/usage-by-repository
+func (s *ActionsService) ListCacheUsageByRepoForOrgAll(ctx context.Context, org string, opts *ListOptions) iter.Seq2[*ActionsCacheUsage, error] {
+	o := new(ListOptions)
+	if opts != nil {
+		*o = *opts
+	}
+	return listIter(ctx, &o.Page, func() ([]*ActionsCacheUsage, *Response, error) {
+		usage, resp, err := s.ListCacheUsageByRepoForOrg(ctx, org, o)
+		if err != nil {
+			return nil, resp, err
+		}
+		return usage.RepoCacheUsage, resp, nil
+	})
+}
+
+// ListOrganizationRunnerGroupsAll returns an iterator over all self-hosted
+// runner groups of an organization, fetching further pages as needed.
+// See ListOrganizationRunnerGroups.
+//
+// GitHub API docs: https://docs.github.com/rest/actions/self-hosted-runner-groups#list-self-hosted-runner-groups-for-an-organization
+//
+//meta:operation GET /orgs/{org}/actions/runner-groups
+func (s *ActionsService) ListOrganizationRunnerGroupsAll(ctx context.Context, org string, opts *ListOrgRunnerGroupOptions) iter.Seq2[*RunnerGroup, error] {
+	o := new(ListOrgRunnerGroupOptions)
+	if opts != nil {
+		*o = *opts
+	}
+	return listIter(ctx, &o.Page, func() ([]*RunnerGroup, *Response, error) {
+		groups, resp, err := s.ListOrganizationRunnerGroups(ctx, org, o)
+		if err != nil {
+			return nil, resp, err
+		}
+		return groups.RunnerGroups, resp, nil
+	})
+}
+
+// ListRepositoryAccessRunnerGroupAll returns an iterator over all
+// repositories with access to a self-hosted runner group, fetching further
+// pages as needed. See ListRepositoryAccessRunnerGroup.
+//
+// GitHub API docs: https://docs.github.com/rest/actions/self-hosted-runner-groups#list-repository-access-to-a-self-hosted-runner-group-in-an-organization
+//
+//meta:operation GET /orgs/{org}/actions/runner-groups/{runner_group_id}/repositories
+func (s *ActionsService) ListRepositoryAccessRunnerGroupAll(ctx context.Context, org string, groupID int64, opts *ListOptions) iter.Seq2[*Repository, error] {
+	o := new(ListOptions)
+	if opts != nil {
+		*o = *opts
+	}
+	return listIter(ctx, &o.Page, func() ([]*Repository, *Response, error) {
+		repos, resp, err := s.ListRepositoryAccessRunnerGroup(ctx, org, groupID, o)
+		if err != nil {
+			return nil, resp, err
+		}
+		return repos.Repositories, resp, nil
+	})
+}
+
+// ListRunnerGroupRunnersAll returns an iterator over all self-hosted runners
+// in a runner group, fetching further pages as needed.
+// See ListRunnerGroupRunners.
+//
+// GitHub API docs: https://docs.github.com/rest/actions/self-hosted-runner-groups#list-self-hosted-runners-in-a-group-for-an-organization
+//
+//meta:operation GET /orgs/{org}/actions/runner-groups/{runner_group_id}/runners
+func (s *ActionsService) ListRunnerGroupRunnersAll(ctx context.Context, org string, groupID int64, opts *ListOptions) iter.Seq2[*Runner, error] {
+	o := new(ListOptions)
+	if opts != nil {
+		*o = *opts
+	}
+	return listIter(ctx, &o.Page, func() ([]*Runner, *Response, error) {
+		runners, resp, err := s.ListRunnerGroupRunners(ctx, org, groupID, o)
+		if err != nil {
+			return nil, resp, err
+		}
+		return runners.Runners, resp, nil
+	})
+}
+
+// ListRunnersAll returns an iterator over all self-hosted runners of an
+// enterprise, fetching further pages as needed. See ListRunners.
+//
+// GitHub API docs: https://docs.github.com/enterprise-cloud@latest/rest/actions/self-hosted-runners#list-self-hosted-runners-for-an-enterprise
+//
+//meta:operation GET /enterprises/{enterprise}/actions/runners
+func (s *EnterpriseService) ListRunnersAll(ctx context.Context, enterprise string, opts *ListRunnersOptions) iter.Seq2[*Runner, error] {
+	o := new(ListRunnersOptions)
+	if opts != nil {
+		*o = *opts
+	}
+	return listIter(ctx, &o.Page, func() ([]*Runner, *Response, error) {
+		runners, resp, err := s.ListRunners(ctx, enterprise, o)
+		if err != nil {
+			return nil, resp, err
+		}
+		return runners.Runners, resp, nil
+	})
+}
+
+// ListRunnerGroupsAll returns an iterator over all self-hosted runner groups
+// of an enterprise, fetching further pages as needed. See ListRunnerGroups.
+//
+// GitHub API docs: https://docs.github.com/enterprise-cloud@latest/rest/actions/self-hosted-runner-groups#list-self-hosted-runner-groups-for-an-enterprise
+//
+//meta:operation GET /enterprises/{enterprise}/actions/runner-groups
+func (s *EnterpriseService) ListRunnerGroupsAll(ctx context.Context, enterprise string, opts *ListEnterpriseRunnerGroupOptions) iter.Seq2[*EnterpriseRunnerGroup, error] {
+	o := new(ListEnterpriseRunnerGroupOptions)
+	if opts != nil {
+		*o = *opts
+	}
+	return listIter(ctx, &o.Page, func() ([]*EnterpriseRunnerGroup, *Response, error) {
+		groups, resp, err := s.ListRunnerGroups(ctx, enterprise, o)
+		if err != nil {
+			return nil, resp, err
+		}
+		return groups.RunnerGroups, resp, nil
+	})
+}
+
+// ListRunnerGroupRunnersAll returns an iterator over all self-hosted runners
+// in an enterprise runner group, fetching further pages as needed.
+// See ListRunnerGroupRunners.
+//
+// GitHub API docs: https://docs.github.com/enterprise-cloud@latest/rest/actions/self-hosted-runner-groups#list-self-hosted-runners-in-a-group-for-an-enterprise
+//
+//meta:operation GET /enterprises/{enterprise}/actions/runner-groups/{runner_group_id}/runners
+func (s *EnterpriseService) ListRunnerGroupRunnersAll(ctx context.Context, enterprise string, groupID int64, opts *ListOptions) iter.Seq2[*Runner, error] {
+	o := new(ListOptions)
+	if opts != nil {
+		*o = *opts
+	}
+	return listIter(ctx, &o.Page, func() ([]*Runner, *Response, error) {
+		runners, resp, err := s.ListRunnerGroupRunners(ctx, enterprise, groupID, o)
+		if err != nil {
+			return nil, resp, err
+		}
+		return runners.Runners, resp, nil
+	})
+}
+
+// ListRepoVariablesAll returns an iterator over all variables of a repository,
+// fetching further pages as needed.
+// See ListRepoVariables.
+//
+// GitHub API docs: https://docs.github.com/rest/actions/variables#list-repository-variables
+//
+//meta:operation GET /repos/{owner}/{repo}/actions/variables
+func (s *ActionsService) ListRepoVariablesAll(ctx context.Context, owner, repo string, opts *ListOptions) iter.Seq2[*ActionsVariable, error] {
+	o := new(ListOptions)
+	if opts != nil {
+		*o = *opts
+	}
+	return listIter(ctx, &o.Page, func() ([]*ActionsVariable, *Response, error) {
+		variables, resp, err := s.ListRepoVariables(ctx, owner, repo, o)
+		if err != nil {
+			return nil, resp, err
+		}
+		return variables.Variables, resp, nil
+	})
+}
+
+// ListRepoOrgVariablesAll returns an iterator over all organization variables
+// available in a repository, fetching further pages as needed.
+// See ListRepoOrgVariables.
+//
+// GitHub API docs: https://docs.github.com/rest/actions/variables#list-repository-organization-variables
+//
+//meta:operation GET /repos/{owner}/{repo}/actions/organization-variables
+func (s *ActionsService) ListRepoOrgVariablesAll(ctx context.Context, owner, repo string, opts *ListOptions) iter.Seq2[*ActionsVariable, error] {
+	o := new(ListOptions)
+	if opts != nil {
+		*o = *opts
+	}
+	return listIter(ctx, &o.Page, func() ([]*ActionsVariable, *Response, error) {
+		variables, resp, err := s.ListRepoOrgVariables(ctx, owner, repo, o)
+		if err != nil {
+			return nil, resp, err
+		}
+		return variables.Variables, resp, nil
+	})
+}
+
+// ListOrgVariablesAll returns an iterator over all variables of an organization,
+// fetching further pages as needed.
+// See ListOrgVariables.
+//
+// GitHub API docs: https://docs.github.com/rest/actions/variables#list-organization-variables
+//
+//meta:operation GET /orgs/{org}/actions/variables
+func (s *ActionsService) ListOrgVariablesAll(ctx context.Context, org string, opts *ListOptions) iter.Seq2[*ActionsVariable, error] {
+	o := new(ListOptions)
+	if opts != nil {
+		*o = *opts
+	}
+	return listIter(ctx, &o.Page, func() ([]*ActionsVariable, *Response, error) {
+		variables, resp, err := s.ListOrgVariables(ctx, org, o)
+		if err != nil {
+			return nil, resp, err
+		}
+		return variables.Variables, resp, nil
+	})
+}
+
+// ListEnvVariablesAll returns an iterator over all variables of an environment,
+// fetching further pages as needed.
+// See ListEnvVariables.
+//
+// GitHub API docs: https://docs.github.com/rest/actions/variables#list-environment-variables
+//
+//meta:operation GET /repos/{owner}/{repo}/environments/{environment_name}/variables
+func (s *ActionsService) ListEnvVariablesAll(ctx context.Context, owner, repo, env string, opts *ListOptions) iter.Seq2[*ActionsVariable, error] {
+	o := new(ListOptions)
+	if opts != nil {
+		*o = *opts
+	}
+	return listIter(ctx, &o.Page, func() ([]*ActionsVariable, *Response, error) {
+		variables, resp, err := s.ListEnvVariables(ctx, owner, repo, env, o)
+		if err != nil {
+			return nil, resp, err
+		}
+		return variables.Variables, resp, nil
+	})
+}
+
+// ListEmailsAll returns an iterator over all email addresses of the
+// authenticated user, fetching further pages as needed. See ListEmails.
+//
+// GitHub API docs: https://docs.github.com/rest/users/emails#list-email-addresses-for-the-authenticated-user
+//
+//meta:operation GET /user/emails
+func (s *UsersService) ListEmailsAll(ctx context.Context, opts *ListOptions) iter.Seq2[*UserEmail, error] {
+	o := new(ListOptions)
+	if opts != nil {
+		*o = *opts
+	}
+	return listIter(ctx, &o.Page, func() ([]*UserEmail, *Response, error) {
+		return s.ListEmails(ctx, o)
+	})
+}
+
+// ListTeamsAssignedToOrgRoleAll returns an iterator over all teams assigned to
+// an organization role, fetching further pages as needed.
+// See ListTeamsAssignedToOrgRole.
+//
+// GitHub API docs: https://docs.github.com/rest/orgs/organization-roles#list-teams-that-are-assigned-to-an-organization-role
+//
+//meta:operation GET /orgs/{org}/organization-roles/{role_id}/teams
+func (s *OrganizationsService) ListTeamsAssignedToOrgRoleAll(ctx context.Context, org string, roleID int64, opts *ListOptions) iter.Seq2[*Team, error] {
+	o := new(ListOptions)
+	if opts != nil {
+		*o = *opts
+	}
+	return listIter(ctx, &o.Page, func() ([]*Team, *Response, error) {
+		return s.ListTeamsAssignedToOrgRole(ctx, org, roleID, o)
+	})
+}
+
+// ListUsersAssignedToOrgRoleAll returns an iterator over all users assigned to
+// an organization role, fetching further pages as needed.
+// See ListUsersAssignedToOrgRole.
+//
+// GitHub API docs: https://docs.github.com/rest/orgs/organization-roles#list-users-that-are-assigned-to-an-organization-role
+//
+//meta:operation GET /orgs/{org}/organization-roles/{role_id}/users
+func (s *OrganizationsService) ListUsersAssignedToOrgRoleAll(ctx context.Context, org string, roleID int64, opts *ListOptions) iter.Seq2[*User, error] {
+	o := new(ListOptions)
+	if opts != nil {
+		*o = *opts
+	}
+	return listIter(ctx, &o.Page, func() ([]*User, *Response, error) {
+		return s.ListUsersAssignedToOrgRole(ctx, org, roleID, o)
+	})
+}
+
+// ListOutsideCollaboratorsAll returns an iterator over all outside
+// collaborators of an organization, fetching further pages as needed.
+// See ListOutsideCollaborators.
+//
+// GitHub API docs: https://docs.github.com/rest/orgs/outside-collaborators#list-outside-collaborators-for-an-organization
+//
+//meta:operation GET /orgs/{org}/outside_collaborators
+func (s *OrganizationsService) ListOutsideCollaboratorsAll(ctx context.Context, org string, opts *ListOutsideCollaboratorsOptions) iter.Seq2[*User, error] {
+	o := new(ListOutsideCollaboratorsOptions)
+	if opts != nil {
+		*o = *opts
+	}
+	return listIter(ctx, &o.Page, func() ([]*User, *Response, error) {
+		return s.ListOutsideCollaborators(ctx, org, o)
+	})
+}
+
+// ListInvitationsAll returns an iterator over all open invitations to
+// collaborate on a repository, fetching further pages as needed. See
+// ListInvitations.
+//
+// GitHub API docs: https://docs.github.com/rest/collaborators/invitations#list-repository-invitations
+//
+//meta:operation GET /repos/{owner}/{repo}/invitations
+func (s *RepositoriesService) ListInvitationsAll(ctx context.Context, owner, repo string, opts *ListOptions) iter.Seq2[*RepositoryInvitation, error] {
+	o := new(ListOptions)
+	if opts != nil {
+		*o = *opts
+	}
+	return listIter(ctx, &o.Page, func() ([]*RepositoryInvitation, *Response, error) {
+		return s.ListInvitations(ctx, owner, repo, o)
+	})
+}
+
+// ListInvitationsAll returns an iterator over all open repository
+// invitations for the authenticated user, fetching further pages as needed.
+// See ListInvitations.
+//
+// GitHub API docs: https://docs.github.com/rest/collaborators/invitations#list-repository-invitations-for-the-authenticated-user
+//
+//meta:operation GET /user/repository_invitations
+func (s *UsersService) ListInvitationsAll(ctx context.Context, opts *ListOptions) iter.Seq2[*RepositoryInvitation, error] {
+	o := new(ListOptions)
+	if opts != nil {
+		*o = *opts
+	}
+	return listIter(ctx, &o.Page, func() ([]*RepositoryInvitation, *Response, error) {
+		return s.ListInvitations(ctx, o)
+	})
+}
+
+// ListInstallationsAll returns an iterator over all GitHub App installations
+// for an organization, fetching further pages as needed. See
+// ListInstallations.
+//
+// GitHub API docs: https://docs.github.com/rest/orgs/orgs#list-app-installations-for-an-organization
+//
+//meta:operation GET /orgs/{org}/installations
+func (s *OrganizationsService) ListInstallationsAll(ctx context.Context, org string, opts *ListOptions) iter.Seq2[*Installation, error] {
+	o := new(ListOptions)
+	if opts != nil {
+		*o = *opts
+	}
+	return listIter(ctx, &o.Page, func() ([]*Installation, *Response, error) {
+		installations, resp, err := s.ListInstallations(ctx, org, o)
+		if err != nil {
+			return nil, resp, err
+		}
+		return installations.Installations, resp, nil
+	})
+}
+
+// ListInstallationRequestsAll returns an iterator over all pending
+// installation requests for the authenticated app, fetching further pages as
+// needed. See ListInstallationRequests.
+//
+// GitHub API docs: https://docs.github.com/rest/apps/apps#list-installation-requests-for-the-authenticated-app
+//
+//meta:operation GET /app/installation-requests
+func (s *AppsService) ListInstallationRequestsAll(ctx context.Context, opts *ListOptions) iter.Seq2[*InstallationRequest, error] {
+	o := new(ListOptions)
+	if opts != nil {
+		*o = *opts
+	}
+	return listIter(ctx, &o.Page, func() ([]*InstallationRequest, *Response, error) {
+		return s.ListInstallationRequests(ctx, o)
+	})
+}
+
+// ListPendingOrgInvitationsAll returns an iterator over all pending
+// invitations to an organization, fetching further pages as needed.
+// See ListPendingOrgInvitations.
+//
+// GitHub API docs: https://docs.github.com/rest/orgs/members#list-pending-organization-invitations
+//
+//meta:operation GET /orgs/{org}/invitations
+func (s *OrganizationsService) ListPendingOrgInvitationsAll(ctx context.Context, org string, opts *ListOptions) iter.Seq2[*Invitation, error] {
+	o := new(ListOptions)
+	if opts != nil {
+		*o = *opts
+	}
+	return listIter(ctx, &o.Page, func() ([]*Invitation, *Response, error) {
+		return s.ListPendingOrgInvitations(ctx, org, o)
+	})
+}
+
+// ListFailedOrgInvitationsAll returns an iterator over all failed
+// invitations to an organization, fetching further pages as needed.
+// See ListFailedOrgInvitations.
+//
+// GitHub API docs: https://docs.github.com/rest/orgs/members#list-failed-organization-invitations
+//
+//meta:operation GET /orgs/{org}/failed_invitations
+func (s *OrganizationsService) ListFailedOrgInvitationsAll(ctx context.Context, org string, opts *ListOptions) iter.Seq2[*Invitation, error] {
+	o := new(ListOptions)
+	if opts != nil {
+		*o = *opts
+	}
+	return listIter(ctx, &o.Page, func() ([]*Invitation, *Response, error) {
+		return s.ListFailedOrgInvitations(ctx, org, o)
+	})
+}
+
+// ListByUserAll returns an iterator over all gists of a user, or of the
+// authenticated user if user is empty, fetching further pages as needed.
+// See List.
+//
+// GitHub API docs: https://docs.github.com/rest/gists/gists#list-gists-for-a-user
+// GitHub API docs: https://docs.github.com/rest/gists/gists#list-gists-for-the-authenticated-user
+//
+//meta:operation GET /gists
+//meta:operation GET /users/{username}/gists
+func (s *GistsService) ListByUserAll(ctx context.Context, user string, opts *GistListOptions) iter.Seq2[*Gist, error] {
+	o := new(GistListOptions)
+	if opts != nil {
+		*o = *opts
+	}
+	return listIter(ctx, &o.Page, func() ([]*Gist, *Response, error) {
+		return s.List(ctx, user, o)
+	})
+}
+
+// ListStarredAll returns an iterator over all gists starred by the
+// authenticated user, fetching further pages as needed. See ListStarred.
+//
+// GitHub API docs: https://docs.github.com/rest/gists/gists#list-starred-gists
+//
+//meta:operation GET /gists/starred
+func (s *GistsService) ListStarredAll(ctx context.Context, opts *GistListOptions) iter.Seq2[*Gist, error] {
+	o := new(GistListOptions)
+	if opts != nil {
+		*o = *opts
+	}
+	return listIter(ctx, &o.Page, func() ([]*Gist, *Response, error) {
+		return s.ListStarred(ctx, o)
+	})
+}
+
+// ListFollowersAll returns an iterator over all followers of a user, or of the
+// authenticated user if user is empty, fetching further pages as needed.
+// See ListFollowers.
+//
+// GitHub API docs: https://docs.github.com/rest/users/followers#list-followers-of-a-user
+// GitHub API docs: https://docs.github.com/rest/users/followers#list-followers-of-the-authenticated-user
+//
+//meta:operation GET /user/followers
+//meta:operation GET /users/{username}/followers
+func (s *UsersService) ListFollowersAll(ctx context.Context, user string, opts *ListOptions) iter.Seq2[*User, error] {
+	o := new(ListOptions)
+	if opts != nil {
+		*o = *opts
+	}
+	return listIter(ctx, &o.Page, func() ([]*User, *Response, error) {
+		return s.ListFollowers(ctx, user, o)
+	})
+}
+
+// ListFollowingAll returns an iterator over all users followed by a user, or
+// by the authenticated user if user is empty, fetching further pages as needed.
+// See ListFollowing.
+//
+// GitHub API docs: https://docs.github.com/rest/users/followers#list-the-people-a-user-follows
+// GitHub API docs: https://docs.github.com/rest/users/followers#list-the-people-the-authenticated-user-follows
+//
+//meta:operation GET /user/following
+//meta:operation GET /users/{username}/following
+func (s *UsersService) ListFollowingAll(ctx context.Context, user string, opts *ListOptions) iter.Seq2[*User, error] {
+	o := new(ListOptions)
+	if opts != nil {
+		*o = *opts
+	}
+	return listIter(ctx, &o.Page, func() ([]*User, *Response, error) {
+		return s.ListFollowing(ctx, user, o)
+	})
+}
+
+// ListReposMatching returns an iterator over the repositories of an
+// organization whose names match pattern, fetching further pages as needed.
+// The API has no filter by name, so every repository of the organization is
+// fetched and matched client-side, but only one page is held in memory at a
+// time. The pattern is a glob, such as "svc-*", unless opts.Regexp is set.
+// An invalid pattern is reported as the iterator's first and only error.
+//
+// GitHub API docs: https://docs.github.com/rest/repos/repos#list-organization-repositories
+//
+//meta:operation GET /orgs/{org}/repos
+func (s *OrganizationsService) ListReposMatching(ctx context.Context, org, pattern string, opts *ListReposMatchingOptions) iter.Seq2[*Repository, error] {
+	o := new(ListReposMatchingOptions)
+	if opts != nil {
+		*o = *opts
+	}
+
+	var match func(name string) bool
+	if o.Regexp {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return func(yield func(*Repository, error) bool) { yield(nil, err) }
+		}
+		match = re.MatchString
+	} else {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return func(yield func(*Repository, error) bool) { yield(nil, err) }
+		}
+		match = func(name string) bool {
+			ok, _ := path.Match(pattern, name)
+			return ok
+		}
+	}
+
+	repos := listIter(ctx, &o.Page, func() ([]*Repository, *Response, error) {
+		return s.client.Repositories.ListByOrg(ctx, org, &o.RepositoryListByOrgOptions)
+	})
+	return func(yield func(*Repository, error) bool) {
+		for repo, err := range repos {
+			if err != nil || match(repo.GetName()) {
+				if !yield(repo, err) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// ListSSHSigningKeysAll returns an iterator over all SSH signing keys of a
+// user, or of the authenticated user if user is empty, fetching further pages
+// as needed. See ListSSHSigningKeys.
+//
+// GitHub API docs: https://docs.github.com/rest/users/ssh-signing-keys#list-ssh-signing-keys-for-a-user
+// GitHub API docs: https://docs.github.com/rest/users/ssh-signing-keys#list-ssh-signing-keys-for-the-authenticated-user
+//
+//meta:operation GET /user/ssh_signing_keys
+//meta:operation GET /users/{username}/ssh_signing_keys
+func (s *UsersService) ListSSHSigningKeysAll(ctx context.Context, user string, opts *ListOptions) iter.Seq2[*SSHSigningKey, error] {
+	o := new(ListOptions)
+	if opts != nil {
+		*o = *opts
+	}
+	return listIter(ctx, &o.Page, func() ([]*SSHSigningKey, *Response, error) {
+		return s.ListSSHSigningKeys(ctx, user, o)
+	})
+}
+
+// ListCollaboratorsAll returns an iterator over all collaborators of a
+// repository that match opts, fetching further pages as needed. Each user's
+// Permissions and RoleName describe their effective access to the repository.
+// See ListCollaborators.
+//
+// GitHub API docs: https://docs.github.com/rest/collaborators/collaborators#list-repository-collaborators
+//
+//meta:operation GET /repos/{owner}/{repo}/collaborators
+func (s *RepositoriesService) ListCollaboratorsAll(ctx context.Context, owner, repo string, opts *ListCollaboratorsOptions) iter.Seq2[*User, error] {
+	o := new(ListCollaboratorsOptions)
+	if opts != nil {
+		*o = *opts
+	}
+	return listIter(ctx, &o.Page, func() ([]*User, *Response, error) {
+		return s.ListCollaborators(ctx, owner, repo, o)
+	})
+}
+
+// ListTagsAll returns an iterator over all tags of a repository, fetching
+// further pages as needed. See ListTags.
+//
+// GitHub API docs: https://docs.github.com/rest/repos/repos#list-repository-tags
+//
+//meta:operation GET /repos/{owner}/{repo}/tags
+func (s *RepositoriesService) ListTagsAll(ctx context.Context, owner, repo string, opts *ListOptions) iter.Seq2[*RepositoryTag, error] {
+	o := new(ListOptions)
+	if opts != nil {
+		*o = *opts
+	}
+	return listIter(ctx, &o.Page, func() ([]*RepositoryTag, *Response, error) {
+		return s.ListTags(ctx, owner, repo, o)
+	})
+}
+
+// ListCheckRunsForRefAll returns an iterator over all check runs for a
+// commit SHA, branch name, or tag name that match opts, fetching further pages
+// as needed. See ListCheckRunsForRef.
+//
+// GitHub API docs: https://docs.github.com/rest/checks/runs#list-check-runs-for-a-git-reference
+//
+//meta:operation GET /repos/{owner}/{repo}/commits/{ref}/check-runs
+func (s *ChecksService) ListCheckRunsForRefAll(ctx context.Context, owner, repo, ref string, opts *ListCheckRunsOptions) iter.Seq2[*CheckRun, error] {
+	o := new(ListCheckRunsOptions)
+	if opts != nil {
+		*o = *opts
+	}
+	return listIter(ctx, &o.Page, func() ([]*CheckRun, *Response, error) {
+		runs, resp, err := s.ListCheckRunsForRef(ctx, owner, repo, ref, o)
+		if err != nil {
+			return nil, resp, err
+		}
+		return runs.CheckRuns, resp, nil
+	})
+}
+
+// ListAccessibleAll returns an iterator over every repository the authenticated
+// user can access, whether owned by them, shared with them as a collaborator,
+// or reachable through organization membership. Unless opts sets Affiliation
+// or Type, all three affiliations are requested. Repositories are yielded once
+// each, even if pagination or multiple affiliations would return them again.
+//
+// For users who belong to many organizations this can take many requests and
+// consume a significant part of the rate limit.
+//
+// GitHub API docs: https://docs.github.com/rest/repos/repos#list-repositories-for-the-authenticated-user
+//
+//meta:operation GET /user/repos
+func (s *RepositoriesService) ListAccessibleAll(ctx context.Context, opts *RepositoryListByAuthenticatedUserOptions) iter.Seq2[*Repository, error] {
+	o := new(RepositoryListByAuthenticatedUserOptions)
+	if opts != nil {
+		*o = *opts
+	}
+	if o.Affiliation == "" && o.Type == "" {
+		o.Affiliation = "owner,collaborator,organization_member"
+	}
+	repos := listIter(ctx, &o.Page, func() ([]*Repository, *Response, error) {
+		return s.ListByAuthenticatedUser(ctx, o)
+	})
+
+	return func(yield func(*Repository, error) bool) {
+		seen := make(map[string]bool)
+		for repo, err := range repos {
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			key := repo.GetNodeID()
+			if key == "" {
+				key = fmt.Sprintf("id:%v", repo.GetID())
+			}
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			if !yield(repo, nil) {
+				return
+			}
+		}
+	}
+}
+
+// ListStargazersAll returns an iterator over everyone who has starred the
+// specified repo, along with when they starred it, fetching further pages as
+// needed. See ListStargazers.
+//
+// GitHub API docs: https://docs.github.com/rest/activity/starring#list-stargazers
+//
+//meta:operation GET /repos/{owner}/{repo}/stargazers
+func (s *ActivityService) ListStargazersAll(ctx context.Context, owner, repo string, opts *ListOptions) iter.Seq2[*Stargazer, error] {
+	o := new(ListOptions)
+	if opts != nil {
+		*o = *opts
+	}
+	return listIter(ctx, &o.Page, func() ([]*Stargazer, *Response, error) {
+		return s.ListStargazers(ctx, owner, repo, o)
+	})
+}
+
+// ListHookDeliveriesAll returns an iterator over all deliveries of a webhook
+// configured in a repository, fetching further pages as needed.
+// See ListHookDeliveries.
+//
+// GitHub API docs: https://docs.github.com/rest/repos/webhooks#list-deliveries-for-a-repository-webhook
+//
+//meta:operation GET /repos/{owner}/{repo}/hooks/{hook_id}/deliveries
+func (s *RepositoriesService) ListHookDeliveriesAll(ctx context.Context, owner, repo string, id int64, opts *ListCursorOptions) iter.Seq2[*HookDelivery, error] {
+	o := new(ListCursorOptions)
+	if opts != nil {
+		*o = *opts
+	}
+	return cursorListIter(ctx, &o.Cursor, func() ([]*HookDelivery, *Response, error) {
+		return s.ListHookDeliveries(ctx, owner, repo, id, o)
+	})
+}
+
+// ListHookDeliveriesAll returns an iterator over all deliveries of a webhook
+// configured in an organization, fetching further pages as needed.
+// See ListHookDeliveries.
+//
+// GitHub API docs: https://docs.github.com/rest/orgs/webhooks#list-deliveries-for-an-organization-webhook
+//
+//meta:operation GET /orgs/{org}/hooks/{hook_id}/deliveries
+func (s *OrganizationsService) ListHookDeliveriesAll(ctx context.Context, org string, id int64, opts *ListCursorOptions) iter.Seq2[*HookDelivery, error] {
+	o := new(ListCursorOptions)
+	if opts != nil {
+		*o = *opts
+	}
+	return cursorListIter(ctx, &o.Cursor, func() ([]*HookDelivery, *Response, error) {
+		return s.ListHookDeliveries(ctx, org, id, o)
+	})
+}
+
+// ListHookDeliveriesAll returns an iterator over all deliveries of the
+// webhook configured for a GitHub App, fetching further pages as needed.
+// See ListHookDeliveries.
+//
+// GitHub API docs: https://docs.github.com/rest/apps/webhooks#list-deliveries-for-an-app-webhook
+//
+//meta:operation GET /app/hook/deliveries
+func (s *AppsService) ListHookDeliveriesAll(ctx context.Context, opts *ListCursorOptions) iter.Seq2[*HookDelivery, error] {
+	o := new(ListCursorOptions)
+	if opts != nil {
+		*o = *opts
+	}
+	return cursorListIter(ctx, &o.Cursor, func() ([]*HookDelivery, *Response, error) {
+		return s.ListHookDeliveries(ctx, o)
+	})
+}
+
+// searchResultCap is the maximum number of results the search API returns for
+// a single query, regardless of its total_count.
+const searchResultCap = 1000
+
+// ExhaustiveOptions specifies the optional parameters to the
+// SearchService.IssuesExhaustive method.
+type ExhaustiveOptions struct {
+	// Since and Until bound the creation times of the issues searched.
+	// Default: from the start of 2008 until now.
+	Since time.Time
+	Until time.Time
+
+	// PerPage is the number of results to fetch per request. Default: 100.
+	PerPage int
+}
+
+// IssuesExhaustive returns an iterator over all issues and pull requests
+// matching query, even when there are more than the 1000 results a single
+// search can return.
+//
+// The search is split into windows of creation time, each added to query as
+// a created: qualifier, so query must not contain one itself; if it does, the
+// iterator yields an error. A window whose total count exceeds 1000 is split
+// in half repeatedly until each part fits, then the windows are walked from
+// oldest to newest, each sorted by creation time. Every result is yielded
+// once, even if it shows up in more than one request. If more than 1000
+// results were created within the same second, they cannot all be reached,
+// and the iterator yields an error instead.
+//
+// The results are not a consistent snapshot: issues created, edited or
+// deleted during the walk may be missed or included depending on timing.
+// Large result sets take many requests and are subject to the lower search
+// rate limit.
+//
+// GitHub API docs: https://docs.github.com/rest/search/search#search-issues-and-pull-requests
+//
+//meta:operation GET /search/issues
+func (s *SearchService) IssuesExhaustive(ctx context.Context, query string, opts ExhaustiveOptions) iter.Seq2[*Issue, error] {
+	return func(yield func(*Issue, error) bool) {
+		for _, f := range strings.Fields(query) {
+			if strings.HasPrefix(strings.ToLower(strings.TrimPrefix(f, "-")), "created:") {
+				yield(nil, fmt.Errorf("query %q already contains a created: qualifier", query))
+				return
+			}
+		}
+
+		since, until := opts.Since, opts.Until
+		if since.IsZero() {
+			since = time.Date(2008, time.January, 1, 0, 0, 0, 0, time.UTC)
+		}
+		if until.IsZero() {
+			until = time.Now()
+		}
+		perPage := opts.PerPage
+		if perPage == 0 {
+			perPage = 100
+		}
+
+		// Windows are inclusive at both ends, with second precision, to
+		// match the created: qualifier. They are popped from the end so
+		// that the oldest is walked first.
+		windows := [][2]time.Time{{since.UTC().Truncate(time.Second), until.UTC().Truncate(time.Second)}}
+		seen := make(map[int64]bool)
+		for len(windows) > 0 {
+			w := windows[len(windows)-1]
+			windows = windows[:len(windows)-1]
+
+			q := fmt.Sprintf("%v created:%v..%v", query, w[0].Format(time.RFC3339), w[1].Format(time.RFC3339))
+			so := &SearchOptions{Sort: "created", Order: "asc", ListOptions: ListOptions{PerPage: perPage}}
+			result, resp, err := s.Issues(ctx, q, so)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+
+			if result.GetTotal() > searchResultCap {
+				if w[1].Sub(w[0]) < time.Second {
+					yield(nil, fmt.Errorf("%v results created within %v exceed the search limit of %v", result.GetTotal(), w[0].Format(time.RFC3339), searchResultCap))
+					return
+				}
+				mid := w[0].Add(w[1].Sub(w[0]) / 2).Truncate(time.Second)
+				windows = append(windows, [2]time.Time{mid.Add(time.Second), w[1]}, [2]time.Time{w[0], mid})
+				continue
+			}
+
+			first := true
+			page := listIter(ctx, &so.Page, func() ([]*Issue, *Response, error) {
+				if first {
+					first = false
+					return result.Issues, resp, nil
+				}
+				result, resp, err := s.Issues(ctx, q, so)
+				if err != nil {
+					return nil, resp, err
+				}
+				return result.Issues, resp, nil
+			})
+			for issue, err := range page {
+				if err != nil {
+					yield(nil, err)
+					return
+				}
+				if seen[issue.GetID()] {
+					continue
+				}
+				seen[issue.GetID()] = true
+				if !yield(issue, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// ListNotificationsAll returns an iterator over all notifications for the
+// authenticated user that match opts, fetching further pages as needed. See
+// ListNotifications.
+//
+// GitHub API docs: https://docs.github.com/rest/activity/notifications#list-notifications-for-the-authenticated-user
+//
+//meta:operation GET /notifications
+func (s *ActivityService) ListNotificationsAll(ctx context.Context, opts *NotificationListOptions) iter.Seq2[*Notification, error] {
+	o := new(NotificationListOptions)
+	if opts != nil {
+		*o = *opts
+	}
+	return listIter(ctx, &o.Page, func() ([]*Notification, *Response, error) {
+		return s.ListNotifications(ctx, o)
+	})
+}
@@ -0,0 +1,1284 @@
+// Copyright 2026 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.23
+
+package github
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// testPaginatedHandler returns a handler that serves pages, a slice of JSON
+// arrays, as consecutive pages selected by the "page" query parameter.
+func testPaginatedHandler(t *testing.T, pages ...string) http.HandlerFunc {
+	t.Helper()
+	return func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		page := 1
+		if p := r.FormValue("page"); p != "" {
+			if _, err := fmt.Sscan(p, &page); err != nil {
+				t.Errorf("invalid page %q: %v", p, err)
+			}
+		}
+		if page < 1 || page > len(pages) {
+			t.Errorf("unexpected page %v requested", page)
+			return
+		}
+		if page < len(pages) {
+			w.Header().Set("Link", fmt.Sprintf(`<https://api.github.com/?page=%v>; rel="next"`, page+1))
+		}
+		fmt.Fprint(w, pages[page-1])
+	}
+}
+
+// testCursorPaginatedHandler is like testPaginatedHandler for endpoints using
+// cursor pagination, where page n > 1 is requested with cursor "c<n>".
+func testCursorPaginatedHandler(t *testing.T, pages ...string) http.HandlerFunc {
+	t.Helper()
+	return func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		page := 1
+		if c := r.FormValue("cursor"); c != "" {
+			if _, err := fmt.Sscanf(c, "c%d", &page); err != nil {
+				t.Errorf("invalid cursor %q: %v", c, err)
+			}
+		}
+		if page < 1 || page > len(pages) {
+			t.Errorf("unexpected page %v requested", page)
+			return
+		}
+		if page < len(pages) {
+			w.Header().Set("Link", fmt.Sprintf(`<https://api.github.com/?cursor=c%v>; rel="next"`, page+1))
+		}
+		fmt.Fprint(w, pages[page-1])
+	}
+}
+
+func TestListIter(t *testing.T) {
+	t.Parallel()
+	var page int
+	var requested []int
+	seq := listIter(context.Background(), &page, func() ([]int, *Response, error) {
+		requested = append(requested, page)
+		switch page {
+		case 0:
+			return []int{1, 2}, &Response{NextPage: 2}, nil
+		case 2:
+			return []int{3}, &Response{}, nil
+		}
+		return nil, nil, fmt.Errorf("unexpected page %v", page)
+	})
+
+	var got []int
+	for v, err := range seq {
+		if err != nil {
+			t.Fatalf("listIter yielded error: %v", err)
+		}
+		got = append(got, v)
+	}
+	if want := []int{1, 2, 3}; !cmp.Equal(got, want) {
+		t.Errorf("listIter yielded %v, want %v", got, want)
+	}
+	if want := []int{0, 2}; !cmp.Equal(requested, want) {
+		t.Errorf("listIter requested pages %v, want %v", requested, want)
+	}
+}
+
+func TestListIter_stopEarly(t *testing.T) {
+	t.Parallel()
+	var page, calls int
+	seq := listIter(context.Background(), &page, func() ([]int, *Response, error) {
+		calls++
+		return []int{1, 2}, &Response{NextPage: page + 1}, nil
+	})
+
+	for v := range seq {
+		if v == 2 {
+			break
+		}
+	}
+	if calls != 1 {
+		t.Errorf("listIter fetched %v pages, want 1", calls)
+	}
+}
+
+func TestListIter_error(t *testing.T) {
+	t.Parallel()
+	var page int
+	wantErr := errors.New("boom")
+	seq := listIter(context.Background(), &page, func() ([]int, *Response, error) {
+		if page == 0 {
+			return []int{1}, &Response{NextPage: 2}, nil
+		}
+		return nil, nil, wantErr
+	})
+
+	var got []int
+	var gotErr error
+	for v, err := range seq {
+		if err != nil {
+			gotErr = err
+			continue
+		}
+		got = append(got, v)
+	}
+	if want := []int{1}; !cmp.Equal(got, want) {
+		t.Errorf("listIter yielded %v, want %v", got, want)
+	}
+	if !errors.Is(gotErr, wantErr) {
+		t.Errorf("listIter yielded error %v, want %v", gotErr, wantErr)
+	}
+}
+
+func TestListIter_canceledMidPage(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var page, calls int
+	seq := listIter(ctx, &page, func() ([]int, *Response, error) {
+		calls++
+		return []int{1, 2, 3}, &Response{NextPage: page + 1}, nil
+	})
+
+	var got []int
+	var gotErr error
+	for v, err := range seq {
+		if err != nil {
+			gotErr = err
+			continue
+		}
+		got = append(got, v)
+		cancel()
+	}
+	if want := []int{1}; !cmp.Equal(got, want) {
+		t.Errorf("listIter yielded %v, want %v", got, want)
+	}
+	if !errors.Is(gotErr, context.Canceled) {
+		t.Errorf("listIter yielded error %v, want %v", gotErr, context.Canceled)
+	}
+	if calls != 1 {
+		t.Errorf("listIter fetched %v pages, want 1", calls)
+	}
+}
+
+func TestWriteNDJSON(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/orgs/o/teams/s/discussions", testPaginatedHandler(t,
+		`[{"number":1,"title":"<a>"}]`,
+		`[{"number":2}]`,
+	))
+
+	var buf bytes.Buffer
+	w := bufio.NewWriter(&buf)
+	ctx := context.Background()
+	if err := WriteNDJSON(w, client.Teams.ListDiscussionsBySlugAll(ctx, "o", "s", nil)); err != nil {
+		t.Fatalf("WriteNDJSON returned error: %v", err)
+	}
+	want := `{"number":1,"title":"<a>"}` + "\n" + `{"number":2}` + "\n"
+	if got := buf.String(); got != want {
+		t.Errorf("WriteNDJSON wrote %q, want %q", got, want)
+	}
+}
+
+func TestWriteNDJSON_error(t *testing.T) {
+	t.Parallel()
+	errBoom := errors.New("boom")
+	seq := func(yield func(int, error) bool) {
+		if !yield(1, nil) {
+			return
+		}
+		if !yield(0, errBoom) {
+			return
+		}
+		yield(3, nil)
+	}
+
+	var buf bytes.Buffer
+	if err := WriteNDJSON(&buf, seq); !errors.Is(err, errBoom) {
+		t.Errorf("WriteNDJSON returned %v, want %v", err, errBoom)
+	}
+	if got, want := buf.String(), "1\n"; got != want {
+		t.Errorf("WriteNDJSON wrote %q, want %q", got, want)
+	}
+}
+
+func TestTeamsService_ListDiscussionsBySlugAll(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/orgs/o/teams/s/discussions", testPaginatedHandler(t, `[{"number":1},{"number":2}]`, `[{"number":3}]`))
+
+	ctx := context.Background()
+	opts := &DiscussionListOptions{Direction: "asc"}
+	var got []int
+	for d, err := range client.Teams.ListDiscussionsBySlugAll(ctx, "o", "s", opts) {
+		if err != nil {
+			t.Fatalf("Teams.ListDiscussionsBySlugAll returned error: %v", err)
+		}
+		got = append(got, d.GetNumber())
+	}
+	if want := []int{1, 2, 3}; !cmp.Equal(got, want) {
+		t.Errorf("Teams.ListDiscussionsBySlugAll returned %v, want %v", got, want)
+	}
+	if opts.Page != 0 {
+		t.Errorf("Teams.ListDiscussionsBySlugAll modified opts.Page to %v", opts.Page)
+	}
+}
+
+func TestTeamsService_ListCommentsBySlugAll(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/orgs/o/teams/s/discussions/1/comments", testPaginatedHandler(t, `[{"number":1}]`, `[{"number":2}]`))
+
+	ctx := context.Background()
+	var got []int
+	for c, err := range client.Teams.ListCommentsBySlugAll(ctx, "o", "s", 1, nil) {
+		if err != nil {
+			t.Fatalf("Teams.ListCommentsBySlugAll returned error: %v", err)
+		}
+		got = append(got, c.GetNumber())
+	}
+	if want := []int{1, 2}; !cmp.Equal(got, want) {
+		t.Errorf("Teams.ListCommentsBySlugAll returned %v, want %v", got, want)
+	}
+}
+
+func TestTeamsService_ListCommentsBySlugIter_invalidOrg(t *testing.T) {
+	t.Parallel()
+	client, _, _ := setup(t)
+
+	ctx := context.Background()
+	for _, err := range client.Teams.ListCommentsBySlugAll(ctx, "%", "s", 1, nil) {
+		testURLParseError(t, err)
+	}
+}
+
+func TestCopilotService_ListCopilotSeatsAll(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/orgs/o/copilot/billing/seats", testPaginatedHandler(t,
+		`{"total_seats":2,"seats":[{"assignee":{"type":"User","login":"u"}}]}`,
+		`{"total_seats":2,"seats":[{"assignee":{"type":"Team","name":"t"}}]}`,
+	))
+
+	ctx := context.Background()
+	var users, teams []string
+	for seat, err := range client.Copilot.ListCopilotSeatsAll(ctx, "o", nil) {
+		if err != nil {
+			t.Fatalf("Copilot.ListCopilotSeatsAll returned error: %v", err)
+		}
+		if u, ok := seat.GetUser(); ok {
+			users = append(users, u.GetLogin())
+		}
+		if team, ok := seat.GetTeam(); ok {
+			teams = append(teams, team.GetName())
+		}
+	}
+	if want := []string{"u"}; !cmp.Equal(users, want) {
+		t.Errorf("Copilot.ListCopilotSeatsAll returned users %v, want %v", users, want)
+	}
+	if want := []string{"t"}; !cmp.Equal(teams, want) {
+		t.Errorf("Copilot.ListCopilotSeatsAll returned teams %v, want %v", teams, want)
+	}
+}
+
+func TestCopilotService_ListCopilotEnterpriseSeatsAll(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/enterprises/e/copilot/billing/seats", testPaginatedHandler(t,
+		`{"total_seats":1,"seats":[{"assignee":{"type":"User","login":"u"}}]}`,
+	))
+
+	ctx := context.Background()
+	var n int
+	for _, err := range client.Copilot.ListCopilotEnterpriseSeatsAll(ctx, "e", &ListOptions{PerPage: 50}) {
+		if err != nil {
+			t.Fatalf("Copilot.ListCopilotEnterpriseSeatsAll returned error: %v", err)
+		}
+		n++
+	}
+	if n != 1 {
+		t.Errorf("Copilot.ListCopilotEnterpriseSeatsAll returned %v seats, want 1", n)
+	}
+}
+
+func TestActionsService_ListCachesAll(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/actions/caches", func(w http.ResponseWriter, r *http.Request) {
+		if got, want := r.FormValue("ref"), "main"; got != want {
+			t.Errorf("ref = %q, want %q", got, want)
+		}
+		testPaginatedHandler(t,
+			`{"total_count":3,"actions_caches":[{"id":1},{"id":2}]}`,
+			`{"total_count":3,"actions_caches":[{"id":3}]}`,
+		)(w, r)
+	})
+
+	ctx := context.Background()
+	opts := &ActionsCacheListOptions{Ref: Ptr("main"), Sort: Ptr("size_in_bytes")}
+	var got []int64
+	for c, err := range client.Actions.ListCachesAll(ctx, "o", "r", opts) {
+		if err != nil {
+			t.Fatalf("Actions.ListCachesAll returned error: %v", err)
+		}
+		got = append(got, c.GetID())
+	}
+	if want := []int64{1, 2, 3}; !cmp.Equal(got, want) {
+		t.Errorf("Actions.ListCachesAll returned %v, want %v", got, want)
+	}
+}
+
+func TestActionsService_ListCacheUsageByRepoForOrgAll(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/orgs/o/actions/cache/usage-by-repository", testPaginatedHandler(t,
+		`{"total_count":2,"repository_cache_usages":[{"full_name":"o/a"}]}`,
+		`{"total_count":2,"repository_cache_usages":[{"full_name":"o/b"}]}`,
+	))
+
+	ctx := context.Background()
+	var got []string
+	for u, err := range client.Actions.ListCacheUsageByRepoForOrgAll(ctx, "o", nil) {
+		if err != nil {
+			t.Fatalf("Actions.ListCacheUsageByRepoForOrgAll returned error: %v", err)
+		}
+		got = append(got, u.FullName)
+	}
+	if want := []string{"o/a", "o/b"}; !cmp.Equal(got, want) {
+		t.Errorf("Actions.ListCacheUsageByRepoForOrgAll returned %v, want %v", got, want)
+	}
+}
+
+func TestActionsService_VariablesAll(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	ctx := context.Background()
+	tests := []struct {
+		name string
+		path string
+		seq  iter.Seq2[*ActionsVariable, error]
+	}{
+		{"ListRepoVariablesAll", "/repos/o/r/actions/variables", client.Actions.ListRepoVariablesAll(ctx, "o", "r", nil)},
+		{"ListRepoOrgVariablesAll", "/repos/o/r/actions/organization-variables", client.Actions.ListRepoOrgVariablesAll(ctx, "o", "r", nil)},
+		{"ListOrgVariablesAll", "/orgs/o/actions/variables", client.Actions.ListOrgVariablesAll(ctx, "o", nil)},
+		{"ListEnvVariablesAll", "/repos/o/r/environments/e/variables", client.Actions.ListEnvVariablesAll(ctx, "o", "r", "e", nil)},
+	}
+
+	for _, tt := range tests {
+		mux.HandleFunc(tt.path, testPaginatedHandler(t,
+			`{"total_count":3,"variables":[{"name":"A","value":"a"},{"name":"B","value":"b"}]}`,
+			`{"total_count":3,"variables":[{"name":"C","value":"c"}]}`,
+		))
+
+		var got []string
+		for v, err := range tt.seq {
+			if err != nil {
+				t.Fatalf("Actions.%v returned error: %v", tt.name, err)
+			}
+			got = append(got, v.Name)
+		}
+		if want := []string{"A", "B", "C"}; !cmp.Equal(got, want) {
+			t.Errorf("Actions.%v returned %v, want %v", tt.name, got, want)
+		}
+	}
+}
+
+func TestUsersService_ListEmailsAll(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/user/emails", testPaginatedHandler(t,
+		`[{"email":"a@example.com"},{"email":"b@example.com"}]`,
+		`[{"email":"c@example.com"}]`,
+	))
+
+	ctx := context.Background()
+	var got []string
+	for e, err := range client.Users.ListEmailsAll(ctx, nil) {
+		if err != nil {
+			t.Fatalf("Users.ListEmailsAll returned error: %v", err)
+		}
+		got = append(got, e.GetEmail())
+	}
+	if want := []string{"a@example.com", "b@example.com", "c@example.com"}; !cmp.Equal(got, want) {
+		t.Errorf("Users.ListEmailsAll returned %v, want %v", got, want)
+	}
+}
+
+func TestOrganizationsService_ListTeamsAssignedToOrgRoleAll(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/orgs/o/organization-roles/1729/teams", testPaginatedHandler(t,
+		`[{"id":1},{"id":2}]`,
+		`[{"id":3}]`,
+	))
+
+	ctx := context.Background()
+	var got []int64
+	for team, err := range client.Organizations.ListTeamsAssignedToOrgRoleAll(ctx, "o", 1729, nil) {
+		if err != nil {
+			t.Fatalf("Organizations.ListTeamsAssignedToOrgRoleAll returned error: %v", err)
+		}
+		got = append(got, team.GetID())
+	}
+	if want := []int64{1, 2, 3}; !cmp.Equal(got, want) {
+		t.Errorf("Organizations.ListTeamsAssignedToOrgRoleAll returned %v, want %v", got, want)
+	}
+}
+
+func TestOrganizationsService_ListUsersAssignedToOrgRoleAll(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/orgs/o/organization-roles/1729/users", testPaginatedHandler(t,
+		`[{"login":"a"}]`,
+		`[{"login":"b"},{"login":"c"}]`,
+	))
+
+	ctx := context.Background()
+	var got []string
+	for user, err := range client.Organizations.ListUsersAssignedToOrgRoleAll(ctx, "o", 1729, &ListOptions{PerPage: 2}) {
+		if err != nil {
+			t.Fatalf("Organizations.ListUsersAssignedToOrgRoleAll returned error: %v", err)
+		}
+		got = append(got, user.GetLogin())
+	}
+	if want := []string{"a", "b", "c"}; !cmp.Equal(got, want) {
+		t.Errorf("Organizations.ListUsersAssignedToOrgRoleAll returned %v, want %v", got, want)
+	}
+}
+
+func TestOrganizationsService_ListOutsideCollaboratorsAll(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/orgs/o/outside_collaborators", func(w http.ResponseWriter, r *http.Request) {
+		if got, want := r.FormValue("filter"), "2fa_disabled"; got != want {
+			t.Errorf("filter = %q, want %q", got, want)
+		}
+		testPaginatedHandler(t,
+			`[{"login":"a"}]`,
+			`[{"login":"b"}]`,
+		)(w, r)
+	})
+
+	ctx := context.Background()
+	opts := &ListOutsideCollaboratorsOptions{Filter: "2fa_disabled", ListOptions: ListOptions{PerPage: 1}}
+	var got []string
+	for user, err := range client.Organizations.ListOutsideCollaboratorsAll(ctx, "o", opts) {
+		if err != nil {
+			t.Fatalf("Organizations.ListOutsideCollaboratorsAll returned error: %v", err)
+		}
+		got = append(got, user.GetLogin())
+	}
+	if want := []string{"a", "b"}; !cmp.Equal(got, want) {
+		t.Errorf("Organizations.ListOutsideCollaboratorsAll returned %v, want %v", got, want)
+	}
+	if opts.Page != 0 {
+		t.Errorf("Organizations.ListOutsideCollaboratorsAll modified opts.Page to %v", opts.Page)
+	}
+}
+
+func TestRepositoriesService_ListInvitationsAll(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/invitations", testPaginatedHandler(t,
+		`[{"id":1,"permissions":"write"}]`,
+		`[{"id":2,"permissions":"maintain"}]`,
+	))
+
+	ctx := context.Background()
+	var got []string
+	for inv, err := range client.Repositories.ListInvitationsAll(ctx, "o", "r", nil) {
+		if err != nil {
+			t.Fatalf("Repositories.ListInvitationsAll returned error: %v", err)
+		}
+		got = append(got, fmt.Sprintf("%v:%v", inv.GetID(), inv.GetPermissions()))
+	}
+	if want := []string{"1:write", "2:maintain"}; !cmp.Equal(got, want) {
+		t.Errorf("Repositories.ListInvitationsAll returned %v, want %v", got, want)
+	}
+}
+
+func TestUsersService_ListInvitationsAll(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/user/repository_invitations", testPaginatedHandler(t,
+		`[{"id":1,"repository":{"full_name":"o/a"}}]`,
+		`[{"id":2,"repository":{"full_name":"o/b"}}]`,
+	))
+
+	ctx := context.Background()
+	var got []string
+	for inv, err := range client.Users.ListInvitationsAll(ctx, nil) {
+		if err != nil {
+			t.Fatalf("Users.ListInvitationsAll returned error: %v", err)
+		}
+		got = append(got, inv.GetRepo().GetFullName())
+	}
+	if want := []string{"o/a", "o/b"}; !cmp.Equal(got, want) {
+		t.Errorf("Users.ListInvitationsAll returned %v, want %v", got, want)
+	}
+}
+
+func TestOrganizationsService_ListInstallationsAll(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/orgs/o/installations", testPaginatedHandler(t,
+		`{"total_count":2,"installations":[{"id":1}]}`,
+		`{"total_count":2,"installations":[{"id":2}]}`,
+	))
+
+	ctx := context.Background()
+	var got []int64
+	for inst, err := range client.Organizations.ListInstallationsAll(ctx, "o", nil) {
+		if err != nil {
+			t.Fatalf("Organizations.ListInstallationsAll returned error: %v", err)
+		}
+		got = append(got, inst.GetID())
+	}
+	if want := []int64{1, 2}; !cmp.Equal(got, want) {
+		t.Errorf("Organizations.ListInstallationsAll returned %v, want %v", got, want)
+	}
+}
+
+func TestAppsService_ListInstallationRequestsAll(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/app/installation-requests", testPaginatedHandler(t,
+		`[{"id":1,"account":{"login":"o"},"requester":{"login":"u"}}]`,
+		`[{"id":2}]`,
+	))
+
+	ctx := context.Background()
+	var got []int64
+	for req, err := range client.Apps.ListInstallationRequestsAll(ctx, nil) {
+		if err != nil {
+			t.Fatalf("Apps.ListInstallationRequestsAll returned error: %v", err)
+		}
+		got = append(got, req.GetID())
+	}
+	if want := []int64{1, 2}; !cmp.Equal(got, want) {
+		t.Errorf("Apps.ListInstallationRequestsAll returned %v, want %v", got, want)
+	}
+}
+
+func TestOrganizationsService_ListPendingOrgInvitationsAll(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/orgs/o/invitations", testPaginatedHandler(t,
+		`[{"id":1,"email":"a@example.com"}]`,
+		`[{"id":2,"login":"b"}]`,
+	))
+
+	ctx := context.Background()
+	var got []int64
+	for inv, err := range client.Organizations.ListPendingOrgInvitationsAll(ctx, "o", nil) {
+		if err != nil {
+			t.Fatalf("Organizations.ListPendingOrgInvitationsAll returned error: %v", err)
+		}
+		got = append(got, inv.GetID())
+	}
+	if want := []int64{1, 2}; !cmp.Equal(got, want) {
+		t.Errorf("Organizations.ListPendingOrgInvitationsAll returned %v, want %v", got, want)
+	}
+}
+
+func TestOrganizationsService_ListFailedOrgInvitationsAll(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/orgs/o/failed_invitations", testPaginatedHandler(t,
+		`[{"id":1,"failed_reason":"expired"}]`,
+		`[{"id":2,"failed_reason":"bounced"}]`,
+	))
+
+	ctx := context.Background()
+	var got []string
+	for inv, err := range client.Organizations.ListFailedOrgInvitationsAll(ctx, "o", &ListOptions{PerPage: 1}) {
+		if err != nil {
+			t.Fatalf("Organizations.ListFailedOrgInvitationsAll returned error: %v", err)
+		}
+		got = append(got, inv.GetFailedReason())
+	}
+	if want := []string{"expired", "bounced"}; !cmp.Equal(got, want) {
+		t.Errorf("Organizations.ListFailedOrgInvitationsAll returned %v, want %v", got, want)
+	}
+}
+
+func TestRepositoriesService_ListCollaboratorsAll(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/collaborators", func(w http.ResponseWriter, r *http.Request) {
+		if got, want := r.FormValue("affiliation"), "outside"; got != want {
+			t.Errorf("affiliation = %q, want %q", got, want)
+		}
+		if got, want := r.FormValue("permission"), "push"; got != want {
+			t.Errorf("permission = %q, want %q", got, want)
+		}
+		testPaginatedHandler(t,
+			`[{"login":"a","permissions":{"pull":true,"push":true},"role_name":"write"}]`,
+			`[{"login":"b","permissions":{"pull":true,"push":true,"maintain":true},"role_name":"maintain"}]`,
+		)(w, r)
+	})
+
+	ctx := context.Background()
+	opts := &ListCollaboratorsOptions{Affiliation: "outside", Permission: "push"}
+	var got []*User
+	for user, err := range client.Repositories.ListCollaboratorsAll(ctx, "o", "r", opts) {
+		if err != nil {
+			t.Fatalf("Repositories.ListCollaboratorsAll returned error: %v", err)
+		}
+		got = append(got, user)
+	}
+	want := []*User{
+		{Login: Ptr("a"), Permissions: map[string]bool{"pull": true, "push": true}, RoleName: Ptr("write")},
+		{Login: Ptr("b"), Permissions: map[string]bool{"pull": true, "push": true, "maintain": true}, RoleName: Ptr("maintain")},
+	}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Repositories.ListCollaboratorsAll returned %+v, want %+v", got, want)
+	}
+	if opts.Page != 0 {
+		t.Errorf("Repositories.ListCollaboratorsAll modified opts.Page to %v", opts.Page)
+	}
+}
+
+func TestRepositoriesService_ListTagsAll(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/tags", testPaginatedHandler(t,
+		`[{"name":"v1.1.0"}]`,
+		`[{"name":"v1.0.0"}]`,
+	))
+
+	ctx := context.Background()
+	var got []string
+	for tag, err := range client.Repositories.ListTagsAll(ctx, "o", "r", nil) {
+		if err != nil {
+			t.Fatalf("Repositories.ListTagsAll returned error: %v", err)
+		}
+		got = append(got, tag.GetName())
+	}
+	if want := []string{"v1.1.0", "v1.0.0"}; !cmp.Equal(got, want) {
+		t.Errorf("Repositories.ListTagsAll returned %v, want %v", got, want)
+	}
+}
+
+func TestChecksService_ListCheckRunsForRefAll(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/commits/main/check-runs", func(w http.ResponseWriter, r *http.Request) {
+		if got, want := r.FormValue("status"), "completed"; got != want {
+			t.Errorf("status = %q, want %q", got, want)
+		}
+		testPaginatedHandler(t,
+			`{"total_count":3,"check_runs":[{"id":1},{"id":2}]}`,
+			`{"total_count":3,"check_runs":[{"id":3}]}`,
+		)(w, r)
+	})
+
+	ctx := context.Background()
+	opts := &ListCheckRunsOptions{Status: Ptr("completed")}
+	var got []int64
+	for run, err := range client.Checks.ListCheckRunsForRefAll(ctx, "o", "r", "main", opts) {
+		if err != nil {
+			t.Fatalf("Checks.ListCheckRunsForRefAll returned error: %v", err)
+		}
+		got = append(got, run.GetID())
+	}
+	if want := []int64{1, 2, 3}; !cmp.Equal(got, want) {
+		t.Errorf("Checks.ListCheckRunsForRefAll returned %v, want %v", got, want)
+	}
+}
+
+func TestGistsService_ListByUserAll(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/users/u/gists", func(w http.ResponseWriter, r *http.Request) {
+		if got, want := r.FormValue("since"), referenceTime.Format(time.RFC3339); got != want {
+			t.Errorf("since = %q, want %q", got, want)
+		}
+		testPaginatedHandler(t,
+			`[{"id":"a"}]`,
+			`[{"id":"b"}]`,
+		)(w, r)
+	})
+
+	ctx := context.Background()
+	var got []string
+	for gist, err := range client.Gists.ListByUserAll(ctx, "u", &GistListOptions{Since: referenceTime}) {
+		if err != nil {
+			t.Fatalf("Gists.ListByUserAll returned error: %v", err)
+		}
+		got = append(got, gist.GetID())
+	}
+	if want := []string{"a", "b"}; !cmp.Equal(got, want) {
+		t.Errorf("Gists.ListByUserAll returned %v, want %v", got, want)
+	}
+}
+
+func TestGistsService_ListStarredAll(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/gists/starred", testPaginatedHandler(t,
+		`[{"id":"a"},{"id":"b"}]`,
+		`[{"id":"c"}]`,
+	))
+
+	ctx := context.Background()
+	var got []string
+	for gist, err := range client.Gists.ListStarredAll(ctx, nil) {
+		if err != nil {
+			t.Fatalf("Gists.ListStarredAll returned error: %v", err)
+		}
+		got = append(got, gist.GetID())
+	}
+	if want := []string{"a", "b", "c"}; !cmp.Equal(got, want) {
+		t.Errorf("Gists.ListStarredAll returned %v, want %v", got, want)
+	}
+}
+
+func TestUsersService_ListFollowersAll(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/users/u/followers", testPaginatedHandler(t,
+		`[{"login":"a"}]`,
+		`[{"login":"b"}]`,
+	))
+	mux.HandleFunc("/user/following", testPaginatedHandler(t,
+		`[{"login":"c"},{"login":"d"}]`,
+		`[{"login":"e"}]`,
+	))
+
+	ctx := context.Background()
+	tests := []struct {
+		name string
+		seq  iter.Seq2[*User, error]
+		want []string
+	}{
+		{"ListFollowersAll", client.Users.ListFollowersAll(ctx, "u", nil), []string{"a", "b"}},
+		{"ListFollowingAll", client.Users.ListFollowingAll(ctx, "", nil), []string{"c", "d", "e"}},
+	}
+	for _, tt := range tests {
+		var got []string
+		for user, err := range tt.seq {
+			if err != nil {
+				t.Fatalf("Users.%v returned error: %v", tt.name, err)
+			}
+			got = append(got, user.GetLogin())
+		}
+		if !cmp.Equal(got, tt.want) {
+			t.Errorf("Users.%v returned %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestOrganizationsService_ListReposMatching(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/orgs/o/repos", func(w http.ResponseWriter, r *http.Request) {
+		if got, want := r.FormValue("type"), "sources"; got != want {
+			t.Errorf("type = %q, want %q", got, want)
+		}
+		testPaginatedHandler(t,
+			`[{"name":"svc-a"},{"name":"web"}]`,
+			`[{"name":"svc-b"},{"name":"my-svc-c"}]`,
+		)(w, r)
+	})
+
+	ctx := context.Background()
+	tests := []struct {
+		pattern string
+		regexp  bool
+		want    []string
+	}{
+		{"svc-*", false, []string{"svc-a", "svc-b"}},
+		{"svc-", true, []string{"svc-a", "svc-b", "my-svc-c"}},
+		{"^svc-[ab]$", true, []string{"svc-a", "svc-b"}},
+	}
+	for _, tt := range tests {
+		opts := &ListReposMatchingOptions{Regexp: tt.regexp, RepositoryListByOrgOptions: RepositoryListByOrgOptions{Type: "sources"}}
+		var got []string
+		for repo, err := range client.Organizations.ListReposMatching(ctx, "o", tt.pattern, opts) {
+			if err != nil {
+				t.Fatalf("Organizations.ListReposMatching returned error: %v", err)
+			}
+			got = append(got, repo.GetName())
+		}
+		if !cmp.Equal(got, tt.want) {
+			t.Errorf("Organizations.ListReposMatching(%q) returned %v, want %v", tt.pattern, got, tt.want)
+		}
+	}
+}
+
+func TestOrganizationsService_ListReposMatching_invalidPattern(t *testing.T) {
+	t.Parallel()
+	client, _, _ := setup(t)
+
+	ctx := context.Background()
+	for _, opts := range []*ListReposMatchingOptions{nil, {Regexp: true}} {
+		var errs int
+		for _, err := range client.Organizations.ListReposMatching(ctx, "o", "[", opts) {
+			if err == nil {
+				t.Fatal("Organizations.ListReposMatching returned no error, want one")
+			}
+			errs++
+		}
+		if errs != 1 {
+			t.Errorf("Organizations.ListReposMatching returned %v errors, want 1", errs)
+		}
+	}
+}
+
+func TestUsersService_ListSSHSigningKeysAll(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/user/ssh_signing_keys", testPaginatedHandler(t, `[{"id":1}]`, `[{"id":2}]`))
+	mux.HandleFunc("/users/u/ssh_signing_keys", testPaginatedHandler(t, `[{"id":3}]`, `[{"id":4}]`))
+
+	ctx := context.Background()
+	tests := []struct {
+		user string
+		want []int64
+	}{
+		{"", []int64{1, 2}},
+		{"u", []int64{3, 4}},
+	}
+	for _, tt := range tests {
+		var got []int64
+		for key, err := range client.Users.ListSSHSigningKeysAll(ctx, tt.user, nil) {
+			if err != nil {
+				t.Fatalf("Users.ListSSHSigningKeysAll returned error: %v", err)
+			}
+			got = append(got, key.GetID())
+		}
+		if !cmp.Equal(got, tt.want) {
+			t.Errorf("Users.ListSSHSigningKeysAll(%q) returned %v, want %v", tt.user, got, tt.want)
+		}
+	}
+}
+
+func TestRepositoriesService_ListAccessibleAll(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/user/repos", func(w http.ResponseWriter, r *http.Request) {
+		if got, want := r.FormValue("affiliation"), "owner,collaborator,organization_member"; got != want {
+			t.Errorf("affiliation = %q, want %q", got, want)
+		}
+		testPaginatedHandler(t,
+			`[{"id":1,"node_id":"a"},{"id":2,"node_id":"b"}]`,
+			`[{"id":2,"node_id":"b"},{"id":3,"node_id":"c"},{"id":4},{"id":4}]`,
+		)(w, r)
+	})
+
+	ctx := context.Background()
+	var got []int64
+	for repo, err := range client.Repositories.ListAccessibleAll(ctx, nil) {
+		if err != nil {
+			t.Fatalf("Repositories.ListAccessibleAll returned error: %v", err)
+		}
+		got = append(got, repo.GetID())
+	}
+	if want := []int64{1, 2, 3, 4}; !cmp.Equal(got, want) {
+		t.Errorf("Repositories.ListAccessibleAll returned %v, want %v", got, want)
+	}
+}
+
+func TestRepositoriesService_ListAccessibleAll_type(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/user/repos", func(w http.ResponseWriter, r *http.Request) {
+		testFormValues(t, r, values{"type": "owner"})
+		fmt.Fprint(w, `[{"id":1,"node_id":"a"}]`)
+	})
+
+	ctx := context.Background()
+	for _, err := range client.Repositories.ListAccessibleAll(ctx, &RepositoryListByAuthenticatedUserOptions{Type: "owner"}) {
+		if err != nil {
+			t.Fatalf("Repositories.ListAccessibleAll returned error: %v", err)
+		}
+	}
+}
+
+func TestActivityService_ListStargazersAll(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/stargazers", func(w http.ResponseWriter, r *http.Request) {
+		testHeader(t, r, "Accept", mediaTypeStarringPreview)
+		testPaginatedHandler(t,
+			`[{"starred_at":"2006-01-02T15:04:05Z","user":{"id":1}}]`,
+			`[{"starred_at":"2006-01-02T15:04:05Z","user":{"id":2}}]`,
+		)(w, r)
+	})
+
+	ctx := context.Background()
+	var got []*Stargazer
+	for s, err := range client.Activity.ListStargazersAll(ctx, "o", "r", nil) {
+		if err != nil {
+			t.Fatalf("Activity.ListStargazersAll returned error: %v", err)
+		}
+		got = append(got, s)
+	}
+	want := []*Stargazer{
+		{StarredAt: &Timestamp{referenceTime}, User: &User{ID: Ptr(int64(1))}},
+		{StarredAt: &Timestamp{referenceTime}, User: &User{ID: Ptr(int64(2))}},
+	}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Activity.ListStargazersAll returned %+v, want %+v", got, want)
+	}
+}
+
+func TestRepositoriesService_ListHookDeliveriesAll(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/hooks/1/deliveries", testCursorPaginatedHandler(t,
+		`[{"id":1},{"id":2}]`,
+		`[{"id":3}]`,
+	))
+
+	ctx := context.Background()
+	var got []int64
+	for d, err := range client.Repositories.ListHookDeliveriesAll(ctx, "o", "r", 1, nil) {
+		if err != nil {
+			t.Fatalf("Repositories.ListHookDeliveriesAll returned error: %v", err)
+		}
+		got = append(got, d.GetID())
+	}
+	if want := []int64{1, 2, 3}; !cmp.Equal(got, want) {
+		t.Errorf("Repositories.ListHookDeliveriesAll returned %v, want %v", got, want)
+	}
+}
+
+func TestOrganizationsService_ListHookDeliveriesAll(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/orgs/o/hooks/1/deliveries", testCursorPaginatedHandler(t,
+		`[{"id":1}]`,
+		`[{"id":2}]`,
+	))
+
+	ctx := context.Background()
+	var got []int64
+	for d, err := range client.Organizations.ListHookDeliveriesAll(ctx, "o", 1, &ListCursorOptions{PerPage: 1}) {
+		if err != nil {
+			t.Fatalf("Organizations.ListHookDeliveriesAll returned error: %v", err)
+		}
+		got = append(got, d.GetID())
+	}
+	if want := []int64{1, 2}; !cmp.Equal(got, want) {
+		t.Errorf("Organizations.ListHookDeliveriesAll returned %v, want %v", got, want)
+	}
+}
+
+func TestAppsService_ListHookDeliveriesAll(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/app/hook/deliveries", testCursorPaginatedHandler(t,
+		`[{"id":1}]`,
+		`[]`,
+		`[{"id":2}]`,
+	))
+
+	ctx := context.Background()
+	var got []int64
+	for d, err := range client.Apps.ListHookDeliveriesAll(ctx, nil) {
+		if err != nil {
+			t.Fatalf("Apps.ListHookDeliveriesAll returned error: %v", err)
+		}
+		got = append(got, d.GetID())
+	}
+	if want := []int64{1, 2}; !cmp.Equal(got, want) {
+		t.Errorf("Apps.ListHookDeliveriesAll returned %v, want %v", got, want)
+	}
+}
+
+func TestSearchService_IssuesExhaustive(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	day := func(d int) time.Time {
+		return time.Date(2020, time.January, d, 0, 0, 0, 0, time.UTC)
+	}
+	created := []time.Time{day(1), day(2), day(2).Add(time.Hour), day(5), day(9)}
+
+	// Each issue counts as 400 results, so any window holding three or more
+	// of them exceeds the cap and must be split.
+	var windows int
+	mux.HandleFunc("/search/issues", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"q": r.FormValue("q"), "sort": "created", "order": "asc", "per_page": "100"})
+		windows++
+
+		fields := strings.Fields(r.FormValue("q"))
+		if len(fields) != 2 || fields[0] != "is:open" || !strings.HasPrefix(fields[1], "created:") {
+			t.Errorf("unexpected query %q", r.FormValue("q"))
+			return
+		}
+		from, to, _ := strings.Cut(strings.TrimPrefix(fields[1], "created:"), "..")
+		since, _ := time.Parse(time.RFC3339, from)
+		until, _ := time.Parse(time.RFC3339, to)
+
+		var items []string
+		for i, c := range created {
+			if !c.Before(since) && !c.After(until) {
+				items = append(items, fmt.Sprintf(`{"id":%v}`, i+1))
+			}
+		}
+		fmt.Fprintf(w, `{"total_count":%v,"items":[%v]}`, 400*len(items), strings.Join(items, ","))
+	})
+
+	ctx := context.Background()
+	var got []int64
+	for issue, err := range client.Search.IssuesExhaustive(ctx, "is:open", ExhaustiveOptions{Since: day(1), Until: day(10)}) {
+		if err != nil {
+			t.Fatalf("Search.IssuesExhaustive returned error: %v", err)
+		}
+		got = append(got, issue.GetID())
+	}
+	if want := []int64{1, 2, 3, 4, 5}; !cmp.Equal(got, want) {
+		t.Errorf("Search.IssuesExhaustive returned %v, want %v", got, want)
+	}
+	if windows < 3 {
+		t.Errorf("Search.IssuesExhaustive made %v requests, want the window to be split", windows)
+	}
+}
+
+func TestSearchService_IssuesExhaustive_error(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/search/issues", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+	})
+
+	ctx := context.Background()
+	for issue, err := range client.Search.IssuesExhaustive(ctx, "q", ExhaustiveOptions{}) {
+		if err == nil {
+			t.Fatalf("Search.IssuesExhaustive yielded %v, want error", issue)
+		}
+		if issue != nil {
+			t.Errorf("Search.IssuesExhaustive yielded %v with error, want nil", issue)
+		}
+	}
+}
+
+func TestSearchService_IssuesExhaustive_createdQualifier(t *testing.T) {
+	t.Parallel()
+	client, _, _ := setup(t)
+
+	ctx := context.Background()
+	var errs int
+	for issue, err := range client.Search.IssuesExhaustive(ctx, "is:open -Created:>2020-01-01", ExhaustiveOptions{}) {
+		if err == nil {
+			t.Fatalf("Search.IssuesExhaustive yielded %v, want error", issue)
+		}
+		errs++
+	}
+	if errs != 1 {
+		t.Errorf("Search.IssuesExhaustive yielded %v errors, want 1", errs)
+	}
+}
+
+func TestSearchService_IssuesExhaustive_windowTooSmall(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/search/issues", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"total_count":1001,"items":[{"id":1}]}`)
+	})
+
+	ctx := context.Background()
+	since := time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+	var errs int
+	for issue, err := range client.Search.IssuesExhaustive(ctx, "q", ExhaustiveOptions{Since: since, Until: since.Add(4 * time.Second)}) {
+		if err == nil {
+			t.Fatalf("Search.IssuesExhaustive yielded %v, want error", issue)
+		}
+		errs++
+	}
+	if errs != 1 {
+		t.Errorf("Search.IssuesExhaustive yielded %v errors, want 1", errs)
+	}
+}
+
+func TestActivityService_ListNotificationsAll(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	paginated := testPaginatedHandler(t,
+		`[{"id":"1"},{"id":"2"}]`,
+		`[{"id":"3"}]`,
+	)
+	mux.HandleFunc("/notifications", func(w http.ResponseWriter, r *http.Request) {
+		if got, want := r.FormValue("all"), "true"; got != want {
+			t.Errorf("all = %q, want %q", got, want)
+		}
+		if got, want := r.FormValue("since"), "2006-01-02T15:04:05Z"; got != want {
+			t.Errorf("since = %q, want %q", got, want)
+		}
+		paginated(w, r)
+	})
+
+	ctx := context.Background()
+	opts := &NotificationListOptions{All: true, Since: time.Date(2006, time.January, 2, 15, 4, 5, 0, time.UTC)}
+	var got []string
+	for n, err := range client.Activity.ListNotificationsAll(ctx, opts) {
+		if err != nil {
+			t.Fatalf("Activity.ListNotificationsAll returned error: %v", err)
+		}
+		got = append(got, n.GetID())
+	}
+	if want := []string{"1", "2", "3"}; !cmp.Equal(got, want) {
+		t.Errorf("Activity.ListNotificationsAll returned %v, want %v", got, want)
+	}
+	if opts.Page != 0 {
+		t.Errorf("Activity.ListNotificationsAll modified opts.Page to %v", opts.Page)
+	}
+}
+
+func TestActionsService_RunnerGroupsAll(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/orgs/o/actions/runner-groups", testPaginatedHandler(t,
+		`{"total_count":3,"runner_groups":[{"name":"a"},{"name":"b"}]}`,
+		`{"total_count":3,"runner_groups":[{"name":"c"}]}`,
+	))
+	mux.HandleFunc("/orgs/o/actions/runner-groups/2/repositories", testPaginatedHandler(t,
+		`{"total_count":2,"repositories":[{"name":"r1"}]}`,
+		`{"total_count":2,"repositories":[{"name":"r2"}]}`,
+	))
+	mux.HandleFunc("/orgs/o/actions/runner-groups/2/runners", testPaginatedHandler(t,
+		`{"total_count":2,"runners":[{"name":"x"}]}`,
+		`{"total_count":2,"runners":[{"name":"y"}]}`,
+	))
+
+	ctx := context.Background()
+	var groups []string
+	for g, err := range client.Actions.ListOrganizationRunnerGroupsAll(ctx, "o", nil) {
+		if err != nil {
+			t.Fatalf("Actions.ListOrganizationRunnerGroupsAll returned error: %v", err)
+		}
+		groups = append(groups, g.GetName())
+	}
+	if want := []string{"a", "b", "c"}; !cmp.Equal(groups, want) {
+		t.Errorf("Actions.ListOrganizationRunnerGroupsAll returned %v, want %v", groups, want)
+	}
+
+	var repos []string
+	for r, err := range client.Actions.ListRepositoryAccessRunnerGroupAll(ctx, "o", 2, nil) {
+		if err != nil {
+			t.Fatalf("Actions.ListRepositoryAccessRunnerGroupAll returned error: %v", err)
+		}
+		repos = append(repos, r.GetName())
+	}
+	if want := []string{"r1", "r2"}; !cmp.Equal(repos, want) {
+		t.Errorf("Actions.ListRepositoryAccessRunnerGroupAll returned %v, want %v", repos, want)
+	}
+
+	var runners []string
+	for r, err := range client.Actions.ListRunnerGroupRunnersAll(ctx, "o", 2, nil) {
+		if err != nil {
+			t.Fatalf("Actions.ListRunnerGroupRunnersAll returned error: %v", err)
+		}
+		runners = append(runners, r.GetName())
+	}
+	if want := []string{"x", "y"}; !cmp.Equal(runners, want) {
+		t.Errorf("Actions.ListRunnerGroupRunnersAll returned %v, want %v", runners, want)
+	}
+}
+
+func TestEnterpriseService_RunnersAll(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/enterprises/e/actions/runners", testPaginatedHandler(t,
+		`{"total_count":3,"runners":[{"name":"a"},{"name":"b"}]}`,
+		`{"total_count":3,"runners":[{"name":"c"}]}`,
+	))
+	mux.HandleFunc("/enterprises/e/actions/runner-groups", testPaginatedHandler(t,
+		`{"total_count":2,"runner_groups":[{"name":"g1"}]}`,
+		`{"total_count":2,"runner_groups":[{"name":"g2"}]}`,
+	))
+	mux.HandleFunc("/enterprises/e/actions/runner-groups/2/runners", testPaginatedHandler(t,
+		`{"total_count":2,"runners":[{"name":"x"}]}`,
+		`{"total_count":2,"runners":[{"name":"y"}]}`,
+	))
+
+	ctx := context.Background()
+	runnerTests := []struct {
+		name string
+		seq  iter.Seq2[*Runner, error]
+		want []string
+	}{
+		{"ListRunnersAll", client.Enterprise.ListRunnersAll(ctx, "e", nil), []string{"a", "b", "c"}},
+		{"ListRunnerGroupRunnersAll", client.Enterprise.ListRunnerGroupRunnersAll(ctx, "e", 2, nil), []string{"x", "y"}},
+	}
+	for _, tt := range runnerTests {
+		var got []string
+		for r, err := range tt.seq {
+			if err != nil {
+				t.Fatalf("Enterprise.%v returned error: %v", tt.name, err)
+			}
+			got = append(got, r.GetName())
+		}
+		if !cmp.Equal(got, tt.want) {
+			t.Errorf("Enterprise.%v returned %v, want %v", tt.name, got, tt.want)
+		}
+	}
+
+	var groups []string
+	for g, err := range client.Enterprise.ListRunnerGroupsAll(ctx, "e", nil) {
+		if err != nil {
+			t.Fatalf("Enterprise.ListRunnerGroupsAll returned error: %v", err)
+		}
+		groups = append(groups, g.GetName())
+	}
+	if want := []string{"g1", "g2"}; !cmp.Equal(groups, want) {
+		t.Errorf("Enterprise.ListRunnerGroupsAll returned %v, want %v", groups, want)
+	}
+}
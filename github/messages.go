@@ -9,6 +9,7 @@
 package github
 
 import (
+	"bytes"
 	"crypto/hmac"
 	"crypto/sha1"
 	"crypto/sha256"
@@ -266,6 +267,53 @@ func ValidatePayload(r *http.Request, secretToken []byte) (payload []byte, err e
 	return ValidatePayloadFromBody(contentType, r.Body, signature, secretToken)
 }
 
+// ValidatePayloadFromHeaders validates a GitHub Webhook event delivered as a
+// raw body and its headers, and returns the (JSON) payload. It behaves like
+// ValidatePayload but does not require an *http.Request, which makes it
+// suitable for serverless functions and message queue consumers.
+// The signature is read from the X-Hub-Signature-256 header, falling back to
+// X-Hub-Signature. Header names are matched case-insensitively, even when
+// header was not built with canonical keys.
+//
+// Example usage:
+//
+//	func handler(ctx context.Context, event events.APIGatewayProxyRequest) error {
+//	  header := http.Header{}
+//	  for k, v := range event.Headers {
+//	    header.Set(k, v)
+//	  }
+//	  payload, err := github.ValidatePayloadFromHeaders(header, []byte(event.Body), webhookSecretKey)
+//	  if err != nil { ... }
+//	  // Process payload...
+//	}
+func ValidatePayloadFromHeaders(header http.Header, body, secretToken []byte) (payload []byte, err error) {
+	signature := headerValue(header, SHA256SignatureHeader)
+	if signature == "" {
+		signature = headerValue(header, SHA1SignatureHeader)
+	}
+
+	contentType, _, err := mime.ParseMediaType(headerValue(header, "Content-Type"))
+	if err != nil {
+		return nil, err
+	}
+
+	return ValidatePayloadFromBody(contentType, bytes.NewReader(body), signature, secretToken)
+}
+
+// headerValue returns the first value associated with key in header,
+// matching key case-insensitively so that non-canonical keys are found too.
+func headerValue(header http.Header, key string) string {
+	if v := header.Get(key); v != "" {
+		return v
+	}
+	for k, v := range header {
+		if strings.EqualFold(k, key) && len(v) > 0 {
+			return v[0]
+		}
+	}
+	return ""
+}
+
 // ValidateSignature validates the signature for the given payload.
 // signature is the GitHub hash signature delivered in the X-Hub-Signature header.
 // payload is the JSON payload sent by GitHub Webhooks.
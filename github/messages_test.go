@@ -258,6 +258,89 @@ func TestValidatePayload_ValidContentTypeParams(t *testing.T) {
 	}
 }
 
+func TestValidatePayloadFromHeaders(t *testing.T) {
+	t.Parallel()
+	const body = `{"yo":true}`
+	const sha1Signature = "sha1=126f2c800419c60137ce748d7672e77b65cf16d6"
+	const sha256Signature = "sha256=b1f8020f5b4cd42042f807dd939015c4a418bc1ff7f604dd55b0a19b5d953d9b"
+	secretKey := []byte("0123456789abcdef")
+
+	tests := []struct {
+		name    string
+		header  http.Header
+		wantErr bool
+	}{
+		{
+			name: "sha256 signature",
+			header: http.Header{
+				"Content-Type":        {"application/json"},
+				"X-Hub-Signature-256": {sha256Signature},
+			},
+		},
+		{
+			name: "falls back to sha1 signature",
+			header: http.Header{
+				"Content-Type":    {"application/json"},
+				"X-Hub-Signature": {sha1Signature},
+			},
+		},
+		{
+			name: "prefers sha256 signature",
+			header: http.Header{
+				"Content-Type":        {"application/json"},
+				"X-Hub-Signature-256": {sha256Signature},
+				"X-Hub-Signature":     {"sha1=012345"},
+			},
+		},
+		{
+			name: "non-canonical header keys",
+			header: http.Header{
+				"content-type":        {"application/json; charset=utf-8"},
+				"x-hub-signature-256": {sha256Signature},
+			},
+		},
+		{
+			name: "invalid signature",
+			header: http.Header{
+				"Content-Type":        {"application/json"},
+				"X-Hub-Signature-256": {"sha256=012345"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "missing signature",
+			header: http.Header{
+				"Content-Type": {"application/json"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "missing content type",
+			header: http.Header{
+				"X-Hub-Signature-256": {sha256Signature},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		got, err := ValidatePayloadFromHeaders(test.header, []byte(body), secretKey)
+		if test.wantErr {
+			if err == nil {
+				t.Errorf("%v: ValidatePayloadFromHeaders returned nil error, want error", test.name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%v: ValidatePayloadFromHeaders returned error: %v", test.name, err)
+			continue
+		}
+		if string(got) != body {
+			t.Errorf("%v: ValidatePayloadFromHeaders = %q, want %q", test.name, got, body)
+		}
+	}
+}
+
 func TestParseWebHook(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -0,0 +1,144 @@
+// Copyright 2026 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Metrics receives observations about the API requests made by a client
+// configured with WithMetrics. It can be implemented on top of expvar,
+// Prometheus, or any other metrics system. Implementations must be safe for
+// concurrent use.
+type Metrics interface {
+	// ObserveRequest is called after each request that reaches the network.
+	// path is the route template of the endpoint, as in the GitHub API docs
+	// but without the leading slash, such as
+	// "repos/{owner}/{repo}/issues/{issue_number}", or "other" for paths
+	// that match no endpoint used by this package. It contains no owner,
+	// repository, user or other names, so it can be used as a metric label.
+	// status is 0 if no response was received.
+	ObserveRequest(method, path string, status int, dur time.Duration)
+
+	// ObserveRateLimit is called for each response that reports rate limit
+	// information, with the rate limit resource (e.g. "core" or "search").
+	ObserveRateLimit(resource string, remaining, limit int)
+}
+
+// WithMetrics returns a copy of the client that reports each request it makes
+// and each rate limit it observes to m.
+func (c *Client) WithMetrics(m Metrics) *Client {
+	c2 := c.copy()
+	defer c2.initialize()
+	c2.metrics = m
+	return c2
+}
+
+// metricsPath returns the templated form of the request path p for Metrics.
+func (c *Client) metricsPath(p string) string {
+	for _, base := range []*url.URL{c.BaseURL, c.UploadURL} {
+		if base != nil && base.Path != "" && strings.HasPrefix(p, base.Path) {
+			p = p[len(base.Path):]
+			break
+		}
+	}
+	p = strings.TrimPrefix(strings.TrimPrefix(p, "/"), c.basePath)
+	return templatePath(p)
+}
+
+// otherRoute is the path reported to Metrics for requests that do not match
+// any of routes.
+const otherRoute = "other"
+
+var (
+	routeSegmentsOnce sync.Once
+	routeSegments     map[string][][]string // routes split into segments, keyed by their first segment
+)
+
+// templatePath returns the template in routes, such as
+// "repos/{owner}/{repo}/issues/{issue_number}", that matches the path p, or
+// otherRoute if none does. Where several templates match, the one with a
+// literal segment at the first position where they differ wins, so that
+// "repos/o/r/pulls/comments" matches "repos/{owner}/{repo}/pulls/comments"
+// rather than "repos/{owner}/{repo}/pulls/{pull_number}". A parameter at
+// the end of a template, such as the path of "repos/{owner}/{repo}/contents/{path}",
+// may match several segments, but only if no template matches exactly.
+func templatePath(p string) string {
+	routeSegmentsOnce.Do(func() {
+		routeSegments = make(map[string][][]string)
+		for _, route := range routes {
+			segments := strings.Split(route, "/")
+			routeSegments[segments[0]] = append(routeSegments[segments[0]], segments)
+		}
+	})
+
+	segments := strings.Split(strings.Trim(p, "/"), "/")
+	var best, bestTail []string
+	for _, route := range routeSegments[segments[0]] {
+		switch matchRoute(route, segments) {
+		case routeMatch:
+			if best == nil || preferRoute(route, best) {
+				best = route
+			}
+		case routeTailMatch:
+			if bestTail == nil || preferRoute(route, bestTail) {
+				bestTail = route
+			}
+		}
+	}
+	if best == nil {
+		best = bestTail
+	}
+	if best == nil {
+		return otherRoute
+	}
+	return strings.Join(best, "/")
+}
+
+const (
+	routeMismatch = iota
+	routeMatch
+	routeTailMatch
+)
+
+// matchRoute reports whether the route template segments match the path
+// segments exactly or only by matching the trailing segments of the path with
+// the last parameter of the template.
+func matchRoute(route, segments []string) int {
+	if len(route) > len(segments) {
+		return routeMismatch
+	}
+	for i, r := range route {
+		if !isRouteParam(r) && r != segments[i] {
+			return routeMismatch
+		}
+	}
+	switch {
+	case len(route) == len(segments):
+		return routeMatch
+	case isRouteParam(route[len(route)-1]):
+		return routeTailMatch
+	}
+	return routeMismatch
+}
+
+// preferRoute reports whether route a has a literal segment where route b has
+// a parameter at the first position where they differ in that respect.
+func preferRoute(a, b []string) bool {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		if pa, pb := isRouteParam(a[i]), isRouteParam(b[i]); pa != pb {
+			return pb
+		}
+	}
+	return len(a) > len(b)
+}
+
+func isRouteParam(segment string) bool {
+	return strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}")
+}
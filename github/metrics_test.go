@@ -0,0 +1,112 @@
+// Copyright 2026 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+type testMetrics struct {
+	mu         sync.Mutex
+	requests   []string
+	rateLimits []string
+}
+
+func (m *testMetrics) ObserveRequest(method, path string, status int, _ time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.requests = append(m.requests, method+" "+path+" "+http.StatusText(status))
+}
+
+func (m *testMetrics) ObserveRateLimit(resource string, remaining, limit int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.rateLimits = append(m.rateLimits, fmt.Sprintf("%v %v/%v", resource, remaining, limit))
+}
+
+func TestWithMetrics(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/issues/12", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(headerRateLimit, "60")
+		w.Header().Set(headerRateRemaining, "59")
+		w.Header().Set(headerRateResource, "core")
+		w.Write([]byte(`{}`))
+	})
+	mux.HandleFunc("/repos/o/r/commits/6dcb09b5b57875f334f61aebed695e2e4193db5e", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	m := &testMetrics{}
+	c := client.WithMetrics(m)
+	if client.metrics != nil {
+		t.Errorf("WithMetrics modified the original client")
+	}
+
+	ctx := context.Background()
+	_, _, err := c.Issues.Get(ctx, "o", "r", 12)
+	assertNilError(t, err)
+	_, _, err = c.Repositories.GetCommit(ctx, "o", "r", "6dcb09b5b57875f334f61aebed695e2e4193db5e", nil)
+	if err == nil {
+		t.Fatal("Repositories.GetCommit returned nil error, want error")
+	}
+
+	wantRequests := []string{
+		"GET repos/{owner}/{repo}/issues/{issue_number} OK",
+		"GET repos/{owner}/{repo}/commits/{ref} Not Found",
+	}
+	if !cmp.Equal(m.requests, wantRequests) {
+		t.Errorf("ObserveRequest calls = %q, want %q", m.requests, wantRequests)
+	}
+	if want := []string{"core 59/60"}; !cmp.Equal(m.rateLimits, want) {
+		t.Errorf("ObserveRateLimit calls = %q, want %q", m.rateLimits, want)
+	}
+}
+
+func TestTemplatePath(t *testing.T) {
+	t.Parallel()
+	tests := map[string]string{
+		"":                             "other",
+		"/user":                        "user",
+		"users/octocat":                "users/{username}",
+		"orgs/o/members":               "orgs/{org}/members",
+		"repos/o/r/issues/12":          "repos/{owner}/{repo}/issues/{issue_number}",
+		"repos/o/r/pulls/comments":     "repos/{owner}/{repo}/pulls/comments",
+		"repos/o/r/pulls/12/":          "repos/{owner}/{repo}/pulls/{pull_number}",
+		"repos/o/r/branches/main":      "repos/{owner}/{repo}/branches/{branch}",
+		"repos/o/r/git/ref/heads/v1":   "repos/{owner}/{repo}/git/ref/{ref}",
+		"repos/o/r/contents/a/b/c.txt": "repos/{owner}/{repo}/contents/{path}",
+		"repos/o/r/git/commits/0123456789abcdef0123456789ABCDEF01234567": "repos/{owner}/{repo}/git/commits/{commit_sha}",
+		"no/such/endpoint": "other",
+	}
+	for in, want := range tests {
+		if got := templatePath(in); got != want {
+			t.Errorf("templatePath(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestClient_metricsPath(t *testing.T) {
+	t.Parallel()
+	c := NewClient(nil).WithBasePath("proxy/github")
+	if got, want := c.metricsPath("/proxy/github/repos/o/r"), "repos/{owner}/{repo}"; got != want {
+		t.Errorf("metricsPath = %q, want %q", got, want)
+	}
+
+	c, err := NewClient(nil).WithEnterpriseURLs("https://ghe.example.com/", "https://ghe.example.com/")
+	assertNilError(t, err)
+	if got, want := c.metricsPath("/api/uploads/repos/o/r/releases/1/assets"), "repos/{owner}/{repo}/releases/{release_id}/assets"; got != want {
+		t.Errorf("metricsPath = %q, want %q", got, want)
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"strings"
 )
@@ -55,6 +56,10 @@ type MigrationOptions struct {
 	// ExcludeAttachments indicates whether attachments should be excluded from
 	// the migration (to reduce migration archive file size).
 	ExcludeAttachments bool
+
+	// Exclude lists related items to exclude from the migration.
+	// Can contain: repositories (to only migrate organization metadata).
+	Exclude []string
 }
 
 // startMigration represents the body of a StartMigration request.
@@ -69,6 +74,9 @@ type startMigration struct {
 	// ExcludeAttachments indicates whether attachments should be excluded from
 	// the migration (to reduce migration archive file size).
 	ExcludeAttachments *bool `json:"exclude_attachments,omitempty"`
+
+	// Exclude lists related items to exclude from the migration.
+	Exclude []string `json:"exclude,omitempty"`
 }
 
 // StartMigration starts the generation of a migration archive.
@@ -84,6 +92,7 @@ func (s *MigrationService) StartMigration(ctx context.Context, org string, repos
 	if opts != nil {
 		body.LockRepositories = Ptr(opts.LockRepositories)
 		body.ExcludeAttachments = Ptr(opts.ExcludeAttachments)
+		body.Exclude = opts.Exclude
 	}
 
 	req, err := s.client.NewRequest("POST", u, body)
@@ -110,7 +119,7 @@ func (s *MigrationService) StartMigration(ctx context.Context, org string, repos
 //meta:operation GET /orgs/{org}/migrations
 func (s *MigrationService) ListMigrations(ctx context.Context, org string, opts *ListOptions) ([]*Migration, *Response, error) {
 	u := fmt.Sprintf("orgs/%v/migrations", org)
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -238,3 +247,106 @@ func (s *MigrationService) UnlockRepo(ctx context.Context, org string, id int64,
 
 	return s.client.Do(ctx, req, nil)
 }
+
+// ErrMigrationFailed is returned by WaitForMigration when the migration it was
+// waiting for ends in the "failed" state.
+var ErrMigrationFailed = errors.New("migration failed")
+
+// WaitForMigration polls the status of a migration, as described by opts,
+// until its state is "exported" or "failed", and returns it. If the migration
+// failed, it is returned together with ErrMigrationFailed. If ctx is done or
+// opts.Timeout elapses first, the context's error is returned along with the
+// last response.
+//
+// GitHub API docs: https://docs.github.com/rest/migrations/orgs#get-an-organization-migration-status
+//
+//meta:operation GET /orgs/{org}/migrations/{migration_id}
+func (s *MigrationService) WaitForMigration(ctx context.Context, org string, id int64, opts WaitOptions) (*Migration, *Response, error) {
+	var m *Migration
+	var resp *Response
+	err := s.client.poll(ctx, opts, func(ctx context.Context) (bool, error) {
+		var err error
+		m, resp, err = s.MigrationStatus(ctx, org, id)
+		if err != nil {
+			return false, err
+		}
+		switch m.GetState() {
+		case "exported":
+			return true, nil
+		case "failed":
+			return false, ErrMigrationFailed
+		}
+		return false, nil
+	})
+	if err != nil {
+		if errors.Is(err, ErrMigrationFailed) {
+			return m, resp, err
+		}
+		return nil, resp, err
+	}
+
+	return m, resp, nil
+}
+
+// CompleteMigration finishes an exported migration: it downloads the migration
+// archive into w and then unlocks every repository of the migration, which
+// otherwise stay locked after a migration started with LockRepositories.
+//
+// The archive is downloaded with httpClient, or http.DefaultClient if nil, as
+// its location must not be sent the GitHub credentials. If the download fails,
+// the repositories are left locked so that it can be retried. Otherwise, all
+// repositories are unlocked even if some of them fail, and the errors of those
+// that failed are returned joined together.
+//
+// GitHub API docs: https://docs.github.com/rest/migrations/orgs#download-an-organization-migration-archive
+// GitHub API docs: https://docs.github.com/rest/migrations/orgs#get-an-organization-migration-status
+// GitHub API docs: https://docs.github.com/rest/migrations/orgs#unlock-an-organization-repository
+//
+//meta:operation GET /orgs/{org}/migrations/{migration_id}
+//meta:operation GET /orgs/{org}/migrations/{migration_id}/archive
+//meta:operation DELETE /orgs/{org}/migrations/{migration_id}/repos/{repo_name}/lock
+func (s *MigrationService) CompleteMigration(ctx context.Context, org string, id int64, w io.Writer, httpClient *http.Client) error {
+	m, _, err := s.MigrationStatus(ctx, org, id)
+	if err != nil {
+		return err
+	}
+
+	archiveURL, err := s.MigrationArchiveURL(ctx, org, id)
+	if err != nil {
+		return err
+	}
+	if err := downloadMigrationArchive(ctx, httpClient, archiveURL, w); err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, repo := range m.Repositories {
+		if _, err := s.UnlockRepo(ctx, org, id, repo.GetName()); err != nil {
+			errs = append(errs, fmt.Errorf("unlocking %v: %w", repo.GetName(), err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// downloadMigrationArchive copies the archive at url into w.
+func downloadMigrationArchive(ctx context.Context, httpClient *http.Client, url string, w io.Writer) error {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("downloading migration archive: unexpected status code: %v", resp.Status)
+	}
+	_, err = io.Copy(w, resp.Body)
+	return err
+}
@@ -7,6 +7,7 @@ package github
 
 import (
 	"context"
+	"errors"
 	"fmt"
 )
 
@@ -319,3 +320,49 @@ func (s *MigrationService) CancelImport(ctx context.Context, owner, repo string)
 
 	return s.client.Do(ctx, req, nil)
 }
+
+// ErrImportFailed is returned by WaitForImport when the import it was waiting
+// for stops in a state that needs attention, such as "error" or
+// "auth_failed".
+var ErrImportFailed = errors.New("source import failed")
+
+// WaitForImport polls the progress of the import of a repository, as
+// described by opts, until its status is "complete" or one of the problem
+// states listed in Import.Status, and returns it. If the import stopped in a
+// problem state, the import is returned together with an error wrapping
+// ErrImportFailed and the status; depending on the status, an UpdateImport
+// request can then resume it. If ctx is done or opts.Timeout elapses first,
+// the context's error is returned along with the last response.
+//
+// GitHub API docs: https://docs.github.com/rest/migrations/source-imports#get-an-import-status
+//
+//meta:operation GET /repos/{owner}/{repo}/import
+func (s *MigrationService) WaitForImport(ctx context.Context, owner, repo string, opts WaitOptions) (*Import, *Response, error) {
+	var imp *Import
+	var resp *Response
+	err := s.client.poll(ctx, opts, func(ctx context.Context) (bool, error) {
+		var err error
+		imp, resp, err = s.ImportProgress(ctx, owner, repo)
+		if err != nil {
+			return false, err
+		}
+		switch status := imp.GetStatus(); status {
+		case "complete":
+			return true, nil
+		case "error", "auth_failed", "detection_needs_auth", "detection_found_nothing", "detection_found_multiple":
+			if msg := imp.GetMessage(); msg != "" {
+				return false, fmt.Errorf("%w: %v: %v", ErrImportFailed, status, msg)
+			}
+			return false, fmt.Errorf("%w: %v", ErrImportFailed, status)
+		}
+		return false, nil
+	})
+	if err != nil {
+		if errors.Is(err, ErrImportFailed) {
+			return imp, resp, err
+		}
+		return nil, resp, err
+	}
+
+	return imp, resp, nil
+}
@@ -8,9 +8,11 @@ package github
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 )
@@ -444,3 +446,60 @@ func TestImport_Marshal(t *testing.T) {
 
 	testJSONMarshal(t, u, want)
 }
+
+func TestMigrationService_WaitForImport(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	calls := 0
+	mux.HandleFunc("/repos/o/r/import", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		calls++
+		if calls < 3 {
+			fmt.Fprint(w, `{"status":"importing","percent":50}`)
+			return
+		}
+		fmt.Fprint(w, `{"status":"complete","commit_count":10}`)
+	})
+
+	ctx := context.Background()
+	imp, _, err := client.Migrations.WaitForImport(ctx, "o", "r", WaitOptions{Interval: time.Millisecond})
+	if err != nil {
+		t.Fatalf("Migrations.WaitForImport returned error: %v", err)
+	}
+
+	want := &Import{Status: Ptr("complete"), CommitCount: Ptr(10)}
+	if !cmp.Equal(imp, want) {
+		t.Errorf("Migrations.WaitForImport returned %+v, want %+v", imp, want)
+	}
+	if calls != 3 {
+		t.Errorf("Migrations.WaitForImport polled %v times, want 3", calls)
+	}
+
+	const methodName = "WaitForImport"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Migrations.WaitForImport(ctx, "\n", "\n", WaitOptions{})
+		return err
+	})
+}
+
+func TestMigrationService_WaitForImport_failed(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/import", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"status":"auth_failed"}`)
+	})
+
+	ctx := context.Background()
+	imp, _, err := client.Migrations.WaitForImport(ctx, "o", "r", WaitOptions{Interval: time.Millisecond})
+	if !errors.Is(err, ErrImportFailed) {
+		t.Fatalf("Migrations.WaitForImport returned error %v, want %v", err, ErrImportFailed)
+	}
+	if got, want := err.Error(), "source import failed: auth_failed"; got != want {
+		t.Errorf("Migrations.WaitForImport returned error %q, want %q", got, want)
+	}
+	if got, want := imp.GetStatus(), "auth_failed"; got != want {
+		t.Errorf("Migrations.WaitForImport returned import with status %q, want %q", got, want)
+	}
+}
@@ -6,11 +6,14 @@
 package github
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 )
@@ -252,6 +255,115 @@ var wantMigration = &Migration{
 	},
 }
 
+func TestMigrationService_WaitForMigration(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	states := []string{"pending", "exporting", "exported"}
+	calls := 0
+	mux.HandleFunc("/orgs/o/migrations/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprintf(w, `{"id":1,"state":%q}`, states[calls])
+		calls++
+	})
+
+	ctx := context.Background()
+	m, _, err := client.Migrations.WaitForMigration(ctx, "o", 1, WaitOptions{Interval: time.Millisecond})
+	if err != nil {
+		t.Fatalf("Migrations.WaitForMigration returned error: %v", err)
+	}
+	if want := (&Migration{ID: Ptr(int64(1)), State: Ptr("exported")}); !cmp.Equal(m, want) {
+		t.Errorf("Migrations.WaitForMigration returned %+v, want %+v", m, want)
+	}
+	if calls != 3 {
+		t.Errorf("Migrations.WaitForMigration polled %v times, want 3", calls)
+	}
+}
+
+func TestMigrationService_WaitForMigration_failed(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/orgs/o/migrations/1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id":1,"state":"failed"}`)
+	})
+
+	ctx := context.Background()
+	m, _, err := client.Migrations.WaitForMigration(ctx, "o", 1, WaitOptions{Interval: time.Millisecond})
+	if !errors.Is(err, ErrMigrationFailed) {
+		t.Errorf("Migrations.WaitForMigration returned error %v, want %v", err, ErrMigrationFailed)
+	}
+	if m.GetState() != "failed" {
+		t.Errorf("Migrations.WaitForMigration returned %+v, want the failed migration", m)
+	}
+}
+
+func TestMigrationService_CompleteMigration(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/orgs/o/migrations/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":1,"state":"exported","repositories":[{"name":"a"},{"name":"b"},{"name":"c"}]}`)
+	})
+	mux.HandleFunc("/orgs/o/migrations/1/archive", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, baseURLPath+"/archive.tar.gz", http.StatusFound)
+	})
+	mux.HandleFunc("/archive.tar.gz", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		assertWrite(t, w, []byte("archive"))
+	})
+	var unlocked []string
+	mux.HandleFunc("/orgs/o/migrations/1/repos/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		repo := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/orgs/o/migrations/1/repos/"), "/lock")
+		if repo == "b" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		unlocked = append(unlocked, repo)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	var buf bytes.Buffer
+	err := client.Migrations.CompleteMigration(ctx, "o", 1, &buf, nil)
+
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) || errResp.Response.StatusCode != http.StatusNotFound {
+		t.Errorf("Migrations.CompleteMigration returned error %v, want the 404 of unlocking b", err)
+	}
+	if got, want := buf.String(), "archive"; got != want {
+		t.Errorf("Migrations.CompleteMigration downloaded %q, want %q", got, want)
+	}
+	if want := []string{"a", "c"}; !cmp.Equal(unlocked, want) {
+		t.Errorf("Migrations.CompleteMigration unlocked %v, want %v", unlocked, want)
+	}
+}
+
+func TestMigrationService_CompleteMigration_downloadFailure(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/orgs/o/migrations/1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id":1,"state":"exported","repositories":[{"name":"a"}]}`)
+	})
+	mux.HandleFunc("/orgs/o/migrations/1/archive", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, baseURLPath+"/archive.tar.gz", http.StatusFound)
+	})
+	mux.HandleFunc("/archive.tar.gz", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	})
+	mux.HandleFunc("/orgs/o/migrations/1/repos/a/lock", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Migrations.CompleteMigration unlocked a repository after a failed download")
+	})
+
+	ctx := context.Background()
+	if err := client.Migrations.CompleteMigration(ctx, "o", 1, &bytes.Buffer{}, nil); err == nil {
+		t.Error("Migrations.CompleteMigration returned nil error, want error")
+	}
+}
+
 func TestMigration_Marshal(t *testing.T) {
 	t.Parallel()
 	testJSONMarshal(t, &Migration{}, "{}")
@@ -295,6 +407,7 @@ func TestStartMigration_Marshal(t *testing.T) {
 		Repositories:       []string{"r"},
 		LockRepositories:   Ptr(false),
 		ExcludeAttachments: Ptr(false),
+		Exclude:            []string{"repositories"},
 	}
 
 	want := `{
@@ -302,7 +415,10 @@ func TestStartMigration_Marshal(t *testing.T) {
 			"r"
 		],
 		"lock_repositories": false,
-		"exclude_attachments": false
+		"exclude_attachments": false,
+		"exclude": [
+			"repositories"
+		]
 	}`
 
 	testJSONMarshal(t, u, want)
@@ -103,7 +103,7 @@ func (s *MigrationService) StartUserMigration(ctx context.Context, repos []strin
 //meta:operation GET /user/migrations
 func (s *MigrationService) ListUserMigrations(ctx context.Context, opts *ListOptions) ([]*UserMigration, *Response, error) {
 	u := "user/migrations"
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -144,6 +144,18 @@ type OrganizationsListOptions struct {
 	ListOptions
 }
 
+// ListReposMatchingOptions specifies the optional parameters to the
+// OrganizationsService.ListReposMatching method.
+type ListReposMatchingOptions struct {
+	// Regexp makes the pattern a regular expression, with the syntax accepted
+	// by regexp.Compile, instead of a glob, with the syntax accepted by
+	// path.Match. Unlike a glob, a regular expression matches any part of
+	// the name unless it is anchored with ^ and $.
+	Regexp bool `url:"-"`
+
+	RepositoryListByOrgOptions
+}
+
 // ListAll lists all organizations, in the order that they were created on GitHub.
 //
 // Note: Pagination is powered exclusively by the since parameter. To continue
@@ -154,7 +166,7 @@ type OrganizationsListOptions struct {
 //
 //meta:operation GET /organizations
 func (s *OrganizationsService) ListAll(ctx context.Context, opts *OrganizationsListOptions) ([]*Organization, *Response, error) {
-	u, err := addOptions("organizations", opts)
+	u, err := s.client.addOptions("organizations", opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -187,7 +199,7 @@ func (s *OrganizationsService) List(ctx context.Context, user string, opts *List
 	} else {
 		u = "user/orgs"
 	}
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -298,7 +310,7 @@ func (s *OrganizationsService) Delete(ctx context.Context, org string) (*Respons
 func (s *OrganizationsService) ListInstallations(ctx context.Context, org string, opts *ListOptions) (*OrganizationInstallations, *Response, error) {
 	u := fmt.Sprintf("orgs/%v/installations", org)
 
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -20,7 +20,7 @@ import (
 func (s *OrganizationsService) ListAttestations(ctx context.Context, org, subjectDigest string, opts *ListOptions) (*AttestationsResponse, *Response, error) {
 	var u = fmt.Sprintf("orgs/%v/attestations/%v", org, subjectDigest)
 
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -248,7 +248,7 @@ func (s *OrganizationsService) RemoveOrgRoleFromUser(ctx context.Context, org, u
 //meta:operation GET /orgs/{org}/organization-roles/{role_id}/teams
 func (s *OrganizationsService) ListTeamsAssignedToOrgRole(ctx context.Context, org string, roleID int64, opts *ListOptions) ([]*Team, *Response, error) {
 	u := fmt.Sprintf("orgs/%v/organization-roles/%v/teams", org, roleID)
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -275,7 +275,7 @@ func (s *OrganizationsService) ListTeamsAssignedToOrgRole(ctx context.Context, o
 //meta:operation GET /orgs/{org}/organization-roles/{role_id}/users
 func (s *OrganizationsService) ListUsersAssignedToOrgRole(ctx context.Context, org string, roleID int64, opts *ListOptions) ([]*User, *Response, error) {
 	u := fmt.Sprintf("orgs/%v/organization-roles/%v/users", org, roleID)
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -7,7 +7,22 @@ package github
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
+)
+
+var (
+	// ErrCannotConvertMember is returned, wrapping the *ErrorResponse, by
+	// ConvertMemberToOutsideCollaborator when GitHub refuses to convert the
+	// user, such as when they are the last owner or not a member of the
+	// organization.
+	ErrCannotConvertMember = errors.New("member cannot be converted to an outside collaborator")
+
+	// ErrUserIsOrgMember is returned, wrapping the *ErrorResponse, by
+	// RemoveOutsideCollaborator when the user is a member of the organization
+	// rather than an outside collaborator.
+	ErrUserIsOrgMember = errors.New("user is a member of the organization")
 )
 
 // ListOutsideCollaboratorsOptions specifies optional parameters to the
@@ -32,7 +47,7 @@ type ListOutsideCollaboratorsOptions struct {
 //meta:operation GET /orgs/{org}/outside_collaborators
 func (s *OrganizationsService) ListOutsideCollaborators(ctx context.Context, org string, opts *ListOutsideCollaboratorsOptions) ([]*User, *Response, error) {
 	u := fmt.Sprintf("orgs/%v/outside_collaborators", org)
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -53,6 +68,8 @@ func (s *OrganizationsService) ListOutsideCollaborators(ctx context.Context, org
 
 // RemoveOutsideCollaborator removes a user from the list of outside collaborators;
 // consequently, removing them from all the organization's repositories.
+// If the user is a member of the organization, the returned error wraps
+// ErrUserIsOrgMember.
 //
 // GitHub API docs: https://docs.github.com/rest/orgs/outside-collaborators#remove-outside-collaborator-from-an-organization
 //
@@ -64,14 +81,20 @@ func (s *OrganizationsService) RemoveOutsideCollaborator(ctx context.Context, or
 		return nil, err
 	}
 
-	return s.client.Do(ctx, req, nil)
+	resp, err := s.client.Do(ctx, req, nil)
+	if isErrorStatus(err, http.StatusUnprocessableEntity) {
+		err = fmt.Errorf("%w: %w", ErrUserIsOrgMember, err)
+	}
+	return resp, err
 }
 
 // ConvertMemberToOutsideCollaborator reduces the permission level of a member of the
 // organization to that of an outside collaborator. Therefore, they will only
 // have access to the repositories that their current team membership allows.
-// Responses for converting a non-member or the last owner to an outside collaborator
-// are listed in GitHub API docs.
+// If GitHub refuses the conversion, such as for a non-member or the last owner
+// of the organization, the returned error wraps ErrCannotConvertMember.
+// If the conversion is queued rather than done immediately, an *AcceptedError
+// is returned.
 //
 // GitHub API docs: https://docs.github.com/rest/orgs/outside-collaborators#convert-an-organization-member-to-outside-collaborator
 //
@@ -83,5 +106,10 @@ func (s *OrganizationsService) ConvertMemberToOutsideCollaborator(ctx context.Co
 		return nil, err
 	}
 
-	return s.client.Do(ctx, req, nil)
+	resp, err := s.client.Do(ctx, req, nil)
+	// Rate limit and SSO errors are also 403s, but are not refusals.
+	if isErrorStatus(err, http.StatusForbidden) && !errors.As(err, new(*SSOError)) {
+		err = fmt.Errorf("%w: %w", ErrCannotConvertMember, err)
+	}
+	return resp, err
 }
@@ -7,9 +7,11 @@ package github
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 )
@@ -123,11 +125,15 @@ func TestOrganizationsService_RemoveOutsideCollaborator_Member(t *testing.T) {
 
 	ctx := context.Background()
 	_, err := client.Organizations.RemoveOutsideCollaborator(ctx, "o", "u")
-	if err, ok := err.(*ErrorResponse); !ok {
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) {
 		t.Errorf("Organizations.RemoveOutsideCollaborator did not return an error")
-	} else if err.Response.StatusCode != http.StatusUnprocessableEntity {
+	} else if errResp.Response.StatusCode != http.StatusUnprocessableEntity {
 		t.Errorf("Organizations.RemoveOutsideCollaborator did not return 422 status code")
 	}
+	if !errors.Is(err, ErrUserIsOrgMember) {
+		t.Errorf("Organizations.RemoveOutsideCollaborator returned error %v, want %v", err, ErrUserIsOrgMember)
+	}
 }
 
 func TestOrganizationsService_ConvertMemberToOutsideCollaborator(t *testing.T) {
@@ -168,9 +174,58 @@ func TestOrganizationsService_ConvertMemberToOutsideCollaborator_NonMemberOrLast
 
 	ctx := context.Background()
 	_, err := client.Organizations.ConvertMemberToOutsideCollaborator(ctx, "o", "u")
-	if err, ok := err.(*ErrorResponse); !ok {
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) {
 		t.Errorf("Organizations.ConvertMemberToOutsideCollaborator did not return an error")
-	} else if err.Response.StatusCode != http.StatusForbidden {
+	} else if errResp.Response.StatusCode != http.StatusForbidden {
 		t.Errorf("Organizations.ConvertMemberToOutsideCollaborator did not return 403 status code")
 	}
+	if !errors.Is(err, ErrCannotConvertMember) {
+		t.Errorf("Organizations.ConvertMemberToOutsideCollaborator returned error %v, want %v", err, ErrCannotConvertMember)
+	}
+}
+
+func TestOrganizationsService_ConvertMemberToOutsideCollaborator_otherForbidden(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/orgs/o/outside_collaborators/rate", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(headerRateLimit, "60")
+		w.Header().Set(headerRateRemaining, "0")
+		w.Header().Set(headerRateReset, fmt.Sprint(time.Now().Add(time.Minute).Unix()))
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"message":"API rate limit exceeded"}`)
+	})
+
+	ctx := context.Background()
+	_, err := client.Organizations.ConvertMemberToOutsideCollaborator(ctx, "o", "rate")
+	if !errors.As(err, new(*RateLimitError)) || errors.Is(err, ErrCannotConvertMember) {
+		t.Errorf("Organizations.ConvertMemberToOutsideCollaborator returned error %v, want an unwrapped *RateLimitError", err)
+	}
+	client, mux, _ = setup(t)
+	mux.HandleFunc("/orgs/o/outside_collaborators/sso", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(headerSSO, "required; url=https://github.com/orgs/o/sso")
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"message":"Resource protected by organization SAML enforcement."}`)
+	})
+	_, err = client.Organizations.ConvertMemberToOutsideCollaborator(ctx, "o", "sso")
+	if !errors.As(err, new(*SSOError)) || errors.Is(err, ErrCannotConvertMember) {
+		t.Errorf("Organizations.ConvertMemberToOutsideCollaborator returned error %v, want an unwrapped *SSOError", err)
+	}
+}
+
+func TestOrganizationsService_ConvertMemberToOutsideCollaborator_Accepted(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/orgs/o/outside_collaborators/u", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	ctx := context.Background()
+	_, err := client.Organizations.ConvertMemberToOutsideCollaborator(ctx, "o", "u")
+	if !errors.As(err, new(*AcceptedError)) {
+		t.Errorf("Organizations.ConvertMemberToOutsideCollaborator returned error %v, want *AcceptedError", err)
+	}
 }
@@ -95,7 +95,7 @@ type ListFineGrainedPATOptions struct {
 func (s *OrganizationsService) ListFineGrainedPersonalAccessTokens(ctx context.Context, org string, opts *ListFineGrainedPATOptions) ([]*PersonalAccessToken, *Response, error) {
 	u := fmt.Sprintf("orgs/%v/personal-access-tokens", org)
 	// The `owner` parameter is a special case that uses the `owner[]=...` format and needs a custom function to format it correctly.
-	u, err := addListFineGrainedPATOptions(u, opts)
+	u, err := addListFineGrainedPATOptions(s.client, u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -149,8 +149,8 @@ func (s *OrganizationsService) ReviewPersonalAccessTokenRequest(ctx context.Cont
 // This will filter the results to only include fine-grained personal access tokens owned by `user1` and `user2`.
 //
 // This function ensures the owner parameter is formatted correctly in the URL query string.
-func addListFineGrainedPATOptions(s string, opts *ListFineGrainedPATOptions) (string, error) {
-	u, err := addOptions(s, opts)
+func addListFineGrainedPATOptions(c *Client, s string, opts *ListFineGrainedPATOptions) (string, error) {
+	u, err := c.addOptions(s, opts)
 	if err != nil {
 		return s, err
 	}
@@ -199,7 +199,7 @@ func (s *OrganizationsService) RemoveCustomProperty(ctx context.Context, org, cu
 //meta:operation GET /orgs/{org}/properties/values
 func (s *OrganizationsService) ListCustomPropertyValues(ctx context.Context, org string, opts *ListOptions) ([]*RepoCustomPropertyValue, *Response, error) {
 	u := fmt.Sprintf("orgs/%v/properties/values", org)
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -7,9 +7,16 @@ package github
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
 )
 
+// ErrSecurityManagerTeamLimit is returned, wrapping the *ErrorResponse, by
+// AddSecurityManagerTeam when the organization already has the maximum number
+// of security manager teams.
+var ErrSecurityManagerTeamLimit = errors.New("organization has reached the maximum number of security manager teams")
+
 // ListSecurityManagerTeams lists all security manager teams for an organization.
 //
 // Deprecated: Please use `client.Organizations.ListTeamsAssignedToOrgRole` instead.
@@ -35,6 +42,9 @@ func (s *OrganizationsService) ListSecurityManagerTeams(ctx context.Context, org
 }
 
 // AddSecurityManagerTeam adds a team to the list of security managers for an organization.
+// Adding a team that is already a security manager succeeds without changes.
+// If the organization has reached its limit of security manager teams, the
+// returned error wraps ErrSecurityManagerTeamLimit.
 //
 // Deprecated: Please use `client.Organizations.AssignOrgRoleToTeam` instead.
 //
@@ -48,7 +58,11 @@ func (s *OrganizationsService) AddSecurityManagerTeam(ctx context.Context, org,
 		return nil, err
 	}
 
-	return s.client.Do(ctx, req, nil)
+	resp, err := s.client.Do(ctx, req, nil)
+	if err != nil && resp != nil && resp.StatusCode == http.StatusConflict {
+		err = fmt.Errorf("%w: %w", ErrSecurityManagerTeamLimit, err)
+	}
+	return resp, err
 }
 
 // RemoveSecurityManagerTeam removes a team from the list of security managers for an organization.
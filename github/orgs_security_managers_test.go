@@ -7,6 +7,7 @@ package github
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"testing"
@@ -83,6 +84,49 @@ func TestOrganizationsService_AddSecurityManagerTeam(t *testing.T) {
 	})
 }
 
+func TestOrganizationsService_AddSecurityManagerTeam_alreadyManager(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	managers := map[string]bool{}
+	mux.HandleFunc("/orgs/o/security-managers/teams/t", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		managers["t"] = true
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	for i := 0; i < 2; i++ {
+		if _, err := client.Organizations.AddSecurityManagerTeam(ctx, "o", "t"); err != nil {
+			t.Errorf("Organizations.AddSecurityManagerTeam #%v returned error: %v", i, err)
+		}
+	}
+	if !managers["t"] {
+		t.Error("team t was not added as a security manager")
+	}
+}
+
+func TestOrganizationsService_AddSecurityManagerTeam_limitReached(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/orgs/o/security-managers/teams/t", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		w.WriteHeader(http.StatusConflict)
+		fmt.Fprint(w, `{"message":"The organization has reached the maximum number of security manager teams."}`)
+	})
+
+	ctx := context.Background()
+	_, err := client.Organizations.AddSecurityManagerTeam(ctx, "o", "t")
+	if !errors.Is(err, ErrSecurityManagerTeamLimit) {
+		t.Errorf("Organizations.AddSecurityManagerTeam returned error %v, want ErrSecurityManagerTeamLimit", err)
+	}
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) {
+		t.Errorf("Organizations.AddSecurityManagerTeam returned error %v, want it to wrap an *ErrorResponse", err)
+	}
+}
+
 func TestOrganizationsService_AddSecurityManagerTeam_invalidOrg(t *testing.T) {
 	t.Parallel()
 	client, _, _ := setup(t)
@@ -0,0 +1,312 @@
+// Copyright 2026 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"errors"
+)
+
+// ProjectsV2Service provides basic automation of GitHub Projects, which are
+// only available through the GraphQL API. Its methods send GraphQL queries
+// using the client's transport and authentication, so the token needs the
+// "project" or "read:project" scope, or the equivalent app permissions.
+// Projects and items are identified by their GraphQL node IDs, as given by
+// ProjectV2.NodeID and ProjectV2Item.NodeID.
+//
+// For anything beyond the operations provided here, use a GraphQL client such
+// as github.com/shurcooL/githubv4.
+//
+// GitHub API docs: https://docs.github.com/issues/planning-and-tracking-with-projects/automating-your-project/using-the-api-to-manage-projects
+type ProjectsV2Service service
+
+// ProjectV2FieldValue is the value of a field of a project item. Exactly one
+// of its fields must be set, matching the type of the project field.
+type ProjectV2FieldValue struct {
+	Text   *string  `json:"text,omitempty"`
+	Number *float64 `json:"number,omitempty"`
+	// Date is formatted as YYYY-MM-DD.
+	Date                 *string `json:"date,omitempty"`
+	SingleSelectOptionID *string `json:"singleSelectOptionId,omitempty"`
+	IterationID          *string `json:"iterationId,omitempty"`
+}
+
+const projectV2Fields = `
+fragment projectFields on ProjectV2 {
+  id
+  databaseId
+  number
+  title
+  shortDescription
+  public
+  closed
+  url
+  createdAt
+  updatedAt
+  closedAt
+}`
+
+const projectV2ItemFields = `
+fragment itemFields on ProjectV2Item {
+  id
+  databaseId
+  createdAt
+  updatedAt
+  creator { login }
+  project { id }
+  content {
+    __typename
+    ... on Issue { id }
+    ... on PullRequest { id }
+    ... on DraftIssue { id }
+  }
+}`
+
+// graphQLProjectV2 is the result of the projectFields fragment.
+type graphQLProjectV2 struct {
+	ID               string     `json:"id"`
+	DatabaseID       int64      `json:"databaseId"`
+	Number           int        `json:"number"`
+	Title            string     `json:"title"`
+	ShortDescription *string    `json:"shortDescription"`
+	Public           bool       `json:"public"`
+	Closed           bool       `json:"closed"`
+	URL              string     `json:"url"`
+	CreatedAt        *Timestamp `json:"createdAt"`
+	UpdatedAt        *Timestamp `json:"updatedAt"`
+	ClosedAt         *Timestamp `json:"closedAt"`
+}
+
+func (p *graphQLProjectV2) toProjectV2() *ProjectV2 {
+	state := "open"
+	if p.Closed {
+		state = "closed"
+	}
+	return &ProjectV2{
+		ID:               Ptr(p.DatabaseID),
+		NodeID:           Ptr(p.ID),
+		Number:           Ptr(p.Number),
+		Title:            Ptr(p.Title),
+		ShortDescription: p.ShortDescription,
+		Public:           Ptr(p.Public),
+		State:            Ptr(state),
+		HTMLURL:          Ptr(p.URL),
+		CreatedAt:        p.CreatedAt,
+		UpdatedAt:        p.UpdatedAt,
+		ClosedAt:         p.ClosedAt,
+	}
+}
+
+// graphQLProjectV2Item is the result of the itemFields fragment.
+type graphQLProjectV2Item struct {
+	ID         string     `json:"id"`
+	DatabaseID int64      `json:"databaseId"`
+	CreatedAt  *Timestamp `json:"createdAt"`
+	UpdatedAt  *Timestamp `json:"updatedAt"`
+	Creator    *struct {
+		Login string `json:"login"`
+	} `json:"creator"`
+	Project struct {
+		ID string `json:"id"`
+	} `json:"project"`
+	Content *struct {
+		Typename string `json:"__typename"`
+		ID       string `json:"id"`
+	} `json:"content"`
+}
+
+func (i *graphQLProjectV2Item) toProjectV2Item() *ProjectV2Item {
+	item := &ProjectV2Item{
+		ID:            Ptr(i.DatabaseID),
+		NodeID:        Ptr(i.ID),
+		ProjectNodeID: Ptr(i.Project.ID),
+		CreatedAt:     i.CreatedAt,
+		UpdatedAt:     i.UpdatedAt,
+	}
+	if i.Creator != nil {
+		item.Creator = &User{Login: Ptr(i.Creator.Login)}
+	}
+	// Content is null for items the viewer cannot see.
+	if i.Content != nil {
+		item.ContentNodeID = Ptr(i.Content.ID)
+		item.ContentType = Ptr(i.Content.Typename)
+	}
+	return item
+}
+
+// GetProject gets the project with the given number that is owned by the
+// organization or user owner.
+//
+// Only the ID, NodeID, Number, Title, ShortDescription, Public, State,
+// HTMLURL, CreatedAt, UpdatedAt, and ClosedAt fields of the returned project
+// are set.
+//
+// GitHub API docs: https://docs.github.com/graphql/guides/forming-calls-with-graphql
+//
+//meta:operation POST /graphql
+func (s *ProjectsV2Service) GetProject(ctx context.Context, owner string, number int) (*ProjectV2, *Response, error) {
+	query := `query($owner: String!, $number: Int!) {
+  repositoryOwner(login: $owner) {
+    ... on ProjectV2Owner {
+      projectV2(number: $number) { ...projectFields }
+    }
+  }
+}` + projectV2Fields
+
+	var data struct {
+		RepositoryOwner *struct {
+			ProjectV2 *graphQLProjectV2 `json:"projectV2"`
+		} `json:"repositoryOwner"`
+	}
+	resp, err := s.client.graphQL(ctx, query, map[string]interface{}{"owner": owner, "number": number}, &data)
+	if err != nil {
+		return nil, resp, err
+	}
+	if data.RepositoryOwner == nil || data.RepositoryOwner.ProjectV2 == nil {
+		return nil, resp, &GraphQLError{Type: "NOT_FOUND", Message: "project not found"}
+	}
+
+	return data.RepositoryOwner.ProjectV2.toProjectV2(), resp, nil
+}
+
+// ListProjectItems lists the items of the project with the given node ID.
+//
+// Only opts.First, which defaults to 30 and may be at most 100, and
+// opts.After are used. To get the next page, set opts.After to the
+// response's After field, which is empty after the last page.
+//
+// GitHub API docs: https://docs.github.com/graphql/guides/forming-calls-with-graphql
+//
+//meta:operation POST /graphql
+func (s *ProjectsV2Service) ListProjectItems(ctx context.Context, projectID string, opts *ListCursorOptions) ([]*ProjectV2Item, *Response, error) {
+	query := `query($project: ID!, $first: Int!, $after: String) {
+  node(id: $project) {
+    ... on ProjectV2 {
+      items(first: $first, after: $after) {
+        nodes { ...itemFields }
+        pageInfo { hasNextPage endCursor }
+      }
+    }
+  }
+}` + projectV2ItemFields
+
+	variables := map[string]interface{}{"project": projectID, "first": 30}
+	if opts != nil {
+		if opts.First > 0 {
+			variables["first"] = opts.First
+		}
+		if opts.After != "" {
+			variables["after"] = opts.After
+		}
+	}
+
+	var data struct {
+		Node *struct {
+			Items *struct {
+				Nodes    []*graphQLProjectV2Item `json:"nodes"`
+				PageInfo struct {
+					HasNextPage bool   `json:"hasNextPage"`
+					EndCursor   string `json:"endCursor"`
+				} `json:"pageInfo"`
+			} `json:"items"`
+		} `json:"node"`
+	}
+	resp, err := s.client.graphQL(ctx, query, variables, &data)
+	if err != nil {
+		return nil, resp, err
+	}
+	if data.Node == nil || data.Node.Items == nil {
+		return nil, resp, &GraphQLError{Type: "NOT_FOUND", Message: "project not found"}
+	}
+
+	items := make([]*ProjectV2Item, 0, len(data.Node.Items.Nodes))
+	for _, node := range data.Node.Items.Nodes {
+		items = append(items, node.toProjectV2Item())
+	}
+	if data.Node.Items.PageInfo.HasNextPage {
+		resp.After = data.Node.Items.PageInfo.EndCursor
+	}
+
+	return items, resp, nil
+}
+
+// AddItem adds the issue or pull request with the given node ID to the
+// project with the given node ID. Adding an item that is already in the
+// project returns the existing item.
+//
+// GitHub API docs: https://docs.github.com/graphql/guides/forming-calls-with-graphql
+//
+//meta:operation POST /graphql
+func (s *ProjectsV2Service) AddItem(ctx context.Context, projectID, contentID string) (*ProjectV2Item, *Response, error) {
+	query := `mutation($project: ID!, $content: ID!) {
+  addProjectV2ItemById(input: {projectId: $project, contentId: $content}) {
+    item { ...itemFields }
+  }
+}` + projectV2ItemFields
+
+	var data struct {
+		AddProjectV2ItemByID struct {
+			Item *graphQLProjectV2Item `json:"item"`
+		} `json:"addProjectV2ItemById"`
+	}
+	resp, err := s.client.graphQL(ctx, query, map[string]interface{}{"project": projectID, "content": contentID}, &data)
+	if err != nil {
+		return nil, resp, err
+	}
+	if data.AddProjectV2ItemByID.Item == nil {
+		return nil, resp, errors.New("graphql: addProjectV2ItemById returned no item")
+	}
+
+	return data.AddProjectV2ItemByID.Item.toProjectV2Item(), resp, nil
+}
+
+// UpdateItemField sets the field with the given node ID of a project item to
+// value. Exactly one field of value must be set.
+//
+// GitHub API docs: https://docs.github.com/graphql/guides/forming-calls-with-graphql
+//
+//meta:operation POST /graphql
+func (s *ProjectsV2Service) UpdateItemField(ctx context.Context, projectID, itemID, fieldID string, value *ProjectV2FieldValue) (*ProjectV2Item, *Response, error) {
+	if value.count() != 1 {
+		return nil, nil, errors.New("exactly one field of the project field value must be set")
+	}
+
+	query := `mutation($project: ID!, $item: ID!, $field: ID!, $value: ProjectV2FieldValue!) {
+  updateProjectV2ItemFieldValue(input: {projectId: $project, itemId: $item, fieldId: $field, value: $value}) {
+    projectV2Item { ...itemFields }
+  }
+}` + projectV2ItemFields
+
+	variables := map[string]interface{}{"project": projectID, "item": itemID, "field": fieldID, "value": value}
+	var data struct {
+		UpdateProjectV2ItemFieldValue struct {
+			ProjectV2Item *graphQLProjectV2Item `json:"projectV2Item"`
+		} `json:"updateProjectV2ItemFieldValue"`
+	}
+	resp, err := s.client.graphQL(ctx, query, variables, &data)
+	if err != nil {
+		return nil, resp, err
+	}
+	if data.UpdateProjectV2ItemFieldValue.ProjectV2Item == nil {
+		return nil, resp, errors.New("graphql: updateProjectV2ItemFieldValue returned no item")
+	}
+
+	return data.UpdateProjectV2ItemFieldValue.ProjectV2Item.toProjectV2Item(), resp, nil
+}
+
+// count returns the number of fields of v that are set.
+func (v *ProjectV2FieldValue) count() int {
+	if v == nil {
+		return 0
+	}
+	n := 0
+	for _, set := range []bool{v.Text != nil, v.Number != nil, v.Date != nil, v.SingleSelectOptionID != nil, v.IterationID != nil} {
+		if set {
+			n++
+		}
+	}
+	return n
+}
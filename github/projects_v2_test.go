@@ -0,0 +1,188 @@
+// Copyright 2026 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// testGraphQLVariables decodes a GraphQL request and checks that its query
+// contains query and that its variables are want.
+func testGraphQLVariables(t *testing.T, r *http.Request, query string, want map[string]interface{}) {
+	t.Helper()
+	var body struct {
+		Query     string                 `json:"query"`
+		Variables map[string]interface{} `json:"variables"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		t.Fatalf("decoding GraphQL request: %v", err)
+	}
+	if !strings.Contains(body.Query, query) {
+		t.Errorf("GraphQL query %q does not contain %q", body.Query, query)
+	}
+	if !cmp.Equal(body.Variables, want) {
+		t.Errorf("GraphQL variables = %+v, want %+v", body.Variables, want)
+	}
+}
+
+const testProjectV2ItemJSON = `{
+	"id": "PVTI_1",
+	"databaseId": 7,
+	"createdAt": "2026-01-02T03:04:05Z",
+	"creator": {"login": "u"},
+	"project": {"id": "PVT_1"},
+	"content": {"__typename": "Issue", "id": "I_1"}
+}`
+
+var testProjectV2Item = &ProjectV2Item{
+	ID:            Ptr(int64(7)),
+	NodeID:        Ptr("PVTI_1"),
+	ProjectNodeID: Ptr("PVT_1"),
+	ContentNodeID: Ptr("I_1"),
+	ContentType:   Ptr("Issue"),
+	Creator:       &User{Login: Ptr("u")},
+	CreatedAt:     &Timestamp{time.Date(2026, time.January, 2, 3, 4, 5, 0, time.UTC)},
+}
+
+func TestProjectsV2Service_GetProject(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testGraphQLVariables(t, r, "projectV2(number: $number)", map[string]interface{}{"owner": "o", "number": float64(1)})
+		fmt.Fprint(w, `{"data":{"repositoryOwner":{"projectV2":{
+			"id": "PVT_1",
+			"databaseId": 5,
+			"number": 1,
+			"title": "t",
+			"public": true,
+			"closed": false,
+			"url": "https://github.com/orgs/o/projects/1"
+		}}}}`)
+	})
+
+	ctx := context.Background()
+	project, _, err := client.ProjectsV2.GetProject(ctx, "o", 1)
+	if err != nil {
+		t.Fatalf("ProjectsV2.GetProject returned error: %v", err)
+	}
+	want := &ProjectV2{
+		ID:      Ptr(int64(5)),
+		NodeID:  Ptr("PVT_1"),
+		Number:  Ptr(1),
+		Title:   Ptr("t"),
+		Public:  Ptr(true),
+		State:   Ptr("open"),
+		HTMLURL: Ptr("https://github.com/orgs/o/projects/1"),
+	}
+	if !cmp.Equal(project, want) {
+		t.Errorf("ProjectsV2.GetProject returned %+v, want %+v", project, want)
+	}
+}
+
+func TestProjectsV2Service_GetProject_notFound(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data":{"repositoryOwner":{"projectV2":null}}}`)
+	})
+
+	ctx := context.Background()
+	if _, _, err := client.ProjectsV2.GetProject(ctx, "o", 1); err == nil {
+		t.Error("ProjectsV2.GetProject returned nil error")
+	}
+}
+
+func TestProjectsV2Service_ListProjectItems(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testGraphQLVariables(t, r, "items(first: $first, after: $after)", map[string]interface{}{"project": "PVT_1", "first": float64(2), "after": "c1"})
+		fmt.Fprintf(w, `{"data":{"node":{"items":{
+			"nodes": [%v, {"id": "PVTI_2", "databaseId": 8, "project": {"id": "PVT_1"}, "content": null}],
+			"pageInfo": {"hasNextPage": true, "endCursor": "c2"}
+		}}}}`, testProjectV2ItemJSON)
+	})
+
+	ctx := context.Background()
+	items, resp, err := client.ProjectsV2.ListProjectItems(ctx, "PVT_1", &ListCursorOptions{First: 2, After: "c1"})
+	if err != nil {
+		t.Fatalf("ProjectsV2.ListProjectItems returned error: %v", err)
+	}
+	want := []*ProjectV2Item{
+		testProjectV2Item,
+		{ID: Ptr(int64(8)), NodeID: Ptr("PVTI_2"), ProjectNodeID: Ptr("PVT_1")},
+	}
+	if !cmp.Equal(items, want) {
+		t.Errorf("ProjectsV2.ListProjectItems returned %+v, want %+v", items, want)
+	}
+	if got, want := resp.After, "c2"; got != want {
+		t.Errorf("ProjectsV2.ListProjectItems After = %q, want %q", got, want)
+	}
+}
+
+func TestProjectsV2Service_AddItem(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testGraphQLVariables(t, r, "addProjectV2ItemById", map[string]interface{}{"project": "PVT_1", "content": "I_1"})
+		fmt.Fprintf(w, `{"data":{"addProjectV2ItemById":{"item":%v}}}`, testProjectV2ItemJSON)
+	})
+
+	ctx := context.Background()
+	item, _, err := client.ProjectsV2.AddItem(ctx, "PVT_1", "I_1")
+	if err != nil {
+		t.Fatalf("ProjectsV2.AddItem returned error: %v", err)
+	}
+	if !cmp.Equal(item, testProjectV2Item) {
+		t.Errorf("ProjectsV2.AddItem returned %+v, want %+v", item, testProjectV2Item)
+	}
+}
+
+func TestProjectsV2Service_UpdateItemField(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testGraphQLVariables(t, r, "updateProjectV2ItemFieldValue", map[string]interface{}{
+			"project": "PVT_1",
+			"item":    "PVTI_1",
+			"field":   "F_1",
+			"value":   map[string]interface{}{"singleSelectOptionId": "opt"},
+		})
+		fmt.Fprintf(w, `{"data":{"updateProjectV2ItemFieldValue":{"projectV2Item":%v}}}`, testProjectV2ItemJSON)
+	})
+
+	ctx := context.Background()
+	item, _, err := client.ProjectsV2.UpdateItemField(ctx, "PVT_1", "PVTI_1", "F_1", &ProjectV2FieldValue{SingleSelectOptionID: Ptr("opt")})
+	if err != nil {
+		t.Fatalf("ProjectsV2.UpdateItemField returned error: %v", err)
+	}
+	if !cmp.Equal(item, testProjectV2Item) {
+		t.Errorf("ProjectsV2.UpdateItemField returned %+v, want %+v", item, testProjectV2Item)
+	}
+
+	for _, value := range []*ProjectV2FieldValue{nil, {}, {Text: Ptr("a"), Number: Ptr(1.0)}} {
+		if _, _, err := client.ProjectsV2.UpdateItemField(ctx, "PVT_1", "PVTI_1", "F_1", value); err == nil {
+			t.Errorf("ProjectsV2.UpdateItemField(%+v) returned nil error", value)
+		}
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
 )
 
 // PullRequestsService handles communication with the pull request related
@@ -150,7 +151,7 @@ type PullRequestListOptions struct {
 //meta:operation GET /repos/{owner}/{repo}/pulls
 func (s *PullRequestsService) List(ctx context.Context, owner string, repo string, opts *PullRequestListOptions) ([]*PullRequest, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/pulls", owner, repo)
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -181,7 +182,7 @@ func (s *PullRequestsService) List(ctx context.Context, owner string, repo strin
 //meta:operation GET /repos/{owner}/{repo}/commits/{commit_sha}/pulls
 func (s *PullRequestsService) ListPullRequestsWithCommit(ctx context.Context, owner, repo, sha string, opts *ListOptions) ([]*PullRequest, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/commits/%v/pulls", owner, repo, sha)
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -396,7 +397,7 @@ func (s *PullRequestsService) Edit(ctx context.Context, owner string, repo strin
 //meta:operation GET /repos/{owner}/{repo}/pulls/{pull_number}/commits
 func (s *PullRequestsService) ListCommits(ctx context.Context, owner string, repo string, number int, opts *ListOptions) ([]*RepositoryCommit, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/pulls/%d/commits", owner, repo, number)
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -422,7 +423,7 @@ func (s *PullRequestsService) ListCommits(ctx context.Context, owner string, rep
 //meta:operation GET /repos/{owner}/{repo}/pulls/{pull_number}/files
 func (s *PullRequestsService) ListFiles(ctx context.Context, owner string, repo string, number int, opts *ListOptions) ([]*CommitFile, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/pulls/%d/files", owner, repo, number)
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -486,6 +487,8 @@ type pullRequestMergeRequest struct {
 
 // Merge a pull request.
 // commitMessage is an extra detail to append to automatic commit message.
+// An *InvalidEnumError is returned without making a request if
+// options.MergeMethod is not one of the values accepted by the API.
 //
 // GitHub API docs: https://docs.github.com/rest/pulls/pulls#merge-a-pull-request
 //
@@ -498,6 +501,9 @@ func (s *PullRequestsService) Merge(ctx context.Context, owner string, repo stri
 		pullRequestBody.CommitMessage = &commitMessage
 	}
 	if options != nil {
+		if err := validateEnum("merge_method", options.MergeMethod, "merge", "squash", "rebase"); err != nil {
+			return nil, nil, err
+		}
 		pullRequestBody.CommitTitle = options.CommitTitle
 		pullRequestBody.MergeMethod = options.MergeMethod
 		pullRequestBody.SHA = options.SHA
@@ -518,3 +524,45 @@ func (s *PullRequestsService) Merge(ctx context.Context, owner string, repo stri
 
 	return mergeResult, resp, nil
 }
+
+// WaitForMergeCommit waits until the commit with the given sha, typically the
+// SHA of a PullRequestMergeResult, can be retrieved from the repository, and
+// returns it. Merge returns as soon as the merge is accepted, but the merge
+// commit may take a moment to become visible, so operations that depend on it
+// (such as tagging it) can fail if issued immediately afterwards.
+//
+// GetCommit is polled as described by opts for as long as it responds with
+// 404 Not Found or 422 Unprocessable Entity; any other error is returned
+// immediately. If ctx is done or opts.Timeout elapses first, the context's
+// error is returned along with the last response. If the client's retry
+// budget runs out first, ErrRetryBudgetExhausted is returned, wrapping the
+// last 404 or 422 error.
+//
+// GitHub API docs: https://docs.github.com/rest/git/commits#get-a-commit-object
+//
+//meta:operation GET /repos/{owner}/{repo}/git/commits/{commit_sha}
+func (s *PullRequestsService) WaitForMergeCommit(ctx context.Context, owner, repo, sha string, opts WaitOptions) (*Commit, *Response, error) {
+	var commit *Commit
+	var resp *Response
+	var notFoundErr error
+	err := s.client.poll(ctx, opts, func(ctx context.Context) (bool, error) {
+		var err error
+		commit, resp, err = s.client.Git.GetCommit(ctx, owner, repo, sha)
+		if err == nil {
+			return true, nil
+		}
+		if isNotFound(err) || isErrorStatus(err, http.StatusUnprocessableEntity) {
+			notFoundErr = err
+			return false, nil
+		}
+		return false, err
+	})
+	if errors.Is(err, ErrRetryBudgetExhausted) {
+		return nil, resp, fmt.Errorf("%w: %w", err, notFoundErr)
+	}
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return commit, resp, nil
+}
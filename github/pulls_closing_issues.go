@@ -0,0 +1,104 @@
+// Copyright 2026 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// IssueReference identifies an issue by its repository and number, such as
+// octo-org/octo-repo#100.
+type IssueReference struct {
+	Owner  string
+	Repo   string
+	Number int
+}
+
+func (r IssueReference) String() string {
+	return Stringify(r)
+}
+
+// closingKeywordRE matches a closing keyword followed by a reference to an
+// issue, either "#10", "owner/repo#10", or the URL of an issue on github.com.
+var closingKeywordRE = regexp.MustCompile(`(?i)(?:^|[^\w])(?:close[sd]?|fix(?:e[sd])?|resolve[sd]?):?\s+` +
+	`(?:#(\d+)|([\w.-]+)/([\w.-]+)#(\d+)|https://github\.com/([\w.-]+)/([\w.-]+)/issues/(\d+))\b`)
+
+// ClosingIssues returns the numbers of the issues in the base repository of
+// the pull request that its body links with a closing keyword, such as
+// "Closes #10" or "fixes: octo-org/octo-repo#100", in the order they first
+// appear. GitHub closes these issues when the pull request is merged into
+// the default branch. The keywords are close, closes, closed, fix, fixes,
+// fixed, resolve, resolves, and resolved, in any case. References in fenced
+// code blocks and code spans are ignored.
+//
+// References to issues in other repositories are returned by
+// CrossRepoClosingIssues.
+//
+// GitHub docs: https://docs.github.com/issues/tracking-your-work-with-issues/using-issues/linking-a-pull-request-to-an-issue#linking-a-pull-request-to-an-issue-using-a-keyword
+func (p *PullRequest) ClosingIssues() []int {
+	var numbers []int
+	seen := make(map[int]bool)
+	for _, ref := range p.closingIssueReferences() {
+		if ref.Owner != "" || seen[ref.Number] {
+			continue
+		}
+		seen[ref.Number] = true
+		numbers = append(numbers, ref.Number)
+	}
+	return numbers
+}
+
+// CrossRepoClosingIssues returns the issues in repositories other than the
+// base repository of the pull request that its body links with a closing
+// keyword, such as "Fixes octo-org/octo-repo#100", in the order they first
+// appear. See ClosingIssues.
+func (p *PullRequest) CrossRepoClosingIssues() []*IssueReference {
+	var refs []*IssueReference
+	seen := make(map[string]bool)
+	for _, ref := range p.closingIssueReferences() {
+		key := strings.ToLower(fmt.Sprintf("%v/%v#%v", ref.Owner, ref.Repo, ref.Number))
+		if ref.Owner == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		refs = append(refs, ref)
+	}
+	return refs
+}
+
+// closingIssueReferences returns every issue the body of p links with a
+// closing keyword. References to the base repository have an empty Owner and
+// Repo.
+func (p *PullRequest) closingIssueReferences() []*IssueReference {
+	baseRepo := strings.ToLower(p.GetBase().GetRepo().GetFullName())
+
+	var refs []*IssueReference
+	for _, line := range strings.Split(stripMarkdownCode(p.GetBody()), "\n") {
+		for _, m := range closingKeywordRE.FindAllStringSubmatch(line, -1) {
+			var ref IssueReference
+			switch {
+			case m[1] != "":
+				ref.Number, _ = strconv.Atoi(m[1])
+			case m[4] != "":
+				ref.Owner, ref.Repo = m[2], m[3]
+				ref.Number, _ = strconv.Atoi(m[4])
+			default:
+				ref.Owner, ref.Repo = m[5], m[6]
+				ref.Number, _ = strconv.Atoi(m[7])
+			}
+			if ref.Owner != "" && strings.ToLower(ref.Owner+"/"+ref.Repo) == baseRepo {
+				ref.Owner, ref.Repo = "", ""
+			}
+			if ref.Number > 0 {
+				refs = append(refs, &ref)
+			}
+		}
+	}
+	return refs
+}
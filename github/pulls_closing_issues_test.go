@@ -0,0 +1,57 @@
+// Copyright 2026 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestPullRequest_ClosingIssues(t *testing.T) {
+	t.Parallel()
+	pr := &PullRequest{
+		Base: &PullRequestBranch{Repo: &Repository{FullName: Ptr("o/r")}},
+		Body: Ptr("Closes #1, fixes: #2 and Resolved #3.\n" +
+			"FIXES O/R#4\n" +
+			"closes https://github.com/o/r/issues/5\n" +
+			"Fixes other/repo#6 and fixes https://github.com/x/y/issues/7\n" +
+			"See #8, prefixes #9, closes #1 again, closes#10\n" +
+			"Don't write `fixes #11` here.\n" +
+			"Nor ``a`b fixes #15``, but fixes #16.\n" +
+			"```\n" +
+			"closes #12\n" +
+			"```\n" +
+			"  ~~~go\n" +
+			"  fix #13\n" +
+			"  ~~~\n" +
+			"Fixed #14.\n" +
+			"closes other/repo#6 too\n"),
+	}
+
+	if got, want := pr.ClosingIssues(), []int{1, 2, 3, 4, 5, 16, 14}; !cmp.Equal(got, want) {
+		t.Errorf("ClosingIssues = %v, want %v", got, want)
+	}
+
+	want := []*IssueReference{
+		{Owner: "other", Repo: "repo", Number: 6},
+		{Owner: "x", Repo: "y", Number: 7},
+	}
+	if got := pr.CrossRepoClosingIssues(); !cmp.Equal(got, want) {
+		t.Errorf("CrossRepoClosingIssues = %v, want %v", got, want)
+	}
+}
+
+func TestPullRequest_ClosingIssues_empty(t *testing.T) {
+	t.Parallel()
+	var pr *PullRequest
+	if got := pr.ClosingIssues(); got != nil {
+		t.Errorf("ClosingIssues = %v, want nil", got)
+	}
+	if got := pr.CrossRepoClosingIssues(); got != nil {
+		t.Errorf("CrossRepoClosingIssues = %v, want nil", got)
+	}
+}
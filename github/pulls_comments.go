@@ -80,7 +80,7 @@ func (s *PullRequestsService) ListComments(ctx context.Context, owner, repo stri
 	} else {
 		u = fmt.Sprintf("repos/%v/%v/pulls/%d/comments", owner, repo, number)
 	}
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -8,11 +8,13 @@ package github
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 )
@@ -1591,3 +1593,92 @@ func TestPullRequest_Marshal(t *testing.T) {
 
 	testJSONMarshal(t, u, want)
 }
+
+func TestPullRequestsService_WaitForMergeCommit(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	calls := 0
+	mux.HandleFunc("/repos/o/r/git/commits/s", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		calls++
+		if calls < 3 {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		fmt.Fprint(w, `{"sha":"s","message":"m"}`)
+	})
+
+	ctx := context.Background()
+	commit, _, err := client.PullRequests.WaitForMergeCommit(ctx, "o", "r", "s", WaitOptions{Interval: time.Millisecond})
+	if err != nil {
+		t.Fatalf("PullRequests.WaitForMergeCommit returned error: %v", err)
+	}
+
+	want := &Commit{SHA: Ptr("s"), Message: Ptr("m")}
+	if !cmp.Equal(commit, want) {
+		t.Errorf("PullRequests.WaitForMergeCommit returned %+v, want %+v", commit, want)
+	}
+	if calls != 3 {
+		t.Errorf("PullRequests.WaitForMergeCommit polled %v times, want 3", calls)
+	}
+}
+
+func TestPullRequestsService_WaitForMergeCommit_timeout(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/git/commits/s", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+	})
+
+	ctx := context.Background()
+	_, _, err := client.PullRequests.WaitForMergeCommit(ctx, "o", "r", "s", WaitOptions{Interval: time.Millisecond, Timeout: 20 * time.Millisecond})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("PullRequests.WaitForMergeCommit returned error %v, want %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestPullRequestsService_WaitForMergeCommit_retryBudget(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+	client = client.WithRetryBudget(1, time.Hour)
+
+	calls := 0
+	mux.HandleFunc("/repos/o/r/git/commits/s", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	ctx := context.Background()
+	_, _, err := client.PullRequests.WaitForMergeCommit(ctx, "o", "r", "s", WaitOptions{Interval: time.Millisecond})
+	if !errors.Is(err, ErrRetryBudgetExhausted) || !isNotFound(err) {
+		t.Errorf("PullRequests.WaitForMergeCommit returned error %v, want ErrRetryBudgetExhausted wrapping a 404", err)
+	}
+	if calls != 2 {
+		t.Errorf("PullRequests.WaitForMergeCommit polled %v times, want 2", calls)
+	}
+}
+
+func TestPullRequestsService_WaitForMergeCommit_error(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	calls := 0
+	mux.HandleFunc("/repos/o/r/git/commits/s", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusForbidden)
+	})
+
+	ctx := context.Background()
+	_, resp, err := client.PullRequests.WaitForMergeCommit(ctx, "o", "r", "s", WaitOptions{Interval: time.Millisecond})
+	if err == nil {
+		t.Fatal("PullRequests.WaitForMergeCommit returned nil error, want error")
+	}
+	if resp.StatusCode != http.StatusForbidden {
+		t.Errorf("PullRequests.WaitForMergeCommit returned status %v, want %v", resp.StatusCode, http.StatusForbidden)
+	}
+	if calls != 1 {
+		t.Errorf("PullRequests.WaitForMergeCommit polled %v times, want 1", calls)
+	}
+}
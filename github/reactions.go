@@ -47,6 +47,23 @@ func (r Reaction) String() string {
 	return Stringify(r)
 }
 
+// reactionContents are the reaction types accepted on commit comments,
+// issues, issue comments, pull request review comments and team discussions.
+var reactionContents = []string{"+1", "-1", "laugh", "confused", "heart", "hooray", "rocket", "eyes"}
+
+// releaseReactionContents are the reaction types accepted on releases.
+var releaseReactionContents = []string{"+1", "laugh", "heart", "hooray", "rocket", "eyes"}
+
+// validateReactionContent returns an *InvalidEnumError if content is not one
+// of allowed. Unlike validateEnum, an empty content is also rejected, since
+// the create endpoints require it.
+func validateReactionContent(content string, allowed ...string) error {
+	if content == "" {
+		return &InvalidEnumError{Field: "content", Value: content, Allowed: allowed}
+	}
+	return validateEnum("content", content, allowed...)
+}
+
 // ListCommentReactionOptions specifies the optional parameters to the
 // ReactionsService.ListCommentReactions method.
 type ListCommentReactionOptions struct {
@@ -58,6 +75,17 @@ type ListCommentReactionOptions struct {
 	ListOptions
 }
 
+// ListReleaseReactionOptions specifies the optional parameters to the
+// ReactionsService.ListReleaseReactions method.
+type ListReleaseReactionOptions struct {
+	// Content restricts the returned release reactions to only those with the given type.
+	// Omit this parameter to list all reactions to a release.
+	// Possible values are: "+1", "laugh", "heart", "hooray", "rocket", or "eyes".
+	Content string `url:"content,omitempty"`
+
+	ListOptions
+}
+
 // ListCommentReactions lists the reactions for a commit comment.
 //
 // GitHub API docs: https://docs.github.com/rest/reactions/reactions#list-reactions-for-a-commit-comment
@@ -65,7 +93,7 @@ type ListCommentReactionOptions struct {
 //meta:operation GET /repos/{owner}/{repo}/comments/{comment_id}/reactions
 func (s *ReactionsService) ListCommentReactions(ctx context.Context, owner, repo string, id int64, opts *ListCommentReactionOptions) ([]*Reaction, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/comments/%v/reactions", owner, repo, id)
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -98,6 +126,9 @@ func (s *ReactionsService) ListCommentReactions(ctx context.Context, owner, repo
 func (s *ReactionsService) CreateCommentReaction(ctx context.Context, owner, repo string, id int64, content string) (*Reaction, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/comments/%v/reactions", owner, repo, id)
 
+	if err := validateReactionContent(content, reactionContents...); err != nil {
+		return nil, nil, err
+	}
 	body := &Reaction{Content: Ptr(content)}
 	req, err := s.client.NewRequest("POST", u, body)
 	if err != nil {
@@ -145,7 +176,7 @@ func (s *ReactionsService) DeleteCommentReactionByID(ctx context.Context, repoID
 //meta:operation GET /repos/{owner}/{repo}/issues/{issue_number}/reactions
 func (s *ReactionsService) ListIssueReactions(ctx context.Context, owner, repo string, number int, opts *ListOptions) ([]*Reaction, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/issues/%v/reactions", owner, repo, number)
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -178,6 +209,9 @@ func (s *ReactionsService) ListIssueReactions(ctx context.Context, owner, repo s
 func (s *ReactionsService) CreateIssueReaction(ctx context.Context, owner, repo string, number int, content string) (*Reaction, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/issues/%v/reactions", owner, repo, number)
 
+	if err := validateReactionContent(content, reactionContents...); err != nil {
+		return nil, nil, err
+	}
 	body := &Reaction{Content: Ptr(content)}
 	req, err := s.client.NewRequest("POST", u, body)
 	if err != nil {
@@ -225,7 +259,7 @@ func (s *ReactionsService) DeleteIssueReactionByID(ctx context.Context, repoID,
 //meta:operation GET /repos/{owner}/{repo}/issues/comments/{comment_id}/reactions
 func (s *ReactionsService) ListIssueCommentReactions(ctx context.Context, owner, repo string, id int64, opts *ListOptions) ([]*Reaction, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/issues/comments/%v/reactions", owner, repo, id)
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -258,6 +292,9 @@ func (s *ReactionsService) ListIssueCommentReactions(ctx context.Context, owner,
 func (s *ReactionsService) CreateIssueCommentReaction(ctx context.Context, owner, repo string, id int64, content string) (*Reaction, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/issues/comments/%v/reactions", owner, repo, id)
 
+	if err := validateReactionContent(content, reactionContents...); err != nil {
+		return nil, nil, err
+	}
 	body := &Reaction{Content: Ptr(content)}
 	req, err := s.client.NewRequest("POST", u, body)
 	if err != nil {
@@ -305,7 +342,7 @@ func (s *ReactionsService) DeleteIssueCommentReactionByID(ctx context.Context, r
 //meta:operation GET /repos/{owner}/{repo}/pulls/comments/{comment_id}/reactions
 func (s *ReactionsService) ListPullRequestCommentReactions(ctx context.Context, owner, repo string, id int64, opts *ListOptions) ([]*Reaction, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/pulls/comments/%v/reactions", owner, repo, id)
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -338,6 +375,9 @@ func (s *ReactionsService) ListPullRequestCommentReactions(ctx context.Context,
 func (s *ReactionsService) CreatePullRequestCommentReaction(ctx context.Context, owner, repo string, id int64, content string) (*Reaction, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/pulls/comments/%v/reactions", owner, repo, id)
 
+	if err := validateReactionContent(content, reactionContents...); err != nil {
+		return nil, nil, err
+	}
 	body := &Reaction{Content: Ptr(content)}
 	req, err := s.client.NewRequest("POST", u, body)
 	if err != nil {
@@ -385,7 +425,7 @@ func (s *ReactionsService) DeletePullRequestCommentReactionByID(ctx context.Cont
 //meta:operation GET /teams/{team_id}/discussions/{discussion_number}/reactions
 func (s *ReactionsService) ListTeamDiscussionReactions(ctx context.Context, teamID int64, discussionNumber int, opts *ListOptions) ([]*Reaction, *Response, error) {
 	u := fmt.Sprintf("teams/%v/discussions/%v/reactions", teamID, discussionNumber)
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -415,6 +455,9 @@ func (s *ReactionsService) ListTeamDiscussionReactions(ctx context.Context, team
 func (s *ReactionsService) CreateTeamDiscussionReaction(ctx context.Context, teamID int64, discussionNumber int, content string) (*Reaction, *Response, error) {
 	u := fmt.Sprintf("teams/%v/discussions/%v/reactions", teamID, discussionNumber)
 
+	if err := validateReactionContent(content, reactionContents...); err != nil {
+		return nil, nil, err
+	}
 	body := &Reaction{Content: Ptr(content)}
 	req, err := s.client.NewRequest("POST", u, body)
 	if err != nil {
@@ -461,7 +504,7 @@ func (s *ReactionsService) DeleteTeamDiscussionReactionByOrgIDAndTeamID(ctx cont
 //meta:operation GET /teams/{team_id}/discussions/{discussion_number}/comments/{comment_number}/reactions
 func (s *ReactionsService) ListTeamDiscussionCommentReactions(ctx context.Context, teamID int64, discussionNumber, commentNumber int, opts *ListOptions) ([]*Reaction, *Response, error) {
 	u := fmt.Sprintf("teams/%v/discussions/%v/comments/%v/reactions", teamID, discussionNumber, commentNumber)
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -490,6 +533,9 @@ func (s *ReactionsService) ListTeamDiscussionCommentReactions(ctx context.Contex
 func (s *ReactionsService) CreateTeamDiscussionCommentReaction(ctx context.Context, teamID int64, discussionNumber, commentNumber int, content string) (*Reaction, *Response, error) {
 	u := fmt.Sprintf("teams/%v/discussions/%v/comments/%v/reactions", teamID, discussionNumber, commentNumber)
 
+	if err := validateReactionContent(content, reactionContents...); err != nil {
+		return nil, nil, err
+	}
 	body := &Reaction{Content: Ptr(content)}
 	req, err := s.client.NewRequest("POST", u, body)
 	if err != nil {
@@ -541,10 +587,38 @@ func (s *ReactionsService) deleteReaction(ctx context.Context, url string) (*Res
 	return s.client.Do(ctx, req, nil)
 }
 
+// ListReleaseReactions lists the reactions for a release.
+//
+// GitHub API docs: https://docs.github.com/rest/reactions/reactions#list-reactions-for-a-release
+//
+//meta:operation GET /repos/{owner}/{repo}/releases/{release_id}/reactions
+func (s *ReactionsService) ListReleaseReactions(ctx context.Context, owner, repo string, releaseID int64, opts *ListReleaseReactionOptions) ([]*Reaction, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/releases/%v/reactions", owner, repo, releaseID)
+	u, err := s.client.addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req.Header.Set("Accept", mediaTypeReactionsPreview)
+
+	var m []*Reaction
+	resp, err := s.client.Do(ctx, req, &m)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return m, resp, nil
+}
+
 // CreateReleaseReaction creates a reaction to a release.
 // Note that a response with a Status: 200 OK means that you already
 // added the reaction type to this release.
-// The content should have one of the following values: "+1", "-1", "laugh", "confused", "heart", "hooray", "rocket", or "eyes".
+// The content should have one of the following values: "+1", "laugh", "heart", "hooray", "rocket", or "eyes".
 //
 // GitHub API docs: https://docs.github.com/rest/reactions/reactions#create-reaction-for-a-release
 //
@@ -552,6 +626,9 @@ func (s *ReactionsService) deleteReaction(ctx context.Context, url string) (*Res
 func (s *ReactionsService) CreateReleaseReaction(ctx context.Context, owner, repo string, releaseID int64, content string) (*Reaction, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/releases/%v/reactions", owner, repo, releaseID)
 
+	if err := validateReactionContent(content, releaseReactionContents...); err != nil {
+		return nil, nil, err
+	}
 	body := &Reaction{Content: Ptr(content)}
 	req, err := s.client.NewRequest("POST", u, body)
 	if err != nil {
@@ -568,3 +645,14 @@ func (s *ReactionsService) CreateReleaseReaction(ctx context.Context, owner, rep
 
 	return m, resp, nil
 }
+
+// DeleteReleaseReaction deletes the reaction to a release.
+//
+// GitHub API docs: https://docs.github.com/rest/reactions/reactions#delete-a-release-reaction
+//
+//meta:operation DELETE /repos/{owner}/{repo}/releases/{release_id}/reactions/{reaction_id}
+func (s *ReactionsService) DeleteReleaseReaction(ctx context.Context, owner, repo string, releaseID, reactionID int64) (*Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/releases/%v/reactions/%v", owner, repo, releaseID, reactionID)
+
+	return s.deleteReaction(ctx, u)
+}
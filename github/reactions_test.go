@@ -7,6 +7,7 @@ package github
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"testing"
@@ -908,3 +909,125 @@ func TestReactionService_CreateReleaseReaction(t *testing.T) {
 		return resp, err
 	})
 }
+
+func TestReactionsService_ListReleaseReactions(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/releases/1/reactions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", mediaTypeReactionsPreview)
+		testFormValues(t, r, values{"content": "heart", "page": "2"})
+
+		assertWrite(t, w, []byte(`[{"id":1,"user":{"login":"l","id":2},"content":"heart"}]`))
+	})
+
+	opt := &ListReleaseReactionOptions{Content: "heart", ListOptions: ListOptions{Page: 2}}
+	ctx := context.Background()
+	got, _, err := client.Reactions.ListReleaseReactions(ctx, "o", "r", 1, opt)
+	if err != nil {
+		t.Errorf("ListReleaseReactions returned error: %v", err)
+	}
+	want := []*Reaction{{ID: Ptr(int64(1)), User: &User{Login: Ptr("l"), ID: Ptr(int64(2))}, Content: Ptr("heart")}}
+	if !cmp.Equal(got, want) {
+		t.Errorf("ListReleaseReactions = %+v, want %+v", got, want)
+	}
+
+	const methodName = "ListReleaseReactions"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Reactions.ListReleaseReactions(ctx, "\n", "\n", -1, opt)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Reactions.ListReleaseReactions(ctx, "o", "r", 1, opt)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestReactionsService_DeleteReleaseReaction(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/releases/1/reactions/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testHeader(t, r, "Accept", mediaTypeReactionsPreview)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	if _, err := client.Reactions.DeleteReleaseReaction(ctx, "o", "r", 1, 2); err != nil {
+		t.Errorf("DeleteReleaseReaction returned error: %v", err)
+	}
+
+	const methodName = "DeleteReleaseReaction"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Reactions.DeleteReleaseReaction(ctx, "\n", "\n", -1, -2)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Reactions.DeleteReleaseReaction(ctx, "o", "r", 1, 2)
+	})
+}
+
+func TestReactionsService_invalidContent(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/", func(_ http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %v %v", r.Method, r.URL.Path)
+	})
+
+	ctx := context.Background()
+	tests := []struct {
+		name    string
+		content string
+		create  func(content string) error
+	}{
+		{"CreateCommentReaction", "thumbsup", func(c string) error {
+			_, _, err := client.Reactions.CreateCommentReaction(ctx, "o", "r", 1, c)
+			return err
+		}},
+		{"CreateIssueReaction", "", func(c string) error {
+			_, _, err := client.Reactions.CreateIssueReaction(ctx, "o", "r", 1, c)
+			return err
+		}},
+		{"CreateIssueCommentReaction", "+2", func(c string) error {
+			_, _, err := client.Reactions.CreateIssueCommentReaction(ctx, "o", "r", 1, c)
+			return err
+		}},
+		{"CreatePullRequestCommentReaction", "Heart", func(c string) error {
+			_, _, err := client.Reactions.CreatePullRequestCommentReaction(ctx, "o", "r", 1, c)
+			return err
+		}},
+		{"CreateTeamDiscussionReaction", "smile", func(c string) error {
+			_, _, err := client.Reactions.CreateTeamDiscussionReaction(ctx, 1, 2, c)
+			return err
+		}},
+		{"CreateTeamDiscussionCommentReaction", "tada", func(c string) error {
+			_, _, err := client.Reactions.CreateTeamDiscussionCommentReaction(ctx, 1, 2, 3, c)
+			return err
+		}},
+		{"CreateReleaseReaction", "confused", func(c string) error {
+			_, _, err := client.Reactions.CreateReleaseReaction(ctx, "o", "r", 1, c)
+			return err
+		}},
+	}
+
+	for _, tt := range tests {
+		err := tt.create(tt.content)
+		var enumErr *InvalidEnumError
+		if !errors.As(err, &enumErr) {
+			t.Errorf("%v(%q) returned error %v, want *InvalidEnumError", tt.name, tt.content, err)
+			continue
+		}
+		if enumErr.Field != "content" || enumErr.Value != tt.content {
+			t.Errorf("%v(%q) returned %+v", tt.name, tt.content, enumErr)
+		}
+	}
+}
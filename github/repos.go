@@ -12,13 +12,87 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"sort"
 	"strings"
+	"sync"
 )
 
 const githubBranchNotProtected string = "Branch not protected"
 
 var ErrBranchNotProtected = errors.New("branch is not protected")
 
+// ErrEmptyRepository is matched, using errors.Is, by the *ErrorResponse
+// returned by GitService.GetRef, GitService.ListMatchingRefs,
+// RepositoriesService.GetContents, RepositoriesService.DownloadContents,
+// RepositoriesService.DownloadContentsWithMeta and
+// RepositoriesService.CreateOrUpdateFiles when the repository has no commits
+// yet. See RepositoriesService.IsEmpty to check for this beforehand.
+var ErrEmptyRepository = errors.New("repository is empty")
+
+// markEmptyRepository marks err as matching ErrEmptyRepository if it is an
+// error response with the given status code whose message reports an empty
+// repository, and returns it. The Git database endpoints respond with 409
+// Conflict and "Git Repository is empty.", and the contents endpoints with 404
+// Not Found and "This repository is empty.".
+func markEmptyRepository(err error, status int) error {
+	var errResp *ErrorResponse
+	if errors.As(err, &errResp) && errResp.Response != nil && errResp.Response.StatusCode == status &&
+		strings.Contains(strings.ToLower(errResp.Message), "repository is empty") {
+		errResp.emptyRepository = true
+	}
+	return err
+}
+
+// InvalidSlugError is returned for a malformed "owner/repo" repository name,
+// by SplitFullName, and by NewRequest when a full name was passed as the owner
+// of a repository along with an empty repository name, such as in
+// Repositories.Get(ctx, "owner/repo", "").
+type InvalidSlugError struct {
+	Slug   string // The malformed name.
+	Reason string // Why it is malformed.
+}
+
+func (e *InvalidSlugError) Error() string {
+	return fmt.Sprintf("invalid repository name %q: %v", e.Slug, e.Reason)
+}
+
+// SplitFullName splits the full name of a repository, such as
+// "google/go-github", into its owner and repository name, as taken by the
+// methods of RepositoriesService and most other services. It returns an
+// *InvalidSlugError unless full has exactly two non-empty parts.
+func SplitFullName(full string) (owner, repo string, err error) {
+	owner, repo, ok := strings.Cut(full, "/")
+	switch {
+	case !ok:
+		return "", "", &InvalidSlugError{Slug: full, Reason: `want "owner/repo"`}
+	case owner == "" || repo == "":
+		return "", "", &InvalidSlugError{Slug: full, Reason: "owner and repository name must not be empty"}
+	case strings.Contains(repo, "/"):
+		return "", "", &InvalidSlugError{Slug: full, Reason: "too many slashes"}
+	}
+	return owner, repo, nil
+}
+
+// checkRepoSlug returns an *InvalidSlugError if urlStr, a URL relative to the
+// base URL, is of a repository endpoint but was built with a full
+// "owner/repo" name as the owner and an empty repository name. That yields
+// URLs such as "repos/owner/repo/" or "repos/owner/repo//branches", which
+// would otherwise fail with a confusing 404.
+func checkRepoSlug(urlStr string) error {
+	rest, ok := strings.CutPrefix(urlStr, "repos/")
+	if !ok {
+		return nil
+	}
+	parts := strings.SplitN(rest, "/", 3)
+	if len(parts) == 3 && parts[0] != "" && parts[1] != "" && (parts[2] == "" || strings.HasPrefix(parts[2], "/")) {
+		return &InvalidSlugError{
+			Slug:   parts[0] + "/" + parts[1],
+			Reason: "a full name was passed as the owner with an empty repository name; see SplitFullName",
+		}
+	}
+	return nil
+}
+
 // RepositoriesService handles communication with the repository related
 // methods of the GitHub API.
 //
@@ -317,7 +391,7 @@ type RepositoryListByUserOptions struct {
 //meta:operation GET /users/{username}/repos
 func (s *RepositoriesService) ListByUser(ctx context.Context, user string, opts *RepositoryListByUserOptions) ([]*Repository, *Response, error) {
 	u := fmt.Sprintf("users/%v/repos", user)
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -380,7 +454,7 @@ type RepositoryListByAuthenticatedUserOptions struct {
 //meta:operation GET /user/repos
 func (s *RepositoriesService) ListByAuthenticatedUser(ctx context.Context, opts *RepositoryListByAuthenticatedUserOptions) ([]*Repository, *Response, error) {
 	u := "user/repos"
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -424,7 +498,7 @@ type RepositoryListByOrgOptions struct {
 //meta:operation GET /orgs/{org}/repos
 func (s *RepositoriesService) ListByOrg(ctx context.Context, org string, opts *RepositoryListByOrgOptions) ([]*Repository, *Response, error) {
 	u := fmt.Sprintf("orgs/%v/repos", org)
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -460,7 +534,7 @@ type RepositoryListAllOptions struct {
 //
 //meta:operation GET /repositories
 func (s *RepositoriesService) ListAll(ctx context.Context, opts *RepositoryListAllOptions) ([]*Repository, *Response, error) {
-	u, err := addOptions("repositories", opts)
+	u, err := s.client.addOptions("repositories", opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -530,6 +604,9 @@ type createRepoRequest struct {
 // changes propagate throughout its servers. You may set up a loop with
 // exponential back-off to verify repository's creation.
 //
+// If an enum field of repo, such as Visibility, is set to a value the
+// API does not accept, an *InvalidEnumError is returned without making a request.
+//
 // GitHub API docs: https://docs.github.com/rest/repos/repos#create-a-repository-for-the-authenticated-user
 // GitHub API docs: https://docs.github.com/rest/repos/repos#create-an-organization-repository
 //
@@ -543,6 +620,10 @@ func (s *RepositoriesService) Create(ctx context.Context, org string, repo *Repo
 		u = "user/repos"
 	}
 
+	if err := validateRepositoryEnums(repo); err != nil {
+		return nil, nil, err
+	}
+
 	repoReq := &createRepoRequest{
 		Name:                      repo.Name,
 		Description:               repo.Description,
@@ -702,10 +783,17 @@ func (s *RepositoriesService) GetByID(ctx context.Context, id int64) (*Repositor
 
 // Edit updates a repository.
 //
+// If an enum field of repository, such as Visibility, is set to a value the
+// API does not accept, an *InvalidEnumError is returned without making a request.
+//
 // GitHub API docs: https://docs.github.com/rest/repos/repos#update-a-repository
 //
 //meta:operation PATCH /repos/{owner}/{repo}
 func (s *RepositoriesService) Edit(ctx context.Context, owner, repo string, repository *Repository) (*Repository, *Response, error) {
+	if err := validateRepositoryEnums(repository); err != nil {
+		return nil, nil, err
+	}
+
 	u := fmt.Sprintf("repos/%v/%v", owner, repo)
 	req, err := s.client.NewRequest("PATCH", u, repository)
 	if err != nil {
@@ -832,6 +920,8 @@ func (s *RepositoriesService) DisableVulnerabilityAlerts(ctx context.Context, ow
 }
 
 // GetAutomatedSecurityFixes checks if the automated security fixes for a repository are enabled.
+// GitHub responds with 404 Not Found when they are not enabled; this is reported as a
+// result with Enabled and Paused set to false rather than as an error.
 //
 // GitHub API docs: https://docs.github.com/rest/repos/repos#check-if-automated-security-fixes-are-enabled-for-a-repository
 //
@@ -846,9 +936,13 @@ func (s *RepositoriesService) GetAutomatedSecurityFixes(ctx context.Context, own
 
 	p := new(AutomatedSecurityFixes)
 	resp, err := s.client.Do(ctx, req, p)
+	enabled, err := parseBoolResponse(err)
 	if err != nil {
 		return nil, resp, err
 	}
+	if !enabled {
+		return &AutomatedSecurityFixes{Enabled: Ptr(false), Paused: Ptr(false)}, resp, nil
+	}
 	return p, resp, nil
 }
 
@@ -891,7 +985,7 @@ func (s *RepositoriesService) DisableAutomatedSecurityFixes(ctx context.Context,
 //meta:operation GET /repos/{owner}/{repo}/contributors
 func (s *RepositoriesService) ListContributors(ctx context.Context, owner string, repository string, opts *ListContributorsOptions) ([]*Contributor, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/contributors", owner, repository)
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -938,6 +1032,55 @@ func (s *RepositoriesService) ListLanguages(ctx context.Context, owner string, r
 	return languages, resp, nil
 }
 
+// LanguageBytes is the number of bytes of code written in a language in a
+// repository, as returned by ListLanguagesSorted.
+type LanguageBytes struct {
+	Language string
+	Bytes    int
+	// Percent is Bytes as a percentage of the bytes in all languages of the
+	// repository.
+	Percent float64
+}
+
+// ListLanguagesSorted is like ListLanguages, but returns the languages sorted
+// by descending number of bytes, then by name, along with the share of the
+// repository each one makes up.
+//
+// GitHub API docs: https://docs.github.com/rest/repos/repos#list-repository-languages
+//
+//meta:operation GET /repos/{owner}/{repo}/languages
+func (s *RepositoriesService) ListLanguagesSorted(ctx context.Context, owner, repo string) ([]LanguageBytes, *Response, error) {
+	languages, resp, err := s.ListLanguages(ctx, owner, repo)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return sortLanguages(languages), resp, nil
+}
+
+// sortLanguages converts the languages returned by ListLanguages into a
+// sorted slice of LanguageBytes.
+func sortLanguages(languages map[string]int) []LanguageBytes {
+	var total int
+	sorted := make([]LanguageBytes, 0, len(languages))
+	for lang, bytes := range languages {
+		total += bytes
+		sorted = append(sorted, LanguageBytes{Language: lang, Bytes: bytes})
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].Bytes != sorted[j].Bytes {
+			return sorted[i].Bytes > sorted[j].Bytes
+		}
+		return sorted[i].Language < sorted[j].Language
+	})
+	if total > 0 {
+		for i := range sorted {
+			sorted[i].Percent = float64(sorted[i].Bytes) * 100 / float64(total)
+		}
+	}
+	return sorted
+}
+
 // ListTeams lists the teams for the specified repository.
 //
 // GitHub API docs: https://docs.github.com/rest/repos/repos#list-repository-teams
@@ -945,7 +1088,7 @@ func (s *RepositoriesService) ListLanguages(ctx context.Context, owner string, r
 //meta:operation GET /repos/{owner}/{repo}/teams
 func (s *RepositoriesService) ListTeams(ctx context.Context, owner string, repo string, opts *ListOptions) ([]*Team, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/teams", owner, repo)
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -979,7 +1122,7 @@ type RepositoryTag struct {
 //meta:operation GET /repos/{owner}/{repo}/tags
 func (s *RepositoriesService) ListTags(ctx context.Context, owner string, repo string, opts *ListOptions) ([]*RepositoryTag, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/tags", owner, repo)
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -998,6 +1141,48 @@ func (s *RepositoriesService) ListTags(ctx context.Context, owner string, repo s
 	return tags, resp, nil
 }
 
+// LatestSemverTagOptions specifies the optional parameters to the
+// RepositoriesService.GetLatestSemverTag method.
+type LatestSemverTagOptions struct {
+	// IncludePrereleases considers tags with a prerelease version, such as
+	// "v2.0.0-rc.1". By default they are ignored.
+	IncludePrereleases bool
+}
+
+// GetLatestSemverTag returns the tag with the highest semantic version, such
+// as "v1.2.3" or "1.2.3", paging through all tags of the repository. Tags that
+// are not valid semantic versions are ignored, and versions are compared by
+// semver precedence, so "v1.10.0" is later than "v1.9.0". If no tag is a
+// semantic version, the returned tag is nil.
+//
+// GitHub API docs: https://docs.github.com/rest/repos/repos#list-repository-tags
+//
+//meta:operation GET /repos/{owner}/{repo}/tags
+func (s *RepositoriesService) GetLatestSemverTag(ctx context.Context, owner, repo string, opts *LatestSemverTagOptions) (*RepositoryTag, *Response, error) {
+	var latest *RepositoryTag
+	var latestVersion semver
+	listOpts := &ListOptions{PerPage: 100}
+	for {
+		tags, resp, err := s.ListTags(ctx, owner, repo, listOpts)
+		if err != nil {
+			return nil, resp, err
+		}
+		for _, tag := range tags {
+			v, ok := parseSemver(tag.GetName())
+			if !ok || (len(v.prerelease) > 0 && (opts == nil || !opts.IncludePrereleases)) {
+				continue
+			}
+			if latest == nil || v.compare(latestVersion) > 0 {
+				latest, latestVersion = tag, v
+			}
+		}
+		if resp.NextPage == 0 {
+			return latest, resp, nil
+		}
+		listOpts.Page = resp.NextPage
+	}
+}
+
 // Branch represents a repository branch.
 type Branch struct {
 	Name      *string           `json:"name,omitempty"`
@@ -1405,7 +1590,7 @@ type AutomatedSecurityFixes struct {
 //meta:operation GET /repos/{owner}/{repo}/branches
 func (s *RepositoriesService) ListBranches(ctx context.Context, owner string, repo string, opts *BranchListOptions) ([]*Branch, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/branches", owner, repo)
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -1424,6 +1609,23 @@ func (s *RepositoriesService) ListBranches(ctx context.Context, owner string, re
 	return branches, resp, nil
 }
 
+// IsEmpty reports whether a repository is empty, that is, has no commits and
+// so no branches yet. Many operations on the contents or Git database of an
+// empty repository fail until a first commit is made, such as by creating a
+// file or by creating the repository with AutoInit.
+//
+// GitHub API docs: https://docs.github.com/rest/branches/branches#list-branches
+//
+//meta:operation GET /repos/{owner}/{repo}/branches
+func (s *RepositoriesService) IsEmpty(ctx context.Context, owner, repo string) (bool, *Response, error) {
+	branches, resp, err := s.ListBranches(ctx, owner, repo, &BranchListOptions{ListOptions: ListOptions{PerPage: 1}})
+	if err != nil {
+		return false, resp, err
+	}
+
+	return len(branches) == 0, resp, nil
+}
+
 // GetBranch gets the specified branch for a repository.
 //
 // Note: the branch name is URL path escaped for you. See: https://pkg.go.dev/net/url#PathEscape .
@@ -1510,6 +1712,70 @@ func (s *RepositoriesService) GetBranchProtection(ctx context.Context, owner, re
 	return p, resp, nil
 }
 
+// ListProtectedBranches lists all protected branches of a repository,
+// fetching every page of results. The returned *Response is that of the last
+// page.
+//
+// GitHub API docs: https://docs.github.com/rest/branches/branches#list-branches
+//
+//meta:operation GET /repos/{owner}/{repo}/branches
+func (s *RepositoriesService) ListProtectedBranches(ctx context.Context, owner, repo string) ([]*Branch, *Response, error) {
+	var branches []*Branch
+	opts := &BranchListOptions{Protected: Ptr(true), ListOptions: ListOptions{PerPage: 100}}
+	for {
+		page, resp, err := s.ListBranches(ctx, owner, repo, opts)
+		if err != nil {
+			return nil, resp, err
+		}
+		branches = append(branches, page...)
+		if resp.NextPage == 0 {
+			return branches, resp, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+// protectionConcurrency is how many branch protections GetProtectionForAll
+// fetches at once.
+const protectionConcurrency = 4
+
+// GetProtectionForAll fetches the protection of every protected branch of a
+// repository, a few at a time, and returns them keyed by branch name. Branches
+// that turn out not to be protected, such as when their protection is removed
+// while the scan runs, are left out. If fetching the protection of some
+// branches fails, the protections that were fetched are returned along with
+// the errors of the others joined together. The returned *Response is that of
+// listing the branches.
+//
+// GitHub API docs: https://docs.github.com/rest/branches/branch-protection#get-branch-protection
+// GitHub API docs: https://docs.github.com/rest/branches/branches#list-branches
+//
+//meta:operation GET /repos/{owner}/{repo}/branches
+//meta:operation GET /repos/{owner}/{repo}/branches/{branch}/protection
+func (s *RepositoriesService) GetProtectionForAll(ctx context.Context, owner, repo string) (map[string]*Protection, *Response, error) {
+	branches, resp, err := s.ListProtectedBranches(ctx, owner, repo)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var mu sync.Mutex
+	protections := make(map[string]*Protection)
+	errs := ForEachBounded(ctx, branches, protectionConcurrency, func(ctx context.Context, b *Branch) error {
+		p, _, err := s.GetBranchProtection(ctx, owner, repo, b.GetName())
+		if errors.Is(err, ErrBranchNotProtected) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("branch %v: %w", b.GetName(), err)
+		}
+		mu.Lock()
+		protections[b.GetName()] = p
+		mu.Unlock()
+		return nil
+	})
+	return protections, resp, errors.Join(errs...)
+}
+
 // GetRequiredStatusChecks gets the required status checks for a given protected branch.
 //
 // Note: the branch name is URL path escaped for you. See: https://pkg.go.dev/net/url#PathEscape .
@@ -27,8 +27,13 @@ func (a ActionsPermissionsRepository) String() string {
 //
 // GitHub API docs: https://docs.github.com/rest/actions/permissions
 type DefaultWorkflowPermissionRepository struct {
-	DefaultWorkflowPermissions   *string `json:"default_workflow_permissions,omitempty"`
-	CanApprovePullRequestReviews *bool   `json:"can_approve_pull_request_reviews,omitempty"`
+	// DefaultWorkflowPermissions is the permissions of the GITHUB_TOKEN of
+	// workflows in the repository: "read" for read access to the contents and
+	// packages scopes only, or "write" for read and write access to all scopes.
+	DefaultWorkflowPermissions *string `json:"default_workflow_permissions,omitempty"`
+	// CanApprovePullRequestReviews is whether GitHub Actions can approve pull
+	// requests, and, when editing, create them.
+	CanApprovePullRequestReviews *bool `json:"can_approve_pull_request_reviews,omitempty"`
 }
 
 // GetActionsPermissions gets the GitHub Actions permissions policy for repositories and allowed actions in a repository.
@@ -97,11 +102,17 @@ func (s *RepositoriesService) GetDefaultWorkflowPermissions(ctx context.Context,
 }
 
 // EditDefaultWorkflowPermissions sets the GitHub Actions default workflow permissions in a repository.
+// An *InvalidEnumError is returned without making a request if
+// permissions.DefaultWorkflowPermissions is not "read" or "write".
 //
 // GitHub API docs: https://docs.github.com/rest/actions/permissions#set-default-workflow-permissions-for-a-repository
 //
 //meta:operation PUT /repos/{owner}/{repo}/actions/permissions/workflow
 func (s *RepositoriesService) EditDefaultWorkflowPermissions(ctx context.Context, owner, repo string, permissions DefaultWorkflowPermissionRepository) (*DefaultWorkflowPermissionRepository, *Response, error) {
+	if err := validateEnum("default_workflow_permissions", permissions.GetDefaultWorkflowPermissions(), "read", "write"); err != nil {
+		return nil, nil, err
+	}
+
 	u := fmt.Sprintf("repos/%v/%v/actions/permissions/workflow", owner, repo)
 	req, err := s.client.NewRequest("PUT", u, permissions)
 	if err != nil {
@@ -8,6 +8,7 @@ package github
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"testing"
@@ -189,3 +190,15 @@ func TestRepositoriesService_EditDefaultWorkflowPermissions(t *testing.T) {
 		return resp, err
 	})
 }
+
+func TestRepositoriesService_EditDefaultWorkflowPermissions_invalid(t *testing.T) {
+	t.Parallel()
+	client, _, _ := setup(t)
+
+	ctx := context.Background()
+	_, _, err := client.Repositories.EditDefaultWorkflowPermissions(ctx, "o", "r", DefaultWorkflowPermissionRepository{DefaultWorkflowPermissions: Ptr("none")})
+	var enumErr *InvalidEnumError
+	if !errors.As(err, &enumErr) {
+		t.Errorf("Repositories.EditDefaultWorkflowPermissions returned error %v, want *InvalidEnumError", err)
+	}
+}
@@ -19,7 +19,7 @@ import (
 func (s *RepositoriesService) ListAttestations(ctx context.Context, owner, repo, subjectDigest string, opts *ListOptions) (*AttestationsResponse, *Response, error) {
 	var u = fmt.Sprintf("repos/%v/%v/attestations/%v", owner, repo, subjectDigest)
 
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -33,7 +33,7 @@ type Autolink struct {
 //meta:operation GET /repos/{owner}/{repo}/autolinks
 func (s *RepositoriesService) ListAutolinks(ctx context.Context, owner, repo string, opts *ListOptions) ([]*Autolink, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/autolinks", owner, repo)
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -110,3 +110,87 @@ func (s *RepositoriesService) DeleteAutolink(ctx context.Context, owner, repo st
 	}
 	return s.client.Do(ctx, req, nil)
 }
+
+// AutolinkSyncResult describes the changes made by RepositoriesService.SyncAutolinks.
+type AutolinkSyncResult struct {
+	Added   []*Autolink
+	Deleted []*Autolink
+}
+
+// SyncAutolinks reconciles the autolink references of a repository with
+// desired, matching them by KeyPrefix. Autolinks whose key prefix is not in
+// desired are deleted, missing ones are added, and ones whose URLTemplate or
+// IsAlphanumeric differ are replaced, as autolinks cannot be edited in place.
+// An unset IsAlphanumeric is taken to be true, as it is by the API. An error
+// is returned, before any request is made, if a key prefix appears more than
+// once in desired.
+//
+// The changes made before any error are reported in the returned result.
+//
+// GitHub API docs: https://docs.github.com/rest/repos/autolinks#create-an-autolink-reference-for-a-repository
+// GitHub API docs: https://docs.github.com/rest/repos/autolinks#delete-an-autolink-reference-from-a-repository
+// GitHub API docs: https://docs.github.com/rest/repos/autolinks#get-all-autolinks-of-a-repository
+//
+//meta:operation GET /repos/{owner}/{repo}/autolinks
+//meta:operation POST /repos/{owner}/{repo}/autolinks
+//meta:operation DELETE /repos/{owner}/{repo}/autolinks/{autolink_id}
+func (s *RepositoriesService) SyncAutolinks(ctx context.Context, owner, repo string, desired []*AutolinkOptions) (*AutolinkSyncResult, *Response, error) {
+	want := make(map[string]*AutolinkOptions, len(desired))
+	for _, d := range desired {
+		if _, ok := want[d.GetKeyPrefix()]; ok {
+			return nil, nil, fmt.Errorf("duplicate autolink key prefix %q", d.GetKeyPrefix())
+		}
+		want[d.GetKeyPrefix()] = d
+	}
+
+	var existing []*Autolink
+	var resp *Response
+	opts := &ListOptions{PerPage: 100}
+	for {
+		autolinks, r, err := s.ListAutolinks(ctx, owner, repo, opts)
+		resp = r
+		if err != nil {
+			return nil, resp, err
+		}
+		existing = append(existing, autolinks...)
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	result := &AutolinkSyncResult{}
+	keep := make(map[string]bool)
+	for _, a := range existing {
+		if d, ok := want[a.GetKeyPrefix()]; ok && autolinkMatches(a, d) {
+			keep[a.GetKeyPrefix()] = true
+			continue
+		}
+		var err error
+		resp, err = s.DeleteAutolink(ctx, owner, repo, a.GetID())
+		if err != nil {
+			return result, resp, err
+		}
+		result.Deleted = append(result.Deleted, a)
+	}
+
+	for _, d := range desired {
+		if keep[d.GetKeyPrefix()] {
+			continue
+		}
+		a, r, err := s.AddAutolink(ctx, owner, repo, d)
+		resp = r
+		if err != nil {
+			return result, resp, err
+		}
+		result.Added = append(result.Added, a)
+	}
+
+	return result, resp, nil
+}
+
+// autolinkMatches reports whether a is configured as described by d.
+func autolinkMatches(a *Autolink, d *AutolinkOptions) bool {
+	alnum := d.IsAlphanumeric == nil || *d.IsAlphanumeric
+	return a.GetURLTemplate() == d.GetURLTemplate() && a.GetIsAlphanumeric() == alnum
+}
@@ -203,3 +203,90 @@ func TestAutolink_Marshal(t *testing.T) {
 
 	testJSONMarshal(t, r, want)
 }
+
+func TestRepositoriesService_SyncAutolinks(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/autolinks", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			testFormValues(t, r, values{"per_page": "100"})
+			fmt.Fprint(w, `[
+				{"id":1,"key_prefix":"KEEP-","url_template":"https://k/<num>","is_alphanumeric":true},
+				{"id":2,"key_prefix":"CHANGE-","url_template":"https://old/<num>","is_alphanumeric":true},
+				{"id":3,"key_prefix":"GONE-","url_template":"https://g/<num>","is_alphanumeric":false}
+			]`)
+		case "POST":
+			v := new(AutolinkOptions)
+			assertNilError(t, json.NewDecoder(r.Body).Decode(v))
+			fmt.Fprintf(w, `{"id":9,"key_prefix":%q}`, v.GetKeyPrefix())
+		default:
+			t.Errorf("Unexpected %v request", r.Method)
+		}
+	})
+	var deleted []string
+	mux.HandleFunc("/repos/o/r/autolinks/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		deleted = append(deleted, r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	desired := []*AutolinkOptions{
+		{KeyPrefix: Ptr("KEEP-"), URLTemplate: Ptr("https://k/<num>")},
+		{KeyPrefix: Ptr("CHANGE-"), URLTemplate: Ptr("https://new/<num>")},
+		{KeyPrefix: Ptr("NEW-"), URLTemplate: Ptr("https://n/<num>"), IsAlphanumeric: Ptr(false)},
+	}
+
+	ctx := context.Background()
+	result, _, err := client.Repositories.SyncAutolinks(ctx, "o", "r", desired)
+	if err != nil {
+		t.Fatalf("Repositories.SyncAutolinks returned error: %v", err)
+	}
+
+	want := &AutolinkSyncResult{
+		Added: []*Autolink{
+			{ID: Ptr(int64(9)), KeyPrefix: Ptr("CHANGE-")},
+			{ID: Ptr(int64(9)), KeyPrefix: Ptr("NEW-")},
+		},
+		Deleted: []*Autolink{
+			{ID: Ptr(int64(2)), KeyPrefix: Ptr("CHANGE-"), URLTemplate: Ptr("https://old/<num>"), IsAlphanumeric: Ptr(true)},
+			{ID: Ptr(int64(3)), KeyPrefix: Ptr("GONE-"), URLTemplate: Ptr("https://g/<num>"), IsAlphanumeric: Ptr(false)},
+		},
+	}
+	if !cmp.Equal(result, want) {
+		t.Errorf("Repositories.SyncAutolinks returned %+v, want %+v", result, want)
+	}
+	if want := []string{"/repos/o/r/autolinks/2", "/repos/o/r/autolinks/3"}; !cmp.Equal(deleted, want) {
+		t.Errorf("Repositories.SyncAutolinks deleted %v, want %v", deleted, want)
+	}
+
+	const methodName = "SyncAutolinks"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.SyncAutolinks(ctx, "\n", "\n", desired)
+		return err
+	})
+}
+
+func TestRepositoriesService_SyncAutolinks_duplicateKeyPrefix(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/autolinks", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Unexpected %v request", r.Method)
+	})
+
+	desired := []*AutolinkOptions{
+		{KeyPrefix: Ptr("DUP-"), URLTemplate: Ptr("https://a/<num>")},
+		{KeyPrefix: Ptr("DUP-"), URLTemplate: Ptr("https://b/<num>")},
+	}
+
+	ctx := context.Background()
+	result, _, err := client.Repositories.SyncAutolinks(ctx, "o", "r", desired)
+	if err == nil {
+		t.Error("Repositories.SyncAutolinks with a duplicate key prefix returned nil error")
+	}
+	if result != nil {
+		t.Errorf("Repositories.SyncAutolinks returned %+v, want nil", result)
+	}
+}
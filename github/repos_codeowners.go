@@ -34,14 +34,28 @@ type CodeownersError struct {
 	Path       string  `json:"path"`
 }
 
+// Error formats the syntax error as "path:line:column: kind", followed by the
+// suggested fix if there is one, so that CodeownersError can be reported or
+// combined with errors.Join like any other error.
+func (e *CodeownersError) Error() string {
+	msg := fmt.Sprintf("%v:%v:%v: %v", e.Path, e.Line, e.Column, e.Kind)
+	if e.Suggestion != nil {
+		msg += " (" + *e.Suggestion + ")"
+	}
+	return msg
+}
+
 // GetCodeownersErrors lists any syntax errors that are detected in the CODEOWNERS file.
+// Lines with errors are ignored by GitHub, so checking for errors before relying
+// on CODEOWNERS to request reviewers (see PullRequestsService.RequestReviewers)
+// catches owners that would otherwise silently not be requested.
 //
 // GitHub API docs: https://docs.github.com/rest/repos/repos#list-codeowners-errors
 //
 //meta:operation GET /repos/{owner}/{repo}/codeowners/errors
 func (s *RepositoriesService) GetCodeownersErrors(ctx context.Context, owner, repo string, opts *GetCodeownersErrorsOptions) (*CodeownersErrors, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/codeowners/errors", owner, repo)
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -172,3 +172,21 @@ func TestCodeownersErrors_Marshal(t *testing.T) {
 `
 	testJSONMarshal(t, u, want)
 }
+
+func TestCodeownersError_Error(t *testing.T) {
+	t.Parallel()
+	e := &CodeownersError{
+		Line:   3,
+		Column: 1,
+		Kind:   "Invalid pattern",
+		Path:   ".github/CODEOWNERS",
+	}
+	if got, want := e.Error(), ".github/CODEOWNERS:3:1: Invalid pattern"; got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+
+	e.Suggestion = Ptr("Did you mean **/*.rb?")
+	if got, want := e.Error(), ".github/CODEOWNERS:3:1: Invalid pattern (Did you mean **/*.rb?)"; got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+}
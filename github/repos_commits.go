@@ -129,7 +129,7 @@ type BranchCommit struct {
 //meta:operation GET /repos/{owner}/{repo}/commits
 func (s *RepositoriesService) ListCommits(ctx context.Context, owner, repo string, opts *CommitsListOptions) ([]*RepositoryCommit, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/commits", owner, repo)
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -155,7 +155,7 @@ func (s *RepositoriesService) ListCommits(ctx context.Context, owner, repo strin
 //meta:operation GET /repos/{owner}/{repo}/commits/{ref}
 func (s *RepositoriesService) GetCommit(ctx context.Context, owner, repo, sha string, opts *ListOptions) (*RepositoryCommit, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/commits/%v", owner, repo, sha)
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -242,7 +242,7 @@ func (s *RepositoriesService) CompareCommits(ctx context.Context, owner, repo st
 	escapedHead := url.QueryEscape(head)
 
 	u := fmt.Sprintf("repos/%v/%v/compare/%v...%v", owner, repo, escapedBase, escapedHead)
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -261,6 +261,24 @@ func (s *RepositoriesService) CompareCommits(ctx context.Context, owner, repo st
 	return comp, resp, nil
 }
 
+// MergeBase returns the merge base of base and head, which is the best common
+// ancestor of the two commits, as reported by the comparison of the two. base
+// and head may be any refs accepted by CompareCommits. GitHub returns a 404
+// Not Found response if the two have no common history.
+//
+// GitHub API docs: https://docs.github.com/rest/commits/commits#compare-two-commits
+//
+//meta:operation GET /repos/{owner}/{repo}/compare/{basehead}
+func (s *RepositoriesService) MergeBase(ctx context.Context, owner, repo, base, head string) (*RepositoryCommit, *Response, error) {
+	// Only the merge base is needed, so keep the list of commits short.
+	comp, resp, err := s.CompareCommits(ctx, owner, repo, base, head, &ListOptions{PerPage: 1})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return comp.MergeBaseCommit, resp, nil
+}
+
 // CompareCommitsRaw compares a range of commits with each other in raw (diff or patch) format.
 //
 // Both "base" and "head" must be branch names in "repo".
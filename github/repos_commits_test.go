@@ -514,6 +514,45 @@ func TestRepositoriesService_CompareCommits(t *testing.T) {
 	}
 }
 
+func TestRepositoriesService_MergeBase(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/compare/main...feature%2Fx", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"per_page": "1"})
+		fmt.Fprint(w, `{
+			"base_commit": {"sha": "b"},
+			"merge_base_commit": {"sha": "m", "commit": {"message": "msg"}},
+			"commits": [{"sha": "c"}]
+		}`)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Repositories.MergeBase(ctx, "o", "r", "main", "feature/x")
+	if err != nil {
+		t.Fatalf("Repositories.MergeBase returned error: %v", err)
+	}
+	want := &RepositoryCommit{SHA: Ptr("m"), Commit: &Commit{Message: Ptr("msg")}}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Repositories.MergeBase returned %+v, want %+v", got, want)
+	}
+
+	const methodName = "MergeBase"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.MergeBase(ctx, "\n", "\n", "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Repositories.MergeBase(ctx, "o", "r", "main", "feature/x")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
 func TestRepositoriesService_CompareCommitsRaw_diff(t *testing.T) {
 	t.Parallel()
 	testCases := []struct {
@@ -31,6 +31,34 @@ type CommunityHealthFiles struct {
 	Readme              *Metric `json:"readme"`
 }
 
+// Missing returns the JSON names, such as "contributing" or "readme", of the
+// community health files that the repository does not have, in the order they
+// are declared in CommunityHealthFiles. A nil receiver is missing every file.
+func (f *CommunityHealthFiles) Missing() []string {
+	if f == nil {
+		f = &CommunityHealthFiles{}
+	}
+	files := []struct {
+		name   string
+		metric *Metric
+	}{
+		{"code_of_conduct", f.CodeOfConduct},
+		{"code_of_conduct_file", f.CodeOfConductFile},
+		{"contributing", f.Contributing},
+		{"issue_template", f.IssueTemplate},
+		{"pull_request_template", f.PullRequestTemplate},
+		{"license", f.License},
+		{"readme", f.Readme},
+	}
+	var missing []string
+	for _, file := range files {
+		if file.metric == nil {
+			missing = append(missing, file.name)
+		}
+	}
+	return missing
+}
+
 // CommunityHealthMetrics represents a response containing the community metrics of a repository.
 type CommunityHealthMetrics struct {
 	HealthPercentage      *int                  `json:"health_percentage"`
@@ -7,6 +7,7 @@ package github
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"testing"
@@ -133,6 +134,22 @@ func TestRepositoriesService_GetCommunityHealthMetrics(t *testing.T) {
 	})
 }
 
+func TestCommunityHealthFiles_Missing(t *testing.T) {
+	t.Parallel()
+	var files *CommunityHealthFiles
+	if err := json.Unmarshal([]byte(`{"code_of_conduct":null,"contributing":{"url":"u"},"license":{"key":"mit"},"readme":{"url":"r"}}`), &files); err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"code_of_conduct", "code_of_conduct_file", "issue_template", "pull_request_template"}
+	if got := files.Missing(); !cmp.Equal(got, want) {
+		t.Errorf("Missing = %v, want %v", got, want)
+	}
+
+	if got := (*CommunityHealthFiles)(nil).Missing(); len(got) != 7 {
+		t.Errorf("Missing on nil files = %v, want all 7 files", got)
+	}
+}
+
 func TestMetric_Marshal(t *testing.T) {
 	t.Parallel()
 	testJSONMarshal(t, &Metric{}, "{}")
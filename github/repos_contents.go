@@ -19,6 +19,7 @@ import (
 	"net/url"
 	"path"
 	"strings"
+	"unicode/utf8"
 )
 
 var ErrPathForbidden = errors.New("path must not contain '..' due to auth vulnerability issue")
@@ -73,7 +74,20 @@ func (r RepositoryContent) String() string {
 }
 
 // GetContent returns the content of r, decoding it if necessary.
+// Base64 content may contain embedded line breaks or other whitespace,
+// which is ignored. Content with the "none" encoding is returned unchanged
+// when present.
 func (r *RepositoryContent) GetContent() (string, error) {
+	var buf strings.Builder
+	if err := r.DecodeTo(&buf); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// DecodeTo writes the decoded content of r to w. Unlike GetContent, it does
+// not hold a second, decoded copy of large files in memory.
+func (r *RepositoryContent) DecodeTo(w io.Writer) error {
 	var encoding string
 	if r.Encoding != nil {
 		encoding = *r.Encoding
@@ -82,19 +96,50 @@ func (r *RepositoryContent) GetContent() (string, error) {
 	switch encoding {
 	case "base64":
 		if r.Content == nil {
-			return "", errors.New("malformed response: base64 encoding of null content")
+			return errors.New("malformed response: base64 encoding of null content")
 		}
-		c, err := base64.StdEncoding.DecodeString(*r.Content)
-		return string(c), err
+		dec := base64.NewDecoder(base64.StdEncoding, &spaceSkippingReader{r: strings.NewReader(*r.Content)})
+		_, err := io.Copy(w, dec)
+		return err
 	case "":
 		if r.Content == nil {
-			return "", nil
+			return nil
 		}
-		return *r.Content, nil
+		_, err := io.WriteString(w, *r.Content)
+		return err
 	case "none":
-		return "", errors.New("unsupported content encoding: none, this may occur when file size > 1 MB, if that is the case consider using DownloadContents")
+		if r.Content == nil || *r.Content == "" {
+			return errors.New("unsupported content encoding: none, this may occur when file size > 1 MB, if that is the case consider using DownloadContents")
+		}
+		_, err := io.WriteString(w, *r.Content)
+		return err
 	default:
-		return "", fmt.Errorf("unsupported content encoding: %v", encoding)
+		return fmt.Errorf("unsupported content encoding: %v", encoding)
+	}
+}
+
+// spaceSkippingReader wraps an io.Reader, dropping any ASCII whitespace.
+// GitHub wraps base64 encoded content at 60 characters per line.
+type spaceSkippingReader struct {
+	r io.Reader
+}
+
+func (s *spaceSkippingReader) Read(p []byte) (int, error) {
+	for {
+		n, err := s.r.Read(p)
+		kept := 0
+		for _, b := range p[:n] {
+			switch b {
+			case ' ', '\t', '\n', '\v', '\f', '\r':
+			default:
+				p[kept] = b
+				kept++
+			}
+		}
+		// Avoid returning 0, nil when a read consisted only of whitespace.
+		if kept > 0 || err != nil {
+			return kept, err
+		}
 	}
 }
 
@@ -105,7 +150,7 @@ func (r *RepositoryContent) GetContent() (string, error) {
 //meta:operation GET /repos/{owner}/{repo}/readme
 func (s *RepositoriesService) GetReadme(ctx context.Context, owner, repo string, opts *RepositoryContentGetOptions) (*RepositoryContent, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/readme", owner, repo)
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -133,6 +178,9 @@ func (s *RepositoriesService) GetReadme(ctx context.Context, owner, repo string,
 // returned error is nil. Callers should check the returned Response status
 // code to verify the content is from a successful response.
 //
+// If the repository is empty, the returned *ErrorResponse matches
+// ErrEmptyRepository.
+//
 // GitHub API docs: https://docs.github.com/rest/repos/contents#get-repository-content
 //
 //meta:operation GET /repos/{owner}/{repo}/contents/{path}
@@ -175,6 +223,9 @@ func (s *RepositoriesService) DownloadContents(ctx context.Context, owner, repo,
 // returned error is nil. Callers should check the returned Response status
 // code to verify the content is from a successful response.
 //
+// If the repository is empty, the returned *ErrorResponse matches
+// ErrEmptyRepository.
+//
 // GitHub API docs: https://docs.github.com/rest/repos/contents#get-repository-content
 //
 //meta:operation GET /repos/{owner}/{repo}/contents/{path}
@@ -218,6 +269,9 @@ func (s *RepositoriesService) DownloadContentsWithMeta(ctx context.Context, owne
 // Due to an auth vulnerability issue in the GitHub v3 API, ".." is not allowed
 // to appear anywhere in the "path" or this method will return an error.
 //
+// If the repository is empty, the returned *ErrorResponse matches
+// ErrEmptyRepository.
+//
 // GitHub API docs: https://docs.github.com/rest/repos/contents#get-repository-content
 //
 //meta:operation GET /repos/{owner}/{repo}/contents/{path}
@@ -228,7 +282,7 @@ func (s *RepositoriesService) GetContents(ctx context.Context, owner, repo, path
 
 	escapedPath := (&url.URL{Path: strings.TrimSuffix(path, "/")}).String()
 	u := fmt.Sprintf("repos/%s/%s/contents/%s", owner, repo, escapedPath)
-	u, err = addOptions(u, opts)
+	u, err = s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, nil, err
 	}
@@ -241,7 +295,7 @@ func (s *RepositoriesService) GetContents(ctx context.Context, owner, repo, path
 	var rawJSON json.RawMessage
 	resp, err = s.client.Do(ctx, req, &rawJSON)
 	if err != nil {
-		return nil, nil, resp, err
+		return nil, nil, resp, markEmptyRepository(err, http.StatusNotFound)
 	}
 
 	fileUnmarshalError := json.Unmarshal(rawJSON, &fileContent)
@@ -323,6 +377,138 @@ func (s *RepositoriesService) DeleteFile(ctx context.Context, owner, repo, path
 	return deleteResponse, resp, nil
 }
 
+// FileChange is a change to a single file made by CreateOrUpdateFiles.
+type FileChange struct {
+	// Path is the path of the file in the repository.
+	Path string
+	// Content is the new content of the file. It is ignored if Delete is set.
+	Content []byte
+	// Mode is the file mode of the file, such as "100755" for an executable.
+	// Default: "100644".
+	Mode string
+	// Delete removes the file instead of writing it.
+	Delete bool
+}
+
+// CommitOptions specifies the parameters of the commit created by
+// CreateOrUpdateFiles.
+type CommitOptions struct {
+	// Message is the commit message. It is required.
+	Message *string
+	// Branch is the branch to commit to. Default: the repository's default
+	// branch.
+	Branch    *string
+	Author    *CommitAuthor
+	Committer *CommitAuthor
+}
+
+// CreateOrUpdateFiles creates, updates and deletes several files of a
+// repository in a single commit on top of the head of a branch, which must
+// already have at least one commit. Unlike CreateFile and UpdateFile, it does
+// not need the blob SHAs of the files being replaced. It uses the Git data API
+// to build the commit, and the branch is only updated if it has not moved in
+// the meantime. The returned RepositoryContentResponse holds the new commit;
+// its Content is not set. The returned *Response is that of updating the
+// branch.
+//
+// If the repository is empty, the returned *ErrorResponse matches
+// ErrEmptyRepository.
+//
+// GitHub API docs: https://docs.github.com/rest/git/blobs#create-a-blob
+// GitHub API docs: https://docs.github.com/rest/git/commits#create-a-commit
+// GitHub API docs: https://docs.github.com/rest/git/commits#get-a-commit-object
+// GitHub API docs: https://docs.github.com/rest/git/refs#get-a-reference
+// GitHub API docs: https://docs.github.com/rest/git/refs#update-a-reference
+// GitHub API docs: https://docs.github.com/rest/git/trees#create-a-tree
+// GitHub API docs: https://docs.github.com/rest/repos/repos#get-a-repository
+//
+//meta:operation GET /repos/{owner}/{repo}
+//meta:operation POST /repos/{owner}/{repo}/git/blobs
+//meta:operation POST /repos/{owner}/{repo}/git/commits
+//meta:operation GET /repos/{owner}/{repo}/git/commits/{commit_sha}
+//meta:operation GET /repos/{owner}/{repo}/git/ref/{ref}
+//meta:operation PATCH /repos/{owner}/{repo}/git/refs/{ref}
+//meta:operation POST /repos/{owner}/{repo}/git/trees
+func (s *RepositoriesService) CreateOrUpdateFiles(ctx context.Context, owner, repo string, changes []FileChange, opts *CommitOptions) (*RepositoryContentResponse, *Response, error) {
+	if opts.GetMessage() == "" {
+		return nil, nil, errors.New("commit message is required")
+	}
+	if len(changes) == 0 {
+		return nil, nil, errors.New("at least one file change is required")
+	}
+
+	branch := opts.GetBranch()
+	if branch == "" {
+		r, resp, err := s.Get(ctx, owner, repo)
+		if err != nil {
+			return nil, resp, err
+		}
+		branch = r.GetDefaultBranch()
+	}
+
+	git := s.client.Git
+	ref, resp, err := git.GetRef(ctx, owner, repo, "heads/"+branch)
+	if err != nil {
+		return nil, resp, err
+	}
+	parent, resp, err := git.GetCommit(ctx, owner, repo, ref.GetObject().GetSHA())
+	if err != nil {
+		return nil, resp, err
+	}
+
+	entries := make([]*TreeEntry, 0, len(changes))
+	for _, c := range changes {
+		entry := &TreeEntry{Path: Ptr(c.Path), Mode: Ptr("100644"), Type: Ptr("blob")}
+		if c.Mode != "" {
+			entry.Mode = Ptr(c.Mode)
+		}
+		switch {
+		case c.Delete:
+			// A nil SHA and Content deletes the file; see TreeEntry.MarshalJSON.
+		case utf8.Valid(c.Content):
+			entry.Content = Ptr(string(c.Content))
+		default:
+			// Tree entries only accept UTF-8 content, so other files are
+			// uploaded as blobs first.
+			blob, resp, err := git.CreateBlob(ctx, owner, repo, &Blob{
+				Content:  Ptr(base64.StdEncoding.EncodeToString(c.Content)),
+				Encoding: Ptr("base64"),
+			})
+			if err != nil {
+				return nil, resp, err
+			}
+			entry.SHA = blob.SHA
+		}
+		entries = append(entries, entry)
+	}
+
+	tree, resp, err := git.CreateTree(ctx, owner, repo, parent.GetTree().GetSHA(), entries)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	commit, resp, err := git.CreateCommit(ctx, owner, repo, &Commit{
+		Message:   opts.Message,
+		Tree:      &Tree{SHA: tree.SHA},
+		Parents:   []*Commit{{SHA: parent.SHA}},
+		Author:    opts.Author,
+		Committer: opts.Committer,
+	}, nil)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	_, resp, err = git.UpdateRef(ctx, owner, repo, &Reference{
+		Ref:    Ptr("refs/heads/" + branch),
+		Object: &GitObject{SHA: commit.SHA},
+	}, false)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &RepositoryContentResponse{Commit: *commit}, resp, nil
+}
+
 // ArchiveFormat is used to define the archive type when calling GetArchiveLink.
 type ArchiveFormat string
 
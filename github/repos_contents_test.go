@@ -6,12 +6,15 @@
 package github
 
 import (
+	"bytes"
 	"context"
+	"encoding/base64"
 	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -54,12 +57,42 @@ func TestRepositoryContent_GetContent(t *testing.T) {
 			want:     "",
 			wantErr:  true,
 		},
+		{
+			encoding: Ptr("base64"),
+			content:  Ptr("aGVs\nbG8g\r\nd29y bGQ=\n"),
+			want:     "hello world",
+			wantErr:  false,
+		},
+		{
+			encoding: Ptr("base64"),
+			content:  Ptr("aGVsbG8"),
+			want:     "",
+			wantErr:  true,
+		},
+		{
+			encoding: Ptr("base64"),
+			content:  nil,
+			want:     "",
+			wantErr:  true,
+		},
 		{
 			encoding: Ptr("none"),
 			content:  nil,
 			want:     "",
 			wantErr:  true,
 		},
+		{
+			encoding: Ptr("none"),
+			content:  Ptr(""),
+			want:     "",
+			wantErr:  true,
+		},
+		{
+			encoding: Ptr("none"),
+			content:  Ptr("raw content"),
+			want:     "raw content",
+			wantErr:  false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -79,6 +112,28 @@ func TestRepositoryContent_GetContent(t *testing.T) {
 	}
 }
 
+func TestRepositoryContent_DecodeTo(t *testing.T) {
+	t.Parallel()
+	// 60 column line wrapping as returned by the GitHub API.
+	want := strings.Repeat("go-github ", 100)
+	encoded := base64.StdEncoding.EncodeToString([]byte(want))
+	var wrapped strings.Builder
+	for len(encoded) > 60 {
+		wrapped.WriteString(encoded[:60] + "\n")
+		encoded = encoded[60:]
+	}
+	wrapped.WriteString(encoded + "\n")
+
+	r := RepositoryContent{Encoding: Ptr("base64"), Content: Ptr(wrapped.String())}
+	var buf bytes.Buffer
+	if err := r.DecodeTo(&buf); err != nil {
+		t.Fatalf("RepositoryContent.DecodeTo returned error: %v", err)
+	}
+	if got := buf.String(); got != want {
+		t.Errorf("RepositoryContent.DecodeTo wrote %q, want %q", got, want)
+	}
+}
+
 // stringOrNil converts a potentially null string pointer to string.
 // For non-nil input pointer, the returned string is enclosed in double-quotes.
 func stringOrNil(s *string) string {
@@ -261,6 +316,27 @@ func TestRepositoriesService_DownloadContents_NoFile(t *testing.T) {
 	}
 }
 
+func TestRepositoriesService_DownloadContents_emptyRepository(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/contents/d", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"message":"This repository is empty."}`)
+	})
+
+	ctx := context.Background()
+	_, _, err := client.Repositories.DownloadContents(ctx, "o", "r", "d/f", nil)
+	if !errors.Is(err, ErrEmptyRepository) {
+		t.Errorf("Repositories.DownloadContents returned error %v, want ErrEmptyRepository", err)
+	}
+	_, _, _, err = client.Repositories.DownloadContentsWithMeta(ctx, "o", "r", "d/f", nil)
+	if !errors.Is(err, ErrEmptyRepository) {
+		t.Errorf("Repositories.DownloadContentsWithMeta returned error %v, want ErrEmptyRepository", err)
+	}
+}
+
 func TestRepositoriesService_DownloadContentsWithMeta_Success(t *testing.T) {
 	t.Parallel()
 	client, mux, serverURL := setup(t)
@@ -453,6 +529,39 @@ func TestRepositoriesService_GetContents_File(t *testing.T) {
 	})
 }
 
+func TestRepositoriesService_GetContents_emptyRepository(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/contents/p", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"message":"This repository is empty."}`)
+	})
+
+	ctx := context.Background()
+	_, _, _, err := client.Repositories.GetContents(ctx, "o", "r", "p", nil)
+	if !errors.Is(err, ErrEmptyRepository) {
+		t.Errorf("Repositories.GetContents returned error %v, want ErrEmptyRepository", err)
+	}
+}
+
+func TestRepositoriesService_GetContents_notFoundIsNotEmptyRepository(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/contents/p", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"message":"Not Found"}`)
+	})
+
+	ctx := context.Background()
+	_, _, _, err := client.Repositories.GetContents(ctx, "o", "r", "p", nil)
+	if err == nil || errors.Is(err, ErrEmptyRepository) {
+		t.Errorf("Repositories.GetContents returned error %v, want a plain 404 *ErrorResponse", err)
+	}
+}
+
 func TestRepositoriesService_GetContents_FilenameNeedsEscape(t *testing.T) {
 	t.Parallel()
 	client, mux, _ := setup(t)
@@ -1076,3 +1185,92 @@ func TestRepositoryContentFileOptions_Marshal(t *testing.T) {
 
 	testJSONMarshal(t, r, want)
 }
+
+func TestRepositoriesService_CreateOrUpdateFiles(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"default_branch":"main"}`)
+	})
+	mux.HandleFunc("/repos/o/r/git/ref/heads/main", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"ref":"refs/heads/main","object":{"sha":"parent"}}`)
+	})
+	mux.HandleFunc("/repos/o/r/git/commits/parent", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"sha":"parent","tree":{"sha":"base"}}`)
+	})
+	mux.HandleFunc("/repos/o/r/git/blobs", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"content":"/wA=","encoding":"base64"}`+"\n")
+		fmt.Fprint(w, `{"sha":"blob"}`)
+	})
+	mux.HandleFunc("/repos/o/r/git/trees", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"base_tree":"base","tree":[`+
+			`{"path":"a.txt","mode":"100644","type":"blob","content":"hello"},`+
+			`{"sha":"blob","path":"bin","mode":"100755","type":"blob"},`+
+			`{"sha":null,"path":"old.txt","mode":"100644","type":"blob"}]}`+"\n")
+		fmt.Fprint(w, `{"sha":"tree"}`)
+	})
+	mux.HandleFunc("/repos/o/r/git/commits", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"message":"m","tree":"tree","parents":["parent"]}`+"\n")
+		fmt.Fprint(w, `{"sha":"commit","message":"m"}`)
+	})
+	mux.HandleFunc("/repos/o/r/git/refs/heads/main", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"sha":"commit","force":false}`+"\n")
+		fmt.Fprint(w, `{"ref":"refs/heads/main","object":{"sha":"commit"}}`)
+	})
+
+	changes := []FileChange{
+		{Path: "a.txt", Content: []byte("hello")},
+		{Path: "bin", Content: []byte{0xff, 0x00}, Mode: "100755"},
+		{Path: "old.txt", Delete: true},
+	}
+	ctx := context.Background()
+	got, _, err := client.Repositories.CreateOrUpdateFiles(ctx, "o", "r", changes, &CommitOptions{Message: Ptr("m")})
+	if err != nil {
+		t.Fatalf("Repositories.CreateOrUpdateFiles returned error: %v", err)
+	}
+
+	want := &RepositoryContentResponse{Commit: Commit{SHA: Ptr("commit"), Message: Ptr("m")}}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Repositories.CreateOrUpdateFiles returned %+v, want %+v", got, want)
+	}
+}
+
+func TestRepositoriesService_CreateOrUpdateFiles_emptyRepository(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/git/ref/heads/main", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.WriteHeader(http.StatusConflict)
+		fmt.Fprint(w, `{"message":"Git Repository is empty."}`)
+	})
+
+	ctx := context.Background()
+	changes := []FileChange{{Path: "a", Content: []byte("a")}}
+	_, _, err := client.Repositories.CreateOrUpdateFiles(ctx, "o", "r", changes, &CommitOptions{Message: Ptr("m"), Branch: Ptr("main")})
+	if !errors.Is(err, ErrEmptyRepository) {
+		t.Errorf("Repositories.CreateOrUpdateFiles returned error %v, want ErrEmptyRepository", err)
+	}
+}
+
+func TestRepositoriesService_CreateOrUpdateFiles_invalid(t *testing.T) {
+	t.Parallel()
+	client, _, _ := setup(t)
+
+	ctx := context.Background()
+	changes := []FileChange{{Path: "a", Content: []byte("a")}}
+	if _, _, err := client.Repositories.CreateOrUpdateFiles(ctx, "o", "r", changes, nil); err == nil {
+		t.Error("Repositories.CreateOrUpdateFiles without a message returned nil error")
+	}
+	if _, _, err := client.Repositories.CreateOrUpdateFiles(ctx, "o", "r", nil, &CommitOptions{Message: Ptr("m")}); err == nil {
+		t.Error("Repositories.CreateOrUpdateFiles without changes returned nil error")
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
 	"strings"
 )
 
@@ -32,9 +33,12 @@ type Deployment struct {
 
 // DeploymentRequest represents a deployment request.
 type DeploymentRequest struct {
-	Ref                   *string     `json:"ref,omitempty"`
-	Task                  *string     `json:"task,omitempty"`
-	AutoMerge             *bool       `json:"auto_merge,omitempty"`
+	Ref       *string `json:"ref,omitempty"`
+	Task      *string `json:"task,omitempty"`
+	AutoMerge *bool   `json:"auto_merge,omitempty"`
+	// RequiredContexts are the status contexts verified against the commit
+	// before deploying. If nil, all unique contexts are verified. Set it to
+	// &[]string{} to skip status checks entirely.
 	RequiredContexts      *[]string   `json:"required_contexts,omitempty"`
 	Payload               interface{} `json:"payload,omitempty"`
 	Environment           *string     `json:"environment,omitempty"`
@@ -68,7 +72,7 @@ type DeploymentsListOptions struct {
 //meta:operation GET /repos/{owner}/{repo}/deployments
 func (s *RepositoriesService) ListDeployments(ctx context.Context, owner, repo string, opts *DeploymentsListOptions) ([]*Deployment, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/deployments", owner, repo)
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -188,7 +192,7 @@ type DeploymentStatusRequest struct {
 //meta:operation GET /repos/{owner}/{repo}/deployments/{deployment_id}/statuses
 func (s *RepositoriesService) ListDeploymentStatuses(ctx context.Context, owner, repo string, deployment int64, opts *ListOptions) ([]*DeploymentStatus, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/deployments/%v/statuses", owner, repo, deployment)
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -262,3 +266,34 @@ func (s *RepositoriesService) CreateDeploymentStatus(ctx context.Context, owner,
 
 	return d, resp, nil
 }
+
+// WaitForDeploymentStatus polls the statuses of a deployment, as described by
+// opts, until its latest status has one of wantStates, and returns that
+// status. If ctx is done or opts.Timeout elapses first, the context's error is
+// returned along with the last response.
+//
+// GitHub API docs: https://docs.github.com/rest/deployments/statuses#list-deployment-statuses
+//
+//meta:operation GET /repos/{owner}/{repo}/deployments/{deployment_id}/statuses
+func (s *RepositoriesService) WaitForDeploymentStatus(ctx context.Context, owner, repo string, deployment int64, wantStates []string, opts WaitOptions) (*DeploymentStatus, *Response, error) {
+	var status *DeploymentStatus
+	var resp *Response
+	err := s.client.poll(ctx, opts, func(ctx context.Context) (bool, error) {
+		// Statuses are listed newest first.
+		statuses, r, err := s.ListDeploymentStatuses(ctx, owner, repo, deployment, &ListOptions{PerPage: 1})
+		resp = r
+		if err != nil {
+			return false, err
+		}
+		if len(statuses) == 0 {
+			return false, nil
+		}
+		status = statuses[0]
+		return slices.Contains(wantStates, status.GetState()), nil
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return status, resp, nil
+}
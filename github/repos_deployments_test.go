@@ -8,10 +8,12 @@ package github
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 )
@@ -294,6 +296,55 @@ func TestRepositoriesService_CreateDeploymentStatus(t *testing.T) {
 	})
 }
 
+func TestRepositoriesService_WaitForDeploymentStatus(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	var calls int
+	mux.HandleFunc("/repos/o/r/deployments/1/statuses", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"per_page": "1"})
+		calls++
+		switch calls {
+		case 1:
+			fmt.Fprint(w, `[]`)
+		case 2:
+			fmt.Fprint(w, `[{"id":1,"state":"in_progress"}]`)
+		default:
+			fmt.Fprint(w, `[{"id":2,"state":"success"}]`)
+		}
+	})
+
+	ctx := context.Background()
+	status, _, err := client.Repositories.WaitForDeploymentStatus(ctx, "o", "r", 1, []string{"success", "failure"}, WaitOptions{Interval: time.Millisecond})
+	if err != nil {
+		t.Fatalf("Repositories.WaitForDeploymentStatus returned error: %v", err)
+	}
+
+	want := &DeploymentStatus{ID: Ptr(int64(2)), State: Ptr("success")}
+	if !cmp.Equal(status, want) {
+		t.Errorf("Repositories.WaitForDeploymentStatus returned %+v, want %+v", status, want)
+	}
+	if calls != 3 {
+		t.Errorf("Repositories.WaitForDeploymentStatus made %v calls, want 3", calls)
+	}
+}
+
+func TestRepositoriesService_WaitForDeploymentStatus_timeout(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/deployments/1/statuses", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"id":1,"state":"queued"}]`)
+	})
+
+	ctx := context.Background()
+	_, _, err := client.Repositories.WaitForDeploymentStatus(ctx, "o", "r", 1, []string{"success"}, WaitOptions{Interval: time.Millisecond, Timeout: 20 * time.Millisecond})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Repositories.WaitForDeploymentStatus returned error %v, want %v", err, context.DeadlineExceeded)
+	}
+}
+
 func TestDeploymentStatusRequest_Marshal(t *testing.T) {
 	t.Parallel()
 	testJSONMarshal(t, &DeploymentStatusRequest{}, "{}")
@@ -427,6 +478,10 @@ func TestDeploymentRequest_Marshal(t *testing.T) {
 	}`
 
 	testJSONMarshal(t, r, want)
+
+	// An explicitly empty RequiredContexts is sent to bypass status checks.
+	r = &DeploymentRequest{RequiredContexts: &[]string{}}
+	testJSONMarshal(t, r, `{"required_contexts": []}`)
 }
 
 func TestDeployment_Marshal(t *testing.T) {
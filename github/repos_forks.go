@@ -8,6 +8,7 @@ package github
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 )
 
@@ -28,7 +29,7 @@ type RepositoryListForksOptions struct {
 //meta:operation GET /repos/{owner}/{repo}/forks
 func (s *RepositoriesService) ListForks(ctx context.Context, owner, repo string, opts *RepositoryListForksOptions) ([]*Repository, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/forks", owner, repo)
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -95,3 +96,39 @@ func (s *RepositoriesService) CreateFork(ctx context.Context, owner, repo string
 
 	return fork, resp, nil
 }
+
+// ErrNotFork is returned by ForkStatus when the repository is not a fork.
+var ErrNotFork = errors.New("repository is not a fork")
+
+// ForkStatus reports how far the default branch of the fork owner/repo has
+// diverged from the default branch of its parent repository. aheadBy is the
+// number of commits on the fork that are not on the parent, and behindBy is the
+// number of commits on the parent that are not on the fork.
+//
+// ErrNotFork is returned if owner/repo is not a fork.
+//
+// GitHub API docs: https://docs.github.com/rest/commits/commits#compare-two-commits
+// GitHub API docs: https://docs.github.com/rest/repos/repos#get-a-repository
+//
+//meta:operation GET /repos/{owner}/{repo}
+//meta:operation GET /repos/{owner}/{repo}/compare/{basehead}
+func (s *RepositoriesService) ForkStatus(ctx context.Context, owner, repo string) (aheadBy, behindBy int, resp *Response, err error) {
+	fork, resp, err := s.Get(ctx, owner, repo)
+	if err != nil {
+		return 0, 0, resp, err
+	}
+
+	parent := fork.GetParent()
+	if !fork.GetFork() || parent == nil {
+		return 0, 0, resp, ErrNotFork
+	}
+
+	base := fmt.Sprintf("%v:%v", parent.GetOwner().GetLogin(), parent.GetDefaultBranch())
+	// Only the totals are needed, so avoid fetching more commits than necessary.
+	comp, resp, err := s.CompareCommits(ctx, owner, repo, base, fork.GetDefaultBranch(), &ListOptions{PerPage: 1})
+	if err != nil {
+		return 0, 0, resp, err
+	}
+
+	return comp.GetAheadBy(), comp.GetBehindBy(), resp, nil
+}
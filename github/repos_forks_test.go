@@ -7,6 +7,7 @@ package github
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"testing"
@@ -137,3 +138,54 @@ func TestRepositoriesService_CreateFork_invalidOwner(t *testing.T) {
 	_, _, err := client.Repositories.CreateFork(ctx, "%", "r", nil)
 	testURLParseError(t, err)
 }
+
+func TestRepositoriesService_ForkStatus(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"fork":true,"default_branch":"dev","parent":{"owner":{"login":"u"},"default_branch":"main"}}`)
+	})
+	mux.HandleFunc("/repos/o/r/compare/u:main...dev", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"per_page": "1"})
+		fmt.Fprint(w, `{"ahead_by":2,"behind_by":5}`)
+	})
+
+	ctx := context.Background()
+	aheadBy, behindBy, _, err := client.Repositories.ForkStatus(ctx, "o", "r")
+	if err != nil {
+		t.Errorf("Repositories.ForkStatus returned error: %v", err)
+	}
+	if aheadBy != 2 || behindBy != 5 {
+		t.Errorf("Repositories.ForkStatus returned (%v, %v), want (2, 5)", aheadBy, behindBy)
+	}
+
+	const methodName = "ForkStatus"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, _, err = client.Repositories.ForkStatus(ctx, "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		_, _, resp, err := client.Repositories.ForkStatus(ctx, "o", "r")
+		return resp, err
+	})
+}
+
+func TestRepositoriesService_ForkStatus_notFork(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"fork":false,"default_branch":"main"}`)
+	})
+
+	ctx := context.Background()
+	_, _, _, err := client.Repositories.ForkStatus(ctx, "o", "r")
+	if !errors.Is(err, ErrNotFork) {
+		t.Errorf("Repositories.ForkStatus returned error %v, want %v", err, ErrNotFork)
+	}
+}
@@ -113,7 +113,7 @@ func (s *RepositoriesService) CreateHook(ctx context.Context, owner, repo string
 //meta:operation GET /repos/{owner}/{repo}/hooks
 func (s *RepositoriesService) ListHooks(ctx context.Context, owner, repo string, opts *ListOptions) ([]*Hook, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/hooks", owner, repo)
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -68,7 +68,7 @@ func (r HookResponse) String() string {
 //meta:operation GET /repos/{owner}/{repo}/hooks/{hook_id}/deliveries
 func (s *RepositoriesService) ListHookDeliveries(ctx context.Context, owner, repo string, id int64, opts *ListCursorOptions) ([]*HookDelivery, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/hooks/%v/deliveries", owner, repo, id)
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -18,7 +18,8 @@ type RepositoryInvitation struct {
 	Inviter *User       `json:"inviter,omitempty"`
 
 	// Permissions represents the permissions that the associated user will have
-	// on the repository. Possible values are: "read", "write", "admin".
+	// on the repository. Possible values are: "read", "triage", "write",
+	// "maintain", "admin".
 	Permissions *string    `json:"permissions,omitempty"`
 	CreatedAt   *Timestamp `json:"created_at,omitempty"`
 	URL         *string    `json:"url,omitempty"`
@@ -33,7 +34,7 @@ type RepositoryInvitation struct {
 //meta:operation GET /repos/{owner}/{repo}/invitations
 func (s *RepositoriesService) ListInvitations(ctx context.Context, owner, repo string, opts *ListOptions) ([]*RepositoryInvitation, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/invitations", owner, repo)
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -71,7 +72,8 @@ func (s *RepositoriesService) DeleteInvitation(ctx context.Context, owner, repo
 // invitation.
 //
 // permissions represents the permissions that the associated user will have
-// on the repository. Possible values are: "read", "write", "admin".
+// on the repository. Possible values are: "read", "triage", "write",
+// "maintain", "admin".
 //
 // GitHub API docs: https://docs.github.com/rest/collaborators/invitations#update-a-repository-invitation
 //
@@ -32,6 +32,13 @@ type RepoMergeUpstreamResult struct {
 	BaseBranch *string `json:"base_branch,omitempty"`
 }
 
+// UpToDate reports whether the branch was already up-to-date with the
+// upstream repository, in which case nothing was merged. GitHub reports this
+// as a successful sync with a merge type of "none" rather than as an error.
+func (r *RepoMergeUpstreamResult) UpToDate() bool {
+	return r.GetMergeType() == "none"
+}
+
 // Merge a branch in the specified repository.
 //
 // GitHub API docs: https://docs.github.com/rest/branches/branches#merge-a-branch
@@ -54,7 +61,8 @@ func (s *RepositoriesService) Merge(ctx context.Context, owner, repo string, req
 }
 
 // MergeUpstream syncs a branch of a forked repository to keep it up-to-date
-// with the upstream repository.
+// with the upstream repository. If the branch is already up-to-date, the
+// returned result's UpToDate method reports true.
 //
 // GitHub API docs: https://docs.github.com/rest/branches/branches#sync-a-fork-branch-with-the-upstream-repository
 //
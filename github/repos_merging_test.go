@@ -146,3 +146,26 @@ func TestRepoMergeUpstreamResult_Marshal(t *testing.T) {
 
 	testJSONMarshal(t, u, want)
 }
+
+func TestRepoMergeUpstreamResult_UpToDate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		result *RepoMergeUpstreamResult
+		want   bool
+	}{
+		{result: nil, want: false},
+		{result: &RepoMergeUpstreamResult{}, want: false},
+		{result: &RepoMergeUpstreamResult{MergeType: Ptr("fast-forward")}, want: false},
+		{result: &RepoMergeUpstreamResult{MergeType: Ptr("merge")}, want: false},
+		{result: &RepoMergeUpstreamResult{
+			Message:   Ptr("This branch is not behind the upstream octocat:main."),
+			MergeType: Ptr("none"),
+		}, want: true},
+	}
+
+	for _, tt := range tests {
+		if got := tt.result.UpToDate(); got != tt.want {
+			t.Errorf("UpToDate() for %+v = %v, want %v", tt.result, got, tt.want)
+		}
+	}
+}
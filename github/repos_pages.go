@@ -7,6 +7,7 @@ package github
 
 import (
 	"context"
+	"errors"
 	"fmt"
 )
 
@@ -220,7 +221,7 @@ func (s *RepositoriesService) GetPagesInfo(ctx context.Context, owner, repo stri
 //meta:operation GET /repos/{owner}/{repo}/pages/builds
 func (s *RepositoriesService) ListPagesBuilds(ctx context.Context, owner, repo string, opts *ListOptions) ([]*PagesBuild, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/pages/builds", owner, repo)
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -322,3 +323,145 @@ func (s *RepositoriesService) GetPageHealthCheck(ctx context.Context, owner, rep
 
 	return healthCheckResponse, resp, nil
 }
+
+// ErrPagesBuildErrored is returned by WaitForPagesBuild when the build it was
+// waiting for ends in the "errored" state.
+var ErrPagesBuildErrored = errors.New("pages build errored")
+
+// WaitForPagesBuild waits for the GitHub Pages build of the commit with the
+// given SHA, such as the head of the publishing branch when RequestPageBuild
+// was called or after a push to it, to finish, and returns it. The builds of
+// the repository are polled as described by opts; until a build of the commit
+// is listed, or while the most recent one is still in progress, polling
+// continues. A build for a later commit does not end the wait.
+//
+// If the build errored, the build is returned together with an error wrapping
+// ErrPagesBuildErrored and the build's error message. If ctx is done or
+// opts.Timeout elapses first, the context's error is returned along with the
+// last response.
+//
+// GitHub API docs: https://docs.github.com/rest/pages/pages#list-github-pages-builds
+//
+//meta:operation GET /repos/{owner}/{repo}/pages/builds
+func (s *RepositoriesService) WaitForPagesBuild(ctx context.Context, owner, repo, commit string, opts WaitOptions) (*PagesBuild, *Response, error) {
+	var build *PagesBuild
+	var resp *Response
+	err := s.client.poll(ctx, opts, func(ctx context.Context) (bool, error) {
+		builds, r, err := s.ListPagesBuilds(ctx, owner, repo, nil)
+		resp = r
+		if err != nil {
+			return false, err
+		}
+		// Builds are listed most recent first.
+		build = nil
+		for _, b := range builds {
+			if b.GetCommit() == commit {
+				build = b
+				break
+			}
+		}
+		switch build.GetStatus() {
+		case "built":
+			return true, nil
+		case "errored":
+			return false, fmt.Errorf("%w: %v", ErrPagesBuildErrored, build.GetError().GetMessage())
+		}
+		return false, nil
+	})
+	if err != nil {
+		if errors.Is(err, ErrPagesBuildErrored) {
+			return build, resp, err
+		}
+		return nil, resp, err
+	}
+
+	return build, resp, nil
+}
+
+// PagesDeploymentRequest represents a request to deploy an Actions artifact
+// to GitHub Pages.
+type PagesDeploymentRequest struct {
+	ArtifactID        *int64  `json:"artifact_id,omitempty"`
+	ArtifactURL       *string `json:"artifact_url,omitempty"`
+	Environment       *string `json:"environment,omitempty"`
+	PagesBuildVersion *string `json:"pages_build_version,omitempty"`
+	OIDCToken         *string `json:"oidc_token,omitempty"`
+}
+
+// PagesDeployment represents a GitHub Pages deployment.
+type PagesDeployment struct {
+	// ID is the ID of the deployment, which is either a number or the SHA of
+	// the deployed commit.
+	ID         interface{} `json:"id,omitempty"`
+	StatusURL  *string     `json:"status_url,omitempty"`
+	PageURL    *string     `json:"page_url,omitempty"`
+	PreviewURL *string     `json:"preview_url,omitempty"`
+}
+
+// PagesDeploymentStatus represents the status of a GitHub Pages deployment.
+type PagesDeploymentStatus struct {
+	// Status is one of "deployment_in_progress", "syncing_files",
+	// "finished_file_sync", "updating_pages", "purging_cdn",
+	// "deployment_cancelled", "deployment_failed", "deployment_content_failed",
+	// "deployment_attempt_error", "deployment_lost" or "succeed".
+	Status *string `json:"status,omitempty"`
+}
+
+// CreatePagesDeployment creates a GitHub Pages deployment from an Actions artifact.
+//
+// GitHub API docs: https://docs.github.com/rest/pages/pages#create-a-github-pages-deployment
+//
+//meta:operation POST /repos/{owner}/{repo}/pages/deployments
+func (s *RepositoriesService) CreatePagesDeployment(ctx context.Context, owner, repo string, request *PagesDeploymentRequest) (*PagesDeployment, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/pages/deployments", owner, repo)
+	req, err := s.client.NewRequest("POST", u, request)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	deployment := new(PagesDeployment)
+	resp, err := s.client.Do(ctx, req, deployment)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return deployment, resp, nil
+}
+
+// GetPagesDeploymentStatus gets the status of a GitHub Pages deployment.
+// deploymentID is either the ID of the deployment or the SHA of the deployed commit.
+//
+// GitHub API docs: https://docs.github.com/rest/pages/pages#get-the-status-of-a-github-pages-deployment
+//
+//meta:operation GET /repos/{owner}/{repo}/pages/deployments/{pages_deployment_id}
+func (s *RepositoriesService) GetPagesDeploymentStatus(ctx context.Context, owner, repo, deploymentID string) (*PagesDeploymentStatus, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/pages/deployments/%v", owner, repo, deploymentID)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	status := new(PagesDeploymentStatus)
+	resp, err := s.client.Do(ctx, req, status)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return status, resp, nil
+}
+
+// CancelPagesDeployment cancels a GitHub Pages deployment.
+// deploymentID is either the ID of the deployment or the SHA of the deployed commit.
+//
+// GitHub API docs: https://docs.github.com/rest/pages/pages#cancel-a-github-pages-deployment
+//
+//meta:operation POST /repos/{owner}/{repo}/pages/deployments/{pages_deployment_id}/cancel
+func (s *RepositoriesService) CancelPagesDeployment(ctx context.Context, owner, repo, deploymentID string) (*Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/pages/deployments/%v/cancel", owner, repo, deploymentID)
+	req, err := s.client.NewRequest("POST", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
@@ -9,10 +9,12 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 )
@@ -717,3 +719,196 @@ func TestCreatePagesRequest_Marshal(t *testing.T) {
 
 	testJSONMarshal(t, u, want)
 }
+
+func TestRepositoriesService_WaitForPagesBuild(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	calls := 0
+	mux.HandleFunc("/repos/o/r/pages/builds", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		calls++
+		switch calls {
+		case 1:
+			// The previous build is still the latest one.
+			fmt.Fprint(w, `[{"status":"built","commit":"old"}]`)
+		case 2:
+			fmt.Fprint(w, `[{"status":"building","commit":"c"},{"status":"built","commit":"old"}]`)
+		default:
+			fmt.Fprint(w, `[{"status":"queued","commit":"later"},{"status":"built","commit":"c"},{"status":"built","commit":"old"}]`)
+		}
+	})
+
+	ctx := context.Background()
+	build, _, err := client.Repositories.WaitForPagesBuild(ctx, "o", "r", "c", WaitOptions{Interval: time.Millisecond})
+	if err != nil {
+		t.Fatalf("Repositories.WaitForPagesBuild returned error: %v", err)
+	}
+
+	want := &PagesBuild{Status: Ptr("built"), Commit: Ptr("c")}
+	if !cmp.Equal(build, want) {
+		t.Errorf("Repositories.WaitForPagesBuild returned %+v, want %+v", build, want)
+	}
+	if calls != 3 {
+		t.Errorf("Repositories.WaitForPagesBuild polled %v times, want 3", calls)
+	}
+
+	const methodName = "WaitForPagesBuild"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.WaitForPagesBuild(ctx, "\n", "\n", "c", WaitOptions{})
+		return err
+	})
+}
+
+func TestRepositoriesService_WaitForPagesBuild_errored(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/pages/builds", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"status":"errored","commit":"c","error":{"message":"bad config"}}]`)
+	})
+
+	ctx := context.Background()
+	build, _, err := client.Repositories.WaitForPagesBuild(ctx, "o", "r", "c", WaitOptions{Interval: time.Millisecond})
+	if !errors.Is(err, ErrPagesBuildErrored) {
+		t.Fatalf("Repositories.WaitForPagesBuild returned error %v, want %v", err, ErrPagesBuildErrored)
+	}
+	if got, want := err.Error(), "pages build errored: bad config"; got != want {
+		t.Errorf("Repositories.WaitForPagesBuild returned error %q, want %q", got, want)
+	}
+	if got, want := build.GetStatus(), "errored"; got != want {
+		t.Errorf("Repositories.WaitForPagesBuild returned build with status %q, want %q", got, want)
+	}
+}
+
+func TestRepositoriesService_CreatePagesDeployment(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	input := &PagesDeploymentRequest{
+		ArtifactID:        Ptr(int64(1)),
+		Environment:       Ptr("github-pages"),
+		PagesBuildVersion: Ptr("v"),
+		OIDCToken:         Ptr("t"),
+	}
+
+	mux.HandleFunc("/repos/o/r/pages/deployments", func(w http.ResponseWriter, r *http.Request) {
+		v := new(PagesDeploymentRequest)
+		assertNilError(t, json.NewDecoder(r.Body).Decode(v))
+
+		testMethod(t, r, "POST")
+		if !cmp.Equal(v, input) {
+			t.Errorf("Request body = %+v, want %+v", v, input)
+		}
+		fmt.Fprint(w, `{"id":"4fd754f7e594640989b406850d0bc8f06a121251","status_url":"s","page_url":"p"}`)
+	})
+
+	ctx := context.Background()
+	deployment, _, err := client.Repositories.CreatePagesDeployment(ctx, "o", "r", input)
+	if err != nil {
+		t.Errorf("Repositories.CreatePagesDeployment returned error: %v", err)
+	}
+
+	want := &PagesDeployment{ID: "4fd754f7e594640989b406850d0bc8f06a121251", StatusURL: Ptr("s"), PageURL: Ptr("p")}
+	if !cmp.Equal(deployment, want) {
+		t.Errorf("Repositories.CreatePagesDeployment returned %+v, want %+v", deployment, want)
+	}
+
+	const methodName = "CreatePagesDeployment"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.CreatePagesDeployment(ctx, "\n", "\n", input)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Repositories.CreatePagesDeployment(ctx, "o", "r", input)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestRepositoriesService_GetPagesDeploymentStatus(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/pages/deployments/123", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"status":"succeed"}`)
+	})
+
+	ctx := context.Background()
+	status, _, err := client.Repositories.GetPagesDeploymentStatus(ctx, "o", "r", "123")
+	if err != nil {
+		t.Errorf("Repositories.GetPagesDeploymentStatus returned error: %v", err)
+	}
+
+	want := &PagesDeploymentStatus{Status: Ptr("succeed")}
+	if !cmp.Equal(status, want) {
+		t.Errorf("Repositories.GetPagesDeploymentStatus returned %+v, want %+v", status, want)
+	}
+
+	const methodName = "GetPagesDeploymentStatus"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.GetPagesDeploymentStatus(ctx, "\n", "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Repositories.GetPagesDeploymentStatus(ctx, "o", "r", "123")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestRepositoriesService_CancelPagesDeployment(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/pages/deployments/123/cancel", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	_, err := client.Repositories.CancelPagesDeployment(ctx, "o", "r", "123")
+	if err != nil {
+		t.Errorf("Repositories.CancelPagesDeployment returned error: %v", err)
+	}
+
+	const methodName = "CancelPagesDeployment"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Repositories.CancelPagesDeployment(ctx, "\n", "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Repositories.CancelPagesDeployment(ctx, "o", "r", "123")
+	})
+}
+
+func TestPagesDeploymentRequest_Marshal(t *testing.T) {
+	t.Parallel()
+	testJSONMarshal(t, &PagesDeploymentRequest{}, "{}")
+
+	u := &PagesDeploymentRequest{
+		ArtifactID:        Ptr(int64(1)),
+		ArtifactURL:       Ptr("u"),
+		Environment:       Ptr("e"),
+		PagesBuildVersion: Ptr("v"),
+		OIDCToken:         Ptr("t"),
+	}
+
+	want := `{
+		"artifact_id": 1,
+		"artifact_url": "u",
+		"environment": "e",
+		"pages_build_version": "v",
+		"oidc_token": "t"
+	}`
+
+	testJSONMarshal(t, u, want)
+}
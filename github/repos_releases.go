@@ -92,7 +92,7 @@ func (r ReleaseAsset) String() string {
 //meta:operation GET /repos/{owner}/{repo}/releases
 func (s *RepositoriesService) ListReleases(ctx context.Context, owner, repo string, opts *ListOptions) ([]*RepositoryRelease, *Response, error) {
 	u := fmt.Sprintf("repos/%s/%s/releases", owner, repo)
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -286,7 +286,7 @@ func (s *RepositoriesService) DeleteRelease(ctx context.Context, owner, repo str
 //meta:operation GET /repos/{owner}/{repo}/releases/{release_id}/assets
 func (s *RepositoriesService) ListReleaseAssets(ctx context.Context, owner, repo string, id int64, opts *ListOptions) ([]*ReleaseAsset, *Response, error) {
 	u := fmt.Sprintf("repos/%s/%s/releases/%d/assets", owner, repo, id)
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -444,7 +444,7 @@ func (s *RepositoriesService) DeleteReleaseAsset(ctx context.Context, owner, rep
 //meta:operation POST /repos/{owner}/{repo}/releases/{release_id}/assets
 func (s *RepositoriesService) UploadReleaseAsset(ctx context.Context, owner, repo string, id int64, opts *UploadOptions, file *os.File) (*ReleaseAsset, *Response, error) {
 	u := fmt.Sprintf("repos/%s/%s/releases/%d/assets", owner, repo, id)
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -474,3 +474,80 @@ func (s *RepositoriesService) UploadReleaseAsset(ctx context.Context, owner, rep
 	}
 	return asset, resp, nil
 }
+
+// ReleaseSpec describes a release to publish with RepositoriesService.PublishRelease.
+type ReleaseSpec struct {
+	// Release holds the fields of the release to create. Its Draft field is
+	// ignored; the release is always created as a draft and published once
+	// all of Assets have been uploaded.
+	Release *RepositoryRelease
+
+	// Assets are uploaded to the release, in order, before it is published.
+	Assets []*ReleaseAssetSpec
+}
+
+// ReleaseAssetSpec describes an asset to upload with RepositoriesService.PublishRelease.
+type ReleaseAssetSpec struct {
+	// Options are passed to UploadReleaseAsset. If Options or its Name is
+	// unset, the base name of File is used as the asset name.
+	Options *UploadOptions
+	File    *os.File
+}
+
+// PublishRelease creates a draft release, uploads the assets in spec to it
+// and then publishes it, so that the release never appears without all of its
+// assets.
+//
+// If uploading an asset fails, the release is left as a draft and returned,
+// with the assets uploaded so far in its Assets field, along with the error.
+// The caller may then retry the remaining uploads or delete the draft. If an
+// asset has no File, an error is returned without making a request.
+//
+// GitHub API docs: https://docs.github.com/rest/releases/assets#upload-a-release-asset
+// GitHub API docs: https://docs.github.com/rest/releases/releases#create-a-release
+// GitHub API docs: https://docs.github.com/rest/releases/releases#update-a-release
+//
+//meta:operation POST /repos/{owner}/{repo}/releases
+//meta:operation PATCH /repos/{owner}/{repo}/releases/{release_id}
+//meta:operation POST /repos/{owner}/{repo}/releases/{release_id}/assets
+func (s *RepositoriesService) PublishRelease(ctx context.Context, owner, repo string, spec ReleaseSpec) (*RepositoryRelease, *Response, error) {
+	for i, a := range spec.Assets {
+		if a == nil || a.File == nil {
+			return nil, nil, fmt.Errorf("release asset %v has no file", i)
+		}
+	}
+
+	draft := new(RepositoryRelease)
+	if spec.Release != nil {
+		*draft = *spec.Release
+	}
+	draft.Draft = Ptr(true)
+
+	release, resp, err := s.CreateRelease(ctx, owner, repo, draft)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	for _, a := range spec.Assets {
+		opts := new(UploadOptions)
+		if a.Options != nil {
+			*opts = *a.Options
+		}
+		if opts.Name == "" {
+			opts.Name = filepath.Base(a.File.Name())
+		}
+
+		asset, resp, err := s.UploadReleaseAsset(ctx, owner, repo, release.GetID(), opts, a.File)
+		if err != nil {
+			return release, resp, fmt.Errorf("uploading release asset %q: %w", opts.Name, err)
+		}
+		release.Assets = append(release.Assets, asset)
+	}
+
+	published, resp, err := s.EditRelease(ctx, owner, repo, release.GetID(), &RepositoryRelease{Draft: Ptr(false)})
+	if err != nil {
+		return release, resp, err
+	}
+
+	return published, resp, nil
+}
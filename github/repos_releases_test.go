@@ -915,3 +915,126 @@ func TestGenerateNotesOptions_Marshal(t *testing.T) {
 
 	testJSONMarshal(t, u, want)
 }
+
+func TestRepositoriesService_PublishRelease(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	var steps []string
+	mux.HandleFunc("/repos/o/r/releases", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"tag_name":"v1.0.0","draft":true}`+"\n")
+		steps = append(steps, "create")
+		fmt.Fprint(w, `{"id":1,"tag_name":"v1.0.0","draft":true}`)
+	})
+	mux.HandleFunc("/repos/o/r/releases/1/assets", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		name := r.FormValue("name")
+		steps = append(steps, "upload "+name)
+		fmt.Fprintf(w, `{"name":%q}`, name)
+	})
+	mux.HandleFunc("/repos/o/r/releases/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"draft":false}`+"\n")
+		steps = append(steps, "publish")
+		fmt.Fprint(w, `{"id":1,"tag_name":"v1.0.0","draft":false,"assets":[{"name":"a.txt"},{"name":"b"}]}`)
+	})
+
+	spec := ReleaseSpec{
+		Release: &RepositoryRelease{TagName: Ptr("v1.0.0"), Draft: Ptr(false)},
+		Assets: []*ReleaseAssetSpec{
+			{File: openTestFile(t, "a.txt", "a")},
+			{Options: &UploadOptions{Name: "b"}, File: openTestFile(t, "upload", "b")},
+		},
+	}
+
+	ctx := context.Background()
+	release, _, err := client.Repositories.PublishRelease(ctx, "o", "r", spec)
+	if err != nil {
+		t.Fatalf("Repositories.PublishRelease returned error: %v", err)
+	}
+
+	want := &RepositoryRelease{
+		ID:      Ptr(int64(1)),
+		TagName: Ptr("v1.0.0"),
+		Draft:   Ptr(false),
+		Assets:  []*ReleaseAsset{{Name: Ptr("a.txt")}, {Name: Ptr("b")}},
+	}
+	if !cmp.Equal(release, want) {
+		t.Errorf("Repositories.PublishRelease returned %+v, want %+v", release, want)
+	}
+
+	wantSteps := []string{"create", "upload a.txt", "upload b", "publish"}
+	if !cmp.Equal(steps, wantSteps) {
+		t.Errorf("Repositories.PublishRelease made requests %v, want %v", steps, wantSteps)
+	}
+}
+
+func TestRepositoriesService_PublishRelease_missingFile(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/releases", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Repositories.PublishRelease created a release for an invalid spec")
+	})
+
+	ctx := context.Background()
+	for _, assets := range [][]*ReleaseAssetSpec{
+		{{File: openTestFile(t, "a", "a")}, {Options: &UploadOptions{Name: "b"}}},
+		{nil},
+	} {
+		if _, _, err := client.Repositories.PublishRelease(ctx, "o", "r", ReleaseSpec{Assets: assets}); err == nil {
+			t.Error("Repositories.PublishRelease returned nil error, want error")
+		}
+	}
+}
+
+func TestRepositoriesService_PublishRelease_uploadError(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/releases", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id":1,"draft":true}`)
+	})
+	uploads := 0
+	mux.HandleFunc("/repos/o/r/releases/1/assets", func(w http.ResponseWriter, r *http.Request) {
+		uploads++
+		if uploads > 1 {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			return
+		}
+		fmt.Fprint(w, `{"name":"a"}`)
+	})
+	mux.HandleFunc("/repos/o/r/releases/1", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Repositories.PublishRelease published the release after a failed upload")
+	})
+
+	spec := ReleaseSpec{
+		Assets: []*ReleaseAssetSpec{
+			{File: openTestFile(t, "a", "a")},
+			{File: openTestFile(t, "b", "b")},
+			{File: openTestFile(t, "c", "c")},
+		},
+	}
+
+	ctx := context.Background()
+	release, resp, err := client.Repositories.PublishRelease(ctx, "o", "r", spec)
+	if err == nil {
+		t.Fatal("Repositories.PublishRelease returned nil error, want error")
+	}
+	if resp.StatusCode != http.StatusUnprocessableEntity {
+		t.Errorf("Repositories.PublishRelease returned status %v, want %v", resp.StatusCode, http.StatusUnprocessableEntity)
+	}
+
+	want := &RepositoryRelease{
+		ID:     Ptr(int64(1)),
+		Draft:  Ptr(true),
+		Assets: []*ReleaseAsset{{Name: Ptr("a")}},
+	}
+	if !cmp.Equal(release, want) {
+		t.Errorf("Repositories.PublishRelease returned %+v, want %+v", release, want)
+	}
+	if uploads != 2 {
+		t.Errorf("Repositories.PublishRelease uploaded %v assets, want 2", uploads)
+	}
+}
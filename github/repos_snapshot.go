@@ -0,0 +1,242 @@
+// Copyright 2026 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"sync"
+)
+
+// RepoSnapshot holds the settings of a repository at a point in time, as
+// gathered by RepositoriesService.Snapshot. It can be stored as JSON and
+// compared with a later snapshot using Diff to detect configuration drift.
+type RepoSnapshot struct {
+	// Settings holds only the settings fields of the repository, such as its
+	// merge options and enabled features. Counters and timestamps, which
+	// change without any change to the configuration, are left unset.
+	Settings *Repository `json:"settings,omitempty"`
+	// Topics is sorted.
+	Topics []string `json:"topics,omitempty"`
+	// BranchProtection maps the name of each protected branch to its
+	// protection.
+	BranchProtection map[string]*Protection `json:"branch_protection,omitempty"`
+	// Hooks holds only the Name, Config, Events, and Active fields of the
+	// repository webhooks, sorted by config URL.
+	Hooks []*Hook `json:"hooks,omitempty"`
+}
+
+// RepoSnapshotChange is a difference between two snapshots found by
+// RepoSnapshot.Diff.
+type RepoSnapshotChange struct {
+	// Path is the dot-separated path of JSON field names to the changed
+	// value, such as "settings.allow_squash_merge" or
+	// "branch_protection.main.enforce_admins.enabled".
+	Path string `json:"path"`
+	// Old and New are the JSON encodings of the value in the receiver and in
+	// the other snapshot. An empty string means the value is absent.
+	Old string `json:"old,omitempty"`
+	New string `json:"new,omitempty"`
+}
+
+// Snapshot gathers the settings of the specified repository into a single
+// RepoSnapshot: the repository's merge options and features, its topics, the
+// protection of each protected branch, as fetched by GetProtectionForAll, and
+// its webhooks. At most a few of the underlying requests are made at a time.
+//
+// Reading branch protection and webhooks requires admin access to the
+// repository. If any request fails, its error is returned, along with its
+// response or, for a branch protection, that of listing the branches.
+//
+// GitHub API docs: https://docs.github.com/rest/branches/branch-protection#get-branch-protection
+// GitHub API docs: https://docs.github.com/rest/branches/branches#list-branches
+// GitHub API docs: https://docs.github.com/rest/repos/repos#get-a-repository
+// GitHub API docs: https://docs.github.com/rest/repos/webhooks#list-repository-webhooks
+//
+//meta:operation GET /repos/{owner}/{repo}
+//meta:operation GET /repos/{owner}/{repo}/branches
+//meta:operation GET /repos/{owner}/{repo}/branches/{branch}/protection
+//meta:operation GET /repos/{owner}/{repo}/hooks
+func (s *RepositoriesService) Snapshot(ctx context.Context, owner, repo string) (*RepoSnapshot, *Response, error) {
+	repository, resp, err := s.Get(ctx, owner, repo)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	snap := &RepoSnapshot{
+		Settings: repoSettings(repository),
+		Topics:   append([]string(nil), repository.Topics...),
+	}
+	sort.Strings(snap.Topics)
+
+	var (
+		mu       sync.Mutex
+		failResp *Response
+	)
+	// record keeps the response of the first failed request.
+	record := func(resp *Response, err error) error {
+		if err != nil {
+			mu.Lock()
+			if failResp == nil {
+				failResp = resp
+			}
+			mu.Unlock()
+		}
+		return err
+	}
+
+	parts := []func(ctx context.Context) error{
+		func(ctx context.Context) error {
+			protections, resp, err := s.GetProtectionForAll(ctx, owner, repo)
+			if err != nil {
+				return record(resp, err)
+			}
+			snap.BranchProtection = protections
+			return nil
+		},
+		func(ctx context.Context) error {
+			opts := &ListOptions{PerPage: 100}
+			for {
+				page, resp, err := s.ListHooks(ctx, owner, repo, opts)
+				if err != nil {
+					return record(resp, err)
+				}
+				for _, h := range page {
+					snap.Hooks = append(snap.Hooks, &Hook{Name: h.Name, Config: h.Config, Events: h.Events, Active: h.Active})
+				}
+				if resp.NextPage == 0 {
+					return nil
+				}
+				opts.Page = resp.NextPage
+			}
+		},
+	}
+	errs := ForEachBounded(ctx, parts, len(parts), func(ctx context.Context, part func(context.Context) error) error {
+		return part(ctx)
+	})
+	for _, err := range errs {
+		if err != nil {
+			return nil, failResp, err
+		}
+	}
+	sort.SliceStable(snap.Hooks, func(i, j int) bool {
+		return snap.Hooks[i].GetConfig().GetURL() < snap.Hooks[j].GetConfig().GetURL()
+	})
+
+	return snap, resp, nil
+}
+
+// repoSettings returns a copy of the settings fields of r.
+func repoSettings(r *Repository) *Repository {
+	return &Repository{
+		Description:               r.Description,
+		Homepage:                  r.Homepage,
+		DefaultBranch:             r.DefaultBranch,
+		Private:                   r.Private,
+		Visibility:                r.Visibility,
+		Archived:                  r.Archived,
+		IsTemplate:                r.IsTemplate,
+		HasIssues:                 r.HasIssues,
+		HasWiki:                   r.HasWiki,
+		HasPages:                  r.HasPages,
+		HasProjects:               r.HasProjects,
+		HasDownloads:              r.HasDownloads,
+		HasDiscussions:            r.HasDiscussions,
+		AllowRebaseMerge:          r.AllowRebaseMerge,
+		AllowUpdateBranch:         r.AllowUpdateBranch,
+		AllowSquashMerge:          r.AllowSquashMerge,
+		AllowMergeCommit:          r.AllowMergeCommit,
+		AllowAutoMerge:            r.AllowAutoMerge,
+		AllowForking:              r.AllowForking,
+		WebCommitSignoffRequired:  r.WebCommitSignoffRequired,
+		DeleteBranchOnMerge:       r.DeleteBranchOnMerge,
+		UseSquashPRTitleAsDefault: r.UseSquashPRTitleAsDefault,
+		SquashMergeCommitTitle:    r.SquashMergeCommitTitle,
+		SquashMergeCommitMessage:  r.SquashMergeCommitMessage,
+		MergeCommitTitle:          r.MergeCommitTitle,
+		MergeCommitMessage:        r.MergeCommitMessage,
+		SecurityAndAnalysis:       r.SecurityAndAnalysis,
+	}
+}
+
+// Diff returns the differences between s and other, sorted by path. Objects
+// are compared field by field, while lists, such as Topics and Hooks, are
+// compared as a whole. A nil snapshot is treated as empty.
+func (s *RepoSnapshot) Diff(other *RepoSnapshot) ([]*RepoSnapshotChange, error) {
+	oldValues, err := s.flatten()
+	if err != nil {
+		return nil, err
+	}
+	newValues, err := other.flatten()
+	if err != nil {
+		return nil, err
+	}
+
+	var changes []*RepoSnapshotChange
+	for path, old := range oldValues {
+		if n := newValues[path]; n != old {
+			changes = append(changes, &RepoSnapshotChange{Path: path, Old: old, New: n})
+		}
+	}
+	for path, n := range newValues {
+		if _, ok := oldValues[path]; !ok {
+			changes = append(changes, &RepoSnapshotChange{Path: path, New: n})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Path < changes[j].Path })
+
+	return changes, nil
+}
+
+// flatten returns the JSON encoding of each non-object, non-null value in s,
+// keyed by its dot-separated path.
+func (s *RepoSnapshot) flatten() (map[string]string, error) {
+	values := map[string]string{}
+	if s == nil {
+		return values, nil
+	}
+
+	b, err := json.Marshal(s)
+	if err != nil {
+		return nil, err
+	}
+	var v interface{}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return nil, err
+	}
+
+	var walk func(path string, v interface{}) error
+	walk = func(path string, v interface{}) error {
+		if m, ok := v.(map[string]interface{}); ok {
+			for k, child := range m {
+				p := k
+				if path != "" {
+					p = path + "." + k
+				}
+				if err := walk(p, child); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+		// Some fields are not omitted when empty; treat null as absent.
+		if v == nil {
+			return nil
+		}
+		b, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+		values[path] = string(b)
+		return nil
+	}
+	if err := walk("", v); err != nil {
+		return nil, err
+	}
+
+	return values, nil
+}
@@ -0,0 +1,168 @@
+// Copyright 2026 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRepositoriesService_Snapshot(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":1,"stargazers_count":5,"allow_squash_merge":true,"topics":["b","a"]}`)
+	})
+	mux.HandleFunc("/repos/o/r/branches", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"protected": "true", "per_page": "100"})
+		fmt.Fprint(w, `[{"name":"main"},{"name":"dev"}]`)
+	})
+	mux.HandleFunc("/repos/o/r/branches/main/protection", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"enforce_admins":{"enabled":true}}`)
+	})
+	mux.HandleFunc("/repos/o/r/branches/dev/protection", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"message":"Branch not protected"}`)
+	})
+	mux.HandleFunc("/repos/o/r/hooks", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id":2,"name":"web","active":true,"config":{"url":"https://b"}},{"id":1,"name":"web","config":{"url":"https://a"}}]`)
+	})
+
+	ctx := context.Background()
+	snap, _, err := client.Repositories.Snapshot(ctx, "o", "r")
+	if err != nil {
+		t.Errorf("Repositories.Snapshot returned error: %v", err)
+	}
+
+	want := &RepoSnapshot{
+		Settings: &Repository{AllowSquashMerge: Ptr(true)},
+		Topics:   []string{"a", "b"},
+		BranchProtection: map[string]*Protection{
+			"main": {EnforceAdmins: &AdminEnforcement{Enabled: true}},
+		},
+		Hooks: []*Hook{
+			{Name: Ptr("web"), Config: &HookConfig{URL: Ptr("https://a")}},
+			{Name: Ptr("web"), Active: Ptr(true), Config: &HookConfig{URL: Ptr("https://b")}},
+		},
+	}
+	if !cmp.Equal(snap, want) {
+		t.Errorf("Repositories.Snapshot returned %+v, want %+v", snap, want)
+	}
+
+	const methodName = "Snapshot"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.Snapshot(ctx, "\n", "\n")
+		return err
+	})
+}
+
+func TestRepositoriesService_Snapshot_error(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id":1}`)
+	})
+	mux.HandleFunc("/repos/o/r/branches", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[]`)
+	})
+	mux.HandleFunc("/repos/o/r/hooks", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	_, resp, err := client.Repositories.Snapshot(context.Background(), "o", "r")
+	if err == nil {
+		t.Fatal("Repositories.Snapshot returned no error, want one")
+	}
+	if got, want := resp.StatusCode, http.StatusNotFound; got != want {
+		t.Errorf("Repositories.Snapshot returned status %v, want %v", got, want)
+	}
+}
+
+func TestRepositoriesService_Snapshot_protectionError(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id":1}`)
+	})
+	mux.HandleFunc("/repos/o/r/branches", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"name":"main"}]`)
+	})
+	mux.HandleFunc("/repos/o/r/branches/main/protection", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+	mux.HandleFunc("/repos/o/r/hooks", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[]`)
+	})
+
+	snap, _, err := client.Repositories.Snapshot(context.Background(), "o", "r")
+	if err == nil {
+		t.Fatal("Repositories.Snapshot returned no error, want one")
+	}
+	if snap != nil {
+		t.Errorf("Repositories.Snapshot returned %+v, want nil", snap)
+	}
+}
+
+func TestRepoSnapshot_Diff(t *testing.T) {
+	t.Parallel()
+	old := &RepoSnapshot{
+		Settings: &Repository{AllowSquashMerge: Ptr(true), HasWiki: Ptr(true)},
+		Topics:   []string{"a"},
+		BranchProtection: map[string]*Protection{
+			"main": {EnforceAdmins: &AdminEnforcement{Enabled: true}},
+		},
+	}
+	current := &RepoSnapshot{
+		Settings: &Repository{AllowSquashMerge: Ptr(false), HasWiki: Ptr(true)},
+		Topics:   []string{"a", "b"},
+		BranchProtection: map[string]*Protection{
+			"main": {EnforceAdmins: &AdminEnforcement{Enabled: true}},
+			"dev":  {AllowForcePushes: &AllowForcePushes{Enabled: false}},
+		},
+	}
+
+	changes, err := old.Diff(current)
+	if err != nil {
+		t.Fatalf("RepoSnapshot.Diff returned error: %v", err)
+	}
+	want := []*RepoSnapshotChange{
+		{Path: "branch_protection.dev.allow_force_pushes.enabled", New: "false"},
+		{Path: "settings.allow_squash_merge", Old: "true", New: "false"},
+		{Path: "topics", Old: `["a"]`, New: `["a","b"]`},
+	}
+	if !cmp.Equal(changes, want) {
+		t.Errorf("RepoSnapshot.Diff returned %v", cmp.Diff(want, changes))
+	}
+
+	changes, err = old.Diff(old)
+	if err != nil {
+		t.Fatalf("RepoSnapshot.Diff returned error: %v", err)
+	}
+	if len(changes) != 0 {
+		t.Errorf("RepoSnapshot.Diff of equal snapshots returned %v, want none", changes)
+	}
+
+	changes, err = (*RepoSnapshot)(nil).Diff(&RepoSnapshot{Topics: []string{"a"}})
+	if err != nil {
+		t.Fatalf("RepoSnapshot.Diff returned error: %v", err)
+	}
+	want = []*RepoSnapshotChange{{Path: "topics", New: `["a"]`}}
+	if !cmp.Equal(changes, want) {
+		t.Errorf("RepoSnapshot.Diff returned %v", cmp.Diff(want, changes))
+	}
+}
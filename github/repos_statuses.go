@@ -7,7 +7,9 @@ package github
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sync"
 )
 
 // RepoStatus represents the status of a repository at a particular reference.
@@ -50,7 +52,7 @@ func (r RepoStatus) String() string {
 //meta:operation GET /repos/{owner}/{repo}/commits/{ref}/statuses
 func (s *RepositoriesService) ListStatuses(ctx context.Context, owner, repo, ref string, opts *ListOptions) ([]*RepoStatus, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/commits/%v/statuses", owner, repo, refURLEscape(ref))
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -110,6 +112,41 @@ func (s CombinedStatus) String() string {
 	return Stringify(s)
 }
 
+// RollupStatuses returns the combined state of statuses the way GitHub
+// computes CombinedStatus.State: "failure" if any status is "error" or
+// "failure", otherwise "pending" if any status is "pending" or there are no
+// statuses, and "success" otherwise.
+//
+// Only the latest status of each context counts. statuses are assumed to be
+// ordered newest first, as returned by ListStatuses, so later entries for a
+// context that was already seen are ignored.
+func RollupStatuses(statuses []*RepoStatus) string {
+	seen := make(map[string]bool)
+	var failed, pending bool
+	for _, s := range statuses {
+		if s == nil || seen[s.GetContext()] {
+			continue
+		}
+		seen[s.GetContext()] = true
+		switch s.GetState() {
+		case "error", "failure":
+			failed = true
+		case "success":
+		default:
+			pending = true
+		}
+	}
+
+	switch {
+	case failed:
+		return "failure"
+	case pending, len(seen) == 0:
+		return "pending"
+	default:
+		return "success"
+	}
+}
+
 // GetCombinedStatus returns the combined status of a repository at the specified
 // reference. ref can be a SHA, a branch name, or a tag name.
 //
@@ -118,7 +155,7 @@ func (s CombinedStatus) String() string {
 //meta:operation GET /repos/{owner}/{repo}/commits/{ref}/status
 func (s *RepositoriesService) GetCombinedStatus(ctx context.Context, owner, repo, ref string, opts *ListOptions) (*CombinedStatus, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/commits/%v/status", owner, repo, refURLEscape(ref))
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -136,3 +173,256 @@ func (s *RepositoriesService) GetCombinedStatus(ctx context.Context, owner, repo
 
 	return status, resp, nil
 }
+
+// setStatusesConcurrency is the maximum number of statuses SetStatuses
+// creates at a time.
+const setStatusesConcurrency = 4
+
+// SetStatuses sets a commit status for each of statuses on the commit with
+// SHA ref, skipping those whose context already has a status with the same
+// state, description, and target URL, so that CI systems posting many
+// statuses do not repost identical ones. The existing statuses are read from
+// the combined status of ref, and the remaining ones are created with at most
+// a few requests at a time. If several statuses have the same context, only
+// the last is set.
+//
+// It returns the statuses that were created, in the order of statuses. If
+// creating any of them fails, the statuses created so far are returned with
+// the first error and its response.
+//
+// GitHub API docs: https://docs.github.com/rest/commits/statuses#create-a-commit-status
+// GitHub API docs: https://docs.github.com/rest/commits/statuses#get-the-combined-status-for-a-specific-reference
+//
+//meta:operation GET /repos/{owner}/{repo}/commits/{ref}/status
+//meta:operation POST /repos/{owner}/{repo}/statuses/{sha}
+func (s *RepositoriesService) SetStatuses(ctx context.Context, owner, repo, ref string, statuses []*RepoStatus) ([]*RepoStatus, *Response, error) {
+	existing := map[string]*RepoStatus{}
+	opts := &ListOptions{PerPage: 100}
+	var resp *Response
+	for {
+		var (
+			page *CombinedStatus
+			err  error
+		)
+		page, resp, err = s.GetCombinedStatus(ctx, owner, repo, ref, opts)
+		if err != nil {
+			return nil, resp, err
+		}
+		for _, status := range page.Statuses {
+			existing[status.GetContext()] = status
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	// Keep the last status for each context, in the order given.
+	last := map[string]int{}
+	for i, status := range statuses {
+		last[statusContext(status)] = i
+	}
+	var pending []int
+	for i, status := range statuses {
+		if last[statusContext(status)] != i || sameStatus(existing[statusContext(status)], status) {
+			continue
+		}
+		pending = append(pending, i)
+	}
+
+	created := make([]*RepoStatus, len(statuses))
+	var (
+		mu       sync.Mutex
+		failResp *Response
+	)
+	errs := ForEachBounded(ctx, pending, setStatusesConcurrency, func(ctx context.Context, i int) error {
+		status, resp, err := s.CreateStatus(ctx, owner, repo, ref, statuses[i])
+		if err != nil {
+			mu.Lock()
+			if failResp == nil {
+				failResp = resp
+			}
+			mu.Unlock()
+			return err
+		}
+		created[i] = status
+		return nil
+	})
+
+	var changed []*RepoStatus
+	for _, status := range created {
+		if status != nil {
+			changed = append(changed, status)
+		}
+	}
+	for _, err := range errs {
+		if err != nil {
+			return changed, failResp, err
+		}
+	}
+
+	return changed, resp, nil
+}
+
+// statusContext returns the context of status, which GitHub defaults to
+// "default".
+func statusContext(status *RepoStatus) string {
+	if status.GetContext() == "" {
+		return "default"
+	}
+	return status.GetContext()
+}
+
+// sameStatus reports whether the existing status already has the state,
+// description, and target URL of want.
+func sameStatus(existing, want *RepoStatus) bool {
+	return existing != nil &&
+		existing.GetState() == want.GetState() &&
+		existing.GetDescription() == want.GetDescription() &&
+		existing.GetTargetURL() == want.GetTargetURL()
+}
+
+// AwaitOptions specifies the optional parameters to the
+// RepositoriesService.AwaitRequiredChecks method.
+type AwaitOptions struct {
+	// Branch is the protected branch whose required status checks are
+	// awaited. Default: the ref being checked.
+	Branch string
+
+	WaitOptions
+}
+
+// AwaitRequiredChecks waits until every status check required by the branch
+// protection of opts.Branch has finished for ref, and returns the commit
+// statuses and check runs reported for ref at that point.
+//
+// A required check is satisfied either by a commit status with that context,
+// as set via CreateStatus, or by a check run with that name from the required
+// GitHub App, if any. It has finished once the commit status is no longer
+// "pending" or all such check runs are "completed"; whether it succeeded is
+// up to the caller to inspect. If the branch is not protected, no checks are
+// required and the current results are returned immediately.
+//
+// The checks are polled as described by opts. If ctx is done or opts.Timeout
+// elapses first, the context's error is returned along with the last response.
+//
+// GitHub API docs: https://docs.github.com/rest/branches/branch-protection#get-status-checks-protection
+// GitHub API docs: https://docs.github.com/rest/checks/runs#list-check-runs-for-a-git-reference
+// GitHub API docs: https://docs.github.com/rest/commits/statuses#get-the-combined-status-for-a-specific-reference
+//
+//meta:operation GET /repos/{owner}/{repo}/branches/{branch}/protection/required_status_checks
+//meta:operation GET /repos/{owner}/{repo}/commits/{ref}/check-runs
+//meta:operation GET /repos/{owner}/{repo}/commits/{ref}/status
+func (s *RepositoriesService) AwaitRequiredChecks(ctx context.Context, owner, repo, ref string, opts AwaitOptions) (*CombinedStatus, *ListCheckRunsResults, *Response, error) {
+	branch := opts.Branch
+	if branch == "" {
+		branch = ref
+	}
+
+	required, resp, err := s.GetRequiredStatusChecks(ctx, owner, repo, branch)
+	if err != nil && !errors.Is(err, ErrBranchNotProtected) {
+		return nil, nil, resp, err
+	}
+	checks := requiredChecks(required)
+
+	var status *CombinedStatus
+	var runs *ListCheckRunsResults
+	err = s.client.poll(ctx, opts.WaitOptions, func(ctx context.Context) (bool, error) {
+		var err error
+		status, runs, resp, err = s.listStatusesAndCheckRuns(ctx, owner, repo, ref)
+		if err != nil {
+			return false, err
+		}
+		for _, check := range checks {
+			if !requiredCheckFinished(check, status, runs) {
+				return false, nil
+			}
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, nil, resp, err
+	}
+
+	return status, runs, resp, nil
+}
+
+// requiredChecks returns the checks listed in r, whether given as checks or
+// as legacy contexts.
+func requiredChecks(r *RequiredStatusChecks) []*RequiredStatusCheck {
+	if r == nil {
+		return nil
+	}
+	if r.Checks != nil {
+		return *r.Checks
+	}
+	var checks []*RequiredStatusCheck
+	if r.Contexts != nil {
+		for _, c := range *r.Contexts {
+			checks = append(checks, &RequiredStatusCheck{Context: c})
+		}
+	}
+	return checks
+}
+
+// requiredCheckFinished reports whether check has finished according to
+// either the commit statuses or the check runs of a ref.
+func requiredCheckFinished(check *RequiredStatusCheck, status *CombinedStatus, runs *ListCheckRunsResults) bool {
+	for _, s := range status.Statuses {
+		if s.GetContext() == check.Context && s.GetState() != "pending" {
+			return true
+		}
+	}
+
+	found := false
+	for _, run := range runs.CheckRuns {
+		if run.GetName() != check.Context {
+			continue
+		}
+		if appID := check.GetAppID(); appID != 0 && appID != -1 && run.GetApp().GetID() != appID {
+			continue
+		}
+		if run.GetStatus() != "completed" {
+			return false
+		}
+		found = true
+	}
+	return found
+}
+
+// listStatusesAndCheckRuns fetches all pages of the combined status and the
+// check runs of a ref.
+func (s *RepositoriesService) listStatusesAndCheckRuns(ctx context.Context, owner, repo, ref string) (*CombinedStatus, *ListCheckRunsResults, *Response, error) {
+	var status *CombinedStatus
+	statusOpts := &ListOptions{PerPage: 100}
+	for {
+		page, resp, err := s.GetCombinedStatus(ctx, owner, repo, ref, statusOpts)
+		if err != nil {
+			return nil, nil, resp, err
+		}
+		if status == nil {
+			status = page
+		} else {
+			status.Statuses = append(status.Statuses, page.Statuses...)
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		statusOpts.Page = resp.NextPage
+	}
+
+	runs := &ListCheckRunsResults{}
+	runOpts := &ListCheckRunsOptions{ListOptions: ListOptions{PerPage: 100}}
+	for {
+		page, resp, err := s.client.Checks.ListCheckRunsForRef(ctx, owner, repo, ref, runOpts)
+		if err != nil {
+			return nil, nil, resp, err
+		}
+		runs.Total = page.Total
+		runs.CheckRuns = append(runs.CheckRuns, page.CheckRuns...)
+		if resp.NextPage == 0 {
+			return status, runs, resp, nil
+		}
+		runOpts.Page = resp.NextPage
+	}
+}
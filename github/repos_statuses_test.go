@@ -8,9 +8,13 @@ package github
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
+	"sort"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 )
@@ -150,6 +154,95 @@ func TestRepositoriesService_GetCombinedStatus(t *testing.T) {
 	})
 }
 
+func TestRepositoriesService_SetStatuses(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/commits/s/status", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"per_page": "100"})
+		fmt.Fprint(w, `{"statuses":[
+			{"context":"build","state":"success","description":"ok"},
+			{"context":"lint","state":"pending"},
+			{"context":"default","state":"success"}
+		]}`)
+	})
+	var (
+		mu     sync.Mutex
+		posted []string
+	)
+	mux.HandleFunc("/repos/o/r/statuses/s", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		v := new(RepoStatus)
+		assertNilError(t, json.NewDecoder(r.Body).Decode(v))
+		mu.Lock()
+		posted = append(posted, v.GetContext()+"="+v.GetState())
+		mu.Unlock()
+		fmt.Fprintf(w, `{"context":%q,"state":%q}`, v.GetContext(), v.GetState())
+	})
+
+	ctx := context.Background()
+	statuses := []*RepoStatus{
+		{Context: Ptr("build"), State: Ptr("success"), Description: Ptr("ok")},
+		{Context: Ptr("lint"), State: Ptr("failure")},
+		{Context: Ptr("test"), State: Ptr("pending")},
+		{Context: Ptr("test"), State: Ptr("success")},
+		{State: Ptr("success")},
+	}
+	changed, _, err := client.Repositories.SetStatuses(ctx, "o", "r", "s", statuses)
+	if err != nil {
+		t.Fatalf("Repositories.SetStatuses returned error: %v", err)
+	}
+
+	want := []*RepoStatus{
+		{Context: Ptr("lint"), State: Ptr("failure")},
+		{Context: Ptr("test"), State: Ptr("success")},
+	}
+	if !cmp.Equal(changed, want) {
+		t.Errorf("Repositories.SetStatuses returned %+v, want %+v", changed, want)
+	}
+	sort.Strings(posted)
+	if want := []string{"lint=failure", "test=success"}; !cmp.Equal(posted, want) {
+		t.Errorf("Repositories.SetStatuses posted %v, want %v", posted, want)
+	}
+
+	const methodName = "SetStatuses"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.SetStatuses(ctx, "\n", "\n", "\n", statuses)
+		return err
+	})
+}
+
+func TestRepositoriesService_SetStatuses_createError(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/commits/s/status", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"statuses":[]}`)
+	})
+	mux.HandleFunc("/repos/o/r/statuses/s", func(w http.ResponseWriter, r *http.Request) {
+		v := new(RepoStatus)
+		assertNilError(t, json.NewDecoder(r.Body).Decode(v))
+		if v.GetContext() == "bad" {
+			http.Error(w, `{"message":"Validation Failed"}`, http.StatusUnprocessableEntity)
+			return
+		}
+		fmt.Fprintf(w, `{"context":%q}`, v.GetContext())
+	})
+
+	statuses := []*RepoStatus{{Context: Ptr("good"), State: Ptr("success")}, {Context: Ptr("bad"), State: Ptr("bogus")}}
+	changed, resp, err := client.Repositories.SetStatuses(context.Background(), "o", "r", "s", statuses)
+	if err == nil {
+		t.Fatal("Repositories.SetStatuses returned no error, want one")
+	}
+	if got, want := resp.StatusCode, http.StatusUnprocessableEntity; got != want {
+		t.Errorf("Repositories.SetStatuses returned status %v, want %v", got, want)
+	}
+	if want := []*RepoStatus{{Context: Ptr("good")}}; !cmp.Equal(changed, want) {
+		t.Errorf("Repositories.SetStatuses returned %+v, want %+v", changed, want)
+	}
+}
+
 func TestRepoStatus_Marshal(t *testing.T) {
 	t.Parallel()
 	testJSONMarshal(t, &RepoStatus{}, "{}")
@@ -243,3 +336,137 @@ func TestCombinedStatus_Marshal(t *testing.T) {
 
 	testJSONMarshal(t, u, want)
 }
+
+func TestRepositoriesService_AwaitRequiredChecks(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/branches/main/protection/required_status_checks", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"checks":[{"context":"ci/legacy"},{"context":"build","app_id":7}]}`)
+	})
+	calls := 0
+	mux.HandleFunc("/repos/o/r/commits/s/status", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"per_page": "100"})
+		calls++
+		if calls < 2 {
+			fmt.Fprint(w, `{"state":"pending","statuses":[{"context":"ci/legacy","state":"pending"}]}`)
+			return
+		}
+		fmt.Fprint(w, `{"state":"failure","statuses":[{"context":"ci/legacy","state":"failure"}]}`)
+	})
+	mux.HandleFunc("/repos/o/r/commits/s/check-runs", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if calls < 3 {
+			fmt.Fprint(w, `{"total_count":2,"check_runs":[
+				{"id":1,"name":"build","status":"completed","conclusion":"success","app":{"id":8}},
+				{"id":2,"name":"build","status":"in_progress","app":{"id":7}}]}`)
+			return
+		}
+		fmt.Fprint(w, `{"total_count":2,"check_runs":[
+			{"id":1,"name":"build","status":"completed","conclusion":"success","app":{"id":8}},
+			{"id":2,"name":"build","status":"completed","conclusion":"success","app":{"id":7}}]}`)
+	})
+
+	ctx := context.Background()
+	status, runs, _, err := client.Repositories.AwaitRequiredChecks(ctx, "o", "r", "s", AwaitOptions{Branch: "main", WaitOptions: WaitOptions{Interval: time.Millisecond}})
+	if err != nil {
+		t.Fatalf("Repositories.AwaitRequiredChecks returned error: %v", err)
+	}
+	if calls != 3 {
+		t.Errorf("Repositories.AwaitRequiredChecks polled %v times, want 3", calls)
+	}
+	if got, want := status.GetState(), "failure"; got != want {
+		t.Errorf("Repositories.AwaitRequiredChecks returned state %v, want %v", got, want)
+	}
+	if got, want := runs.CheckRuns[1].GetConclusion(), "success"; got != want {
+		t.Errorf("Repositories.AwaitRequiredChecks returned conclusion %v, want %v", got, want)
+	}
+}
+
+func TestRepositoriesService_AwaitRequiredChecks_notProtected(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/branches/s/protection/required_status_checks", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprintf(w, `{"message": %q}`, githubBranchNotProtected)
+	})
+	mux.HandleFunc("/repos/o/r/commits/s/status", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"state":"pending"}`)
+	})
+	mux.HandleFunc("/repos/o/r/commits/s/check-runs", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"total_count":1,"check_runs":[{"id":1,"status":"queued"}]}`)
+	})
+
+	ctx := context.Background()
+	status, runs, _, err := client.Repositories.AwaitRequiredChecks(ctx, "o", "r", "s", AwaitOptions{})
+	if err != nil {
+		t.Fatalf("Repositories.AwaitRequiredChecks returned error: %v", err)
+	}
+
+	wantStatus := &CombinedStatus{State: Ptr("pending")}
+	if !cmp.Equal(status, wantStatus) {
+		t.Errorf("Repositories.AwaitRequiredChecks returned %+v, want %+v", status, wantStatus)
+	}
+	wantRuns := &ListCheckRunsResults{Total: Ptr(1), CheckRuns: []*CheckRun{{ID: Ptr(int64(1)), Status: Ptr("queued")}}}
+	if !cmp.Equal(runs, wantRuns) {
+		t.Errorf("Repositories.AwaitRequiredChecks returned %+v, want %+v", runs, wantRuns)
+	}
+}
+
+func TestRepositoriesService_AwaitRequiredChecks_timeout(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/branches/s/protection/required_status_checks", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"contexts":["ci"]}`)
+	})
+	mux.HandleFunc("/repos/o/r/commits/s/status", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"state":"pending"}`)
+	})
+	mux.HandleFunc("/repos/o/r/commits/s/check-runs", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"total_count":0}`)
+	})
+
+	ctx := context.Background()
+	_, _, _, err := client.Repositories.AwaitRequiredChecks(ctx, "o", "r", "s", AwaitOptions{WaitOptions: WaitOptions{Interval: time.Millisecond, Timeout: 20 * time.Millisecond}})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Repositories.AwaitRequiredChecks returned error %v, want %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestRollupStatuses(t *testing.T) {
+	t.Parallel()
+	status := func(context, state string) *RepoStatus {
+		return &RepoStatus{Context: Ptr(context), State: Ptr(state)}
+	}
+
+	tests := []struct {
+		name     string
+		statuses []*RepoStatus
+		want     string
+	}{
+		{"nil", nil, "pending"},
+		{"empty", []*RepoStatus{}, "pending"},
+		{"success", []*RepoStatus{status("a", "success"), status("b", "success")}, "success"},
+		{"pending", []*RepoStatus{status("a", "success"), status("b", "pending")}, "pending"},
+		{"failure", []*RepoStatus{status("a", "success"), status("b", "failure")}, "failure"},
+		{"error", []*RepoStatus{status("a", "error"), status("b", "success")}, "failure"},
+		{"failure over pending", []*RepoStatus{status("a", "pending"), status("b", "failure")}, "failure"},
+		{"error over pending", []*RepoStatus{status("a", "error"), status("b", "pending")}, "failure"},
+		{"unknown state is pending", []*RepoStatus{status("a", "success"), status("b", "")}, "pending"},
+		{"latest success overrides older failure", []*RepoStatus{status("a", "success"), status("a", "failure")}, "success"},
+		{"latest failure overrides older success", []*RepoStatus{status("a", "failure"), status("a", "success")}, "failure"},
+		{"latest pending overrides older success", []*RepoStatus{status("a", "pending"), status("a", "success"), status("b", "success")}, "pending"},
+		{"nil entries are skipped", []*RepoStatus{nil, status("a", "success")}, "success"},
+		{"only nil entries", []*RepoStatus{nil}, "pending"},
+	}
+
+	for _, tt := range tests {
+		if got := RollupStatuses(tt.statuses); got != tt.want {
+			t.Errorf("%v: RollupStatuses returned %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
@@ -701,6 +701,30 @@ func TestRepositoriesService_GetAutomatedSecurityFixes(t *testing.T) {
 	})
 }
 
+func TestRepositoriesService_GetAutomatedSecurityFixes_notEnabled(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/automated-security-fixes", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	ctx := context.Background()
+	fixes, _, err := client.Repositories.GetAutomatedSecurityFixes(ctx, "o", "r")
+	if err != nil {
+		t.Errorf("Repositories.GetAutomatedSecurityFixes returned error: %v", err)
+	}
+
+	want := &AutomatedSecurityFixes{
+		Enabled: Ptr(false),
+		Paused:  Ptr(false),
+	}
+	if !cmp.Equal(fixes, want) {
+		t.Errorf("Repositories.GetAutomatedSecurityFixes returned %+v, want %+v", fixes, want)
+	}
+}
+
 func TestRepositoriesService_DisableAutomatedSecurityFixes(t *testing.T) {
 	t.Parallel()
 	client, mux, _ := setup(t)
@@ -792,6 +816,53 @@ func TestRepositoriesService_ListLanguages(t *testing.T) {
 	})
 }
 
+func TestRepositoriesService_ListLanguagesSorted(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/languages", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"Shell":100,"Go":600,"C":100,"Python":200}`)
+	})
+
+	ctx := context.Background()
+	languages, _, err := client.Repositories.ListLanguagesSorted(ctx, "o", "r")
+	if err != nil {
+		t.Errorf("Repositories.ListLanguagesSorted returned error: %v", err)
+	}
+
+	want := []LanguageBytes{
+		{Language: "Go", Bytes: 600, Percent: 60},
+		{Language: "Python", Bytes: 200, Percent: 20},
+		{Language: "C", Bytes: 100, Percent: 10},
+		{Language: "Shell", Bytes: 100, Percent: 10},
+	}
+	if !cmp.Equal(languages, want) {
+		t.Errorf("Repositories.ListLanguagesSorted returned %+v, want %+v", languages, want)
+	}
+
+	const methodName = "ListLanguagesSorted"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.ListLanguagesSorted(ctx, "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Repositories.ListLanguagesSorted(ctx, "o", "r")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestSortLanguages_empty(t *testing.T) {
+	t.Parallel()
+	if got := sortLanguages(map[string]int{"Go": 0}); !cmp.Equal(got, []LanguageBytes{{Language: "Go"}}) {
+		t.Errorf("sortLanguages returned %+v, want a zero percentage", got)
+	}
+}
+
 func TestRepositoriesService_ListTeams(t *testing.T) {
 	t.Parallel()
 	client, mux, _ := setup(t)
@@ -876,6 +947,62 @@ func TestRepositoriesService_ListTags(t *testing.T) {
 	})
 }
 
+func TestRepositoriesService_GetLatestSemverTag(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/tags", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if r.FormValue("page") == "" {
+			w.Header().Set("Link", `<https://api.github.com/repos/o/r/tags?page=2>; rel="next"`)
+			fmt.Fprint(w, `[{"name":"latest"},{"name":"v1.9.0"},{"name":"v2.0.0-rc.1"}]`)
+			return
+		}
+		fmt.Fprint(w, `[{"name":"v1.10.0"},{"name":"1.2.3"},{"name":"v01.0.0"}]`)
+	})
+
+	ctx := context.Background()
+	tag, _, err := client.Repositories.GetLatestSemverTag(ctx, "o", "r", nil)
+	if err != nil {
+		t.Fatalf("Repositories.GetLatestSemverTag returned error: %v", err)
+	}
+	if got, want := tag.GetName(), "v1.10.0"; got != want {
+		t.Errorf("Repositories.GetLatestSemverTag returned %q, want %q", got, want)
+	}
+
+	tag, _, err = client.Repositories.GetLatestSemverTag(ctx, "o", "r", &LatestSemverTagOptions{IncludePrereleases: true})
+	if err != nil {
+		t.Fatalf("Repositories.GetLatestSemverTag returned error: %v", err)
+	}
+	if got, want := tag.GetName(), "v2.0.0-rc.1"; got != want {
+		t.Errorf("Repositories.GetLatestSemverTag returned %q, want %q", got, want)
+	}
+
+	const methodName = "GetLatestSemverTag"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.GetLatestSemverTag(ctx, "\n", "\n", nil)
+		return err
+	})
+}
+
+func TestRepositoriesService_GetLatestSemverTag_none(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/tags", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"name":"nightly"}]`)
+	})
+
+	ctx := context.Background()
+	tag, _, err := client.Repositories.GetLatestSemverTag(ctx, "o", "r", nil)
+	if err != nil {
+		t.Fatalf("Repositories.GetLatestSemverTag returned error: %v", err)
+	}
+	if tag != nil {
+		t.Errorf("Repositories.GetLatestSemverTag returned %+v, want nil", tag)
+	}
+}
+
 func TestRepositoriesService_ListBranches(t *testing.T) {
 	t.Parallel()
 	client, mux, _ := setup(t)
@@ -916,6 +1043,52 @@ func TestRepositoriesService_ListBranches(t *testing.T) {
 	})
 }
 
+func TestRepositoriesService_IsEmpty(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/empty/branches", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"per_page": "1"})
+		fmt.Fprint(w, `[]`)
+	})
+	mux.HandleFunc("/repos/o/r/branches", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"name":"main"}]`)
+	})
+
+	ctx := context.Background()
+	empty, _, err := client.Repositories.IsEmpty(ctx, "o", "empty")
+	if err != nil {
+		t.Errorf("Repositories.IsEmpty returned error: %v", err)
+	}
+	if !empty {
+		t.Errorf("Repositories.IsEmpty returned false for a repository without branches, want true")
+	}
+
+	empty, _, err = client.Repositories.IsEmpty(ctx, "o", "r")
+	if err != nil {
+		t.Errorf("Repositories.IsEmpty returned error: %v", err)
+	}
+	if empty {
+		t.Errorf("Repositories.IsEmpty returned true for a repository with branches, want false")
+	}
+
+	const methodName = "IsEmpty"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.IsEmpty(ctx, "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Repositories.IsEmpty(ctx, "o", "r")
+		if got {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want false", methodName, got)
+		}
+		return resp, err
+	})
+}
+
 func TestRepositoriesService_GetBranch(t *testing.T) {
 	t.Parallel()
 	client, mux, _ := setup(t)
@@ -1425,6 +1598,82 @@ func TestRepositoriesService_GetBranchProtection_branchNotProtected(t *testing.T
 	}
 }
 
+func TestRepositoriesService_ListProtectedBranches(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/branches", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if got := r.FormValue("protected"); got != "true" {
+			t.Errorf("protected = %q, want true", got)
+		}
+		if r.FormValue("page") == "" {
+			w.Header().Set("Link", `<https://api.github.com/?page=2>; rel="next"`)
+			fmt.Fprint(w, `[{"name":"main","protected":true}]`)
+			return
+		}
+		fmt.Fprint(w, `[{"name":"release","protected":true}]`)
+	})
+
+	ctx := context.Background()
+	branches, _, err := client.Repositories.ListProtectedBranches(ctx, "o", "r")
+	if err != nil {
+		t.Errorf("Repositories.ListProtectedBranches returned error: %v", err)
+	}
+
+	want := []*Branch{
+		{Name: Ptr("main"), Protected: Ptr(true)},
+		{Name: Ptr("release"), Protected: Ptr(true)},
+	}
+	if !cmp.Equal(branches, want) {
+		t.Errorf("Repositories.ListProtectedBranches returned %+v, want %+v", branches, want)
+	}
+
+	const methodName = "ListProtectedBranches"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.ListProtectedBranches(ctx, "\n", "\n")
+		return err
+	})
+}
+
+func TestRepositoriesService_GetProtectionForAll(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/branches", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"name":"a","protected":true},{"name":"b","protected":true},{"name":"c","protected":true}]`)
+	})
+	mux.HandleFunc("/repos/o/r/branches/a/protection", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"enforce_admins":{"enabled":true}}`)
+	})
+	mux.HandleFunc("/repos/o/r/branches/b/protection", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprintf(w, `{"message": %q}`, githubBranchNotProtected)
+	})
+	mux.HandleFunc("/repos/o/r/branches/c/protection", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	ctx := context.Background()
+	protections, _, err := client.Repositories.GetProtectionForAll(ctx, "o", "r")
+
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) || errResp.Response.StatusCode != http.StatusInternalServerError {
+		t.Errorf("Repositories.GetProtectionForAll returned error %v, want a 500 *ErrorResponse", err)
+	}
+	if err != nil && !strings.Contains(err.Error(), "branch c") {
+		t.Errorf("Repositories.GetProtectionForAll error %q does not name branch c", err)
+	}
+
+	want := map[string]*Protection{
+		"a": {EnforceAdmins: &AdminEnforcement{Enabled: true}},
+	}
+	if !cmp.Equal(protections, want) {
+		t.Errorf("Repositories.GetProtectionForAll returned %+v, want %+v", protections, want)
+	}
+}
+
 func TestRepositoriesService_UpdateBranchProtection_Contexts(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -4605,3 +4854,48 @@ func TestRepository_UnmarshalJSON(t *testing.T) {
 		})
 	}
 }
+
+func TestSplitFullName(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		full      string
+		wantOwner string
+		wantRepo  string
+		wantErr   bool
+	}{
+		{full: "google/go-github", wantOwner: "google", wantRepo: "go-github"},
+		{full: "", wantErr: true},
+		{full: "google", wantErr: true},
+		{full: "/go-github", wantErr: true},
+		{full: "google/", wantErr: true},
+		{full: "google/go-github/v69", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		owner, repo, err := SplitFullName(tt.full)
+		if owner != tt.wantOwner || repo != tt.wantRepo {
+			t.Errorf("SplitFullName(%q) = (%q, %q), want (%q, %q)", tt.full, owner, repo, tt.wantOwner, tt.wantRepo)
+		}
+		var slugErr *InvalidSlugError
+		if tt.wantErr != errors.As(err, &slugErr) {
+			t.Errorf("SplitFullName(%q) returned error %v, want *InvalidSlugError: %v", tt.full, err, tt.wantErr)
+		}
+	}
+}
+
+func TestRepositoriesService_fullNameAsOwner(t *testing.T) {
+	t.Parallel()
+	client, _, _ := setup(t)
+
+	ctx := context.Background()
+	_, _, err := client.Repositories.Get(ctx, "o/r", "")
+	var slugErr *InvalidSlugError
+	if !errors.As(err, &slugErr) || slugErr.Slug != "o/r" {
+		t.Errorf("Repositories.Get returned error %v, want *InvalidSlugError for o/r", err)
+	}
+
+	_, _, err = client.Repositories.ListBranches(ctx, "o/r", "", nil)
+	if !errors.As(err, &slugErr) {
+		t.Errorf("Repositories.ListBranches returned error %v, want *InvalidSlugError", err)
+	}
+}
@@ -8,6 +8,7 @@ package github
 import (
 	"context"
 	"fmt"
+	"time"
 )
 
 // TrafficReferrer represent information about traffic from a referrer .
@@ -52,6 +53,33 @@ type TrafficBreakdownOptions struct {
 	Per string `url:"per,omitempty"`
 }
 
+// SumTrafficData adds up the Count and Uniques of the data points in data whose
+// Timestamp falls within [since, until). A zero since or until leaves that end
+// of the range unbounded. It can be used to total the per-day or per-week
+// breakdown returned by ListTrafficViews and ListTrafficClones over a custom
+// date range.
+//
+// Note that the same visitor may be counted as unique in more than one data
+// point, so the summed uniques is an upper bound on the number of distinct
+// visitors over the range.
+func SumTrafficData(data []*TrafficData, since, until time.Time) (count, uniques int) {
+	for _, d := range data {
+		if d == nil {
+			continue
+		}
+		ts := d.GetTimestamp().Time
+		if !since.IsZero() && ts.Before(since) {
+			continue
+		}
+		if !until.IsZero() && !ts.Before(until) {
+			continue
+		}
+		count += d.GetCount()
+		uniques += d.GetUniques()
+	}
+	return count, uniques
+}
+
 // ListTrafficReferrers list the top 10 referrers over the last 14 days.
 //
 // GitHub API docs: https://docs.github.com/rest/metrics/traffic#get-top-referral-sources
@@ -103,7 +131,7 @@ func (s *RepositoriesService) ListTrafficPaths(ctx context.Context, owner, repo
 //meta:operation GET /repos/{owner}/{repo}/traffic/views
 func (s *RepositoriesService) ListTrafficViews(ctx context.Context, owner, repo string, opts *TrafficBreakdownOptions) (*TrafficViews, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/traffic/views", owner, repo)
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -129,7 +157,7 @@ func (s *RepositoriesService) ListTrafficViews(ctx context.Context, owner, repo
 //meta:operation GET /repos/{owner}/{repo}/traffic/clones
 func (s *RepositoriesService) ListTrafficClones(ctx context.Context, owner, repo string, opts *TrafficBreakdownOptions) (*TrafficClones, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/traffic/clones", owner, repo)
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -328,3 +328,37 @@ func TestTrafficBreakdownOptions_Marshal(t *testing.T) {
 
 	testJSONMarshal(t, u, want)
 }
+
+func TestSumTrafficData(t *testing.T) {
+	t.Parallel()
+	day := func(d int) *Timestamp {
+		return &Timestamp{time.Date(2016, time.May, d, 0, 0, 0, 0, time.UTC)}
+	}
+	data := []*TrafficData{
+		{Timestamp: day(1), Count: Ptr(1), Uniques: Ptr(1)},
+		{Timestamp: day(2), Count: Ptr(2), Uniques: Ptr(1)},
+		nil,
+		{Timestamp: day(3), Count: Ptr(4), Uniques: Ptr(3)},
+		{Timestamp: day(4)},
+	}
+
+	tests := []struct {
+		name         string
+		since, until time.Time
+		wantCount    int
+		wantUniques  int
+	}{
+		{name: "unbounded", wantCount: 7, wantUniques: 5},
+		{name: "since", since: day(2).Time, wantCount: 6, wantUniques: 4},
+		{name: "until is exclusive", until: day(3).Time, wantCount: 3, wantUniques: 2},
+		{name: "since and until", since: day(2).Time, until: day(3).Time, wantCount: 2, wantUniques: 1},
+		{name: "empty range", since: day(5).Time, wantCount: 0, wantUniques: 0},
+	}
+
+	for _, tt := range tests {
+		count, uniques := SumTrafficData(data, tt.since, tt.until)
+		if count != tt.wantCount || uniques != tt.wantUniques {
+			t.Errorf("%v: SumTrafficData returned (%v, %v), want (%v, %v)", tt.name, count, uniques, tt.wantCount, tt.wantUniques)
+		}
+	}
+}
@@ -0,0 +1,136 @@
+// Copyright 2026 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// IsRetryable reports whether the operation that returned err may succeed if
+// it is attempted again unchanged. It returns true for:
+//
+//   - server errors (5xx) and 429 Too Many Requests responses,
+//   - secondary rate limits (*AbuseRateLimitError) and primary rate limits
+//     (*RateLimitError), which should be retried only after RetryAfter or
+//     Rate.Reset respectively,
+//   - *AcceptedError, which means GitHub is still computing the result,
+//   - network errors, such as timeouts, refused or reset connections, and
+//     connections closed before the response was complete.
+//
+// It returns false for nil, for all other error responses, such as 4xx
+// validation errors, for errors detected before a request is sent, such as
+// *InvalidEnumError, for TLS certificate errors, and for canceled or expired
+// contexts. err may wrap any of these, as checked with errors.As.
+func IsRetryable(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	var (
+		abuseErr    *AbuseRateLimitError
+		rateErr     *RateLimitError
+		acceptedErr *AcceptedError
+		errResp     *ErrorResponse
+		unknownCA   x509.UnknownAuthorityError
+		invalidCert x509.CertificateInvalidError
+		hostnameErr x509.HostnameError
+		netErr      net.Error
+	)
+	switch {
+	case errors.As(err, &abuseErr), errors.As(err, &rateErr), errors.As(err, &acceptedErr):
+		return true
+	case errors.As(err, &errResp):
+		if errResp.Response == nil {
+			return false
+		}
+		code := errResp.Response.StatusCode
+		return code >= http.StatusInternalServerError || code == http.StatusTooManyRequests
+	case errors.As(err, &unknownCA), errors.As(err, &invalidCert), errors.As(err, &hostnameErr):
+		return false
+	case errors.As(err, &netErr):
+		return true
+	}
+	return errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+// ErrRetryBudgetExhausted is returned by the WaitFor methods when they stop
+// polling because the client's retry budget is exhausted. See
+// Client.WithRetryBudget.
+var ErrRetryBudgetExhausted = errors.New("retry budget exhausted")
+
+// WithRetryBudget returns a copy of the client whose retries are limited to
+// about maxRetriesPerWindow per window, shared by every request made through
+// the client and any client derived from it. Once the budget is exhausted,
+// operations that would retry, such as waiting out a primary rate limit with
+// SleepUntilPrimaryRateLimitResetWhenRateLimited or UpdateRefWithRetry, return
+// their original error immediately instead, and each poll after the first made
+// by a WaitFor method, such as PullRequestsService.WaitForMergeCommit, counts
+// as a retry. The budget refills continuously,
+// so that a sustained outage cannot turn into an unbounded number of retries.
+//
+// A maxRetriesPerWindow of zero disables retries entirely. A negative
+// maxRetriesPerWindow or a window of zero or less removes the budget.
+func (c *Client) WithRetryBudget(maxRetriesPerWindow int, window time.Duration) *Client {
+	c2 := c.copy()
+	defer c2.initialize()
+	c2.retryBudget = nil
+	if maxRetriesPerWindow >= 0 && window > 0 {
+		c2.retryBudget = newRetryBudget(maxRetriesPerWindow, window)
+	}
+	return c2
+}
+
+// allowRetry reports whether the client's retry budget allows one more retry,
+// consuming it if so. It always returns true if the client has no budget.
+func (c *Client) allowRetry() bool {
+	if c.retryBudget == nil {
+		return true
+	}
+	return c.retryBudget.allow(time.Now())
+}
+
+// retryBudget is a token bucket holding up to max retries, refilled at
+// max per window.
+type retryBudget struct {
+	max    float64
+	perSec float64
+
+	mu     sync.Mutex
+	tokens float64
+	last   time.Time
+}
+
+func newRetryBudget(maxRetries int, window time.Duration) *retryBudget {
+	return &retryBudget{
+		max:    float64(maxRetries),
+		perSec: float64(maxRetries) / window.Seconds(),
+		tokens: float64(maxRetries),
+		last:   time.Now(),
+	}
+}
+
+// allow consumes a token at time now and reports whether one was available.
+func (b *retryBudget) allow(now time.Time) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if elapsed := now.Sub(b.last); elapsed > 0 {
+		b.tokens = min(b.max, b.tokens+elapsed.Seconds()*b.perSec)
+		b.last = now
+	}
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
@@ -0,0 +1,193 @@
+// Copyright 2026 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestIsRetryable(t *testing.T) {
+	t.Parallel()
+	status := func(code int) *ErrorResponse {
+		return &ErrorResponse{Response: &http.Response{StatusCode: code}}
+	}
+	netErr := &url.Error{Op: "Get", URL: "https://api.github.com", Err: &net.OpError{Op: "dial", Err: errors.New("connection refused")}}
+
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"nil", nil, false},
+		{"500", status(http.StatusInternalServerError), true},
+		{"502 wrapped", fmt.Errorf("creating tree: %w", status(http.StatusBadGateway)), true},
+		{"429", status(http.StatusTooManyRequests), true},
+		{"404", status(http.StatusNotFound), false},
+		{"422", status(http.StatusUnprocessableEntity), false},
+		{"no response", &ErrorResponse{}, false},
+		{"secondary rate limit", &AbuseRateLimitError{}, true},
+		{"primary rate limit", &RateLimitError{}, true},
+		{"accepted", &AcceptedError{}, true},
+		{"two-factor", &TwoFactorAuthError{Response: &http.Response{StatusCode: http.StatusUnauthorized}}, false},
+		{"invalid enum", &InvalidEnumError{Field: "content"}, false},
+		{"network", netErr, true},
+		{"unexpected EOF", &url.Error{Op: "Get", URL: "u", Err: io.ErrUnexpectedEOF}, true},
+		{"certificate", &url.Error{Op: "Get", URL: "u", Err: x509.UnknownAuthorityError{}}, false},
+		{"canceled", &url.Error{Op: "Get", URL: "u", Err: context.Canceled}, false},
+		{"deadline", context.DeadlineExceeded, false},
+		{"other", errors.New("boom"), false},
+	}
+
+	for _, tt := range tests {
+		if got := IsRetryable(tt.err); got != tt.want {
+			t.Errorf("IsRetryable(%v) = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestIsRetryable_responses(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		fmt.Fprint(w, `{"message":"unavailable"}`)
+	})
+	mux.HandleFunc("/repos/o/r/issues", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		fmt.Fprint(w, `{"message":"Validation Failed","errors":[{"resource":"Issue","field":"title","code":"missing_field"}]}`)
+	})
+
+	ctx := context.Background()
+	if _, _, err := client.Repositories.Get(ctx, "o", "r"); !IsRetryable(err) {
+		t.Errorf("IsRetryable(%v) = false, want true", err)
+	}
+	if _, _, err := client.Issues.Create(ctx, "o", "r", &IssueRequest{}); err == nil || IsRetryable(err) {
+		t.Errorf("IsRetryable(%v) = true, want false", err)
+	}
+}
+
+func TestRetryBudget_allow(t *testing.T) {
+	t.Parallel()
+	b := newRetryBudget(2, 10*time.Second)
+	now := b.last
+
+	for i, want := range []bool{true, true, false} {
+		if got := b.allow(now); got != want {
+			t.Errorf("allow #%v = %v, want %v", i, got, want)
+		}
+	}
+
+	// Half the window refills half the budget.
+	now = now.Add(5 * time.Second)
+	if !b.allow(now) {
+		t.Error("allow after refill = false, want true")
+	}
+	if b.allow(now) {
+		t.Error("allow after consuming refill = true, want false")
+	}
+
+	// The budget never holds more than its maximum.
+	now = now.Add(time.Hour)
+	for i, want := range []bool{true, true, false} {
+		if got := b.allow(now); got != want {
+			t.Errorf("allow #%v after a long pause = %v, want %v", i, got, want)
+		}
+	}
+}
+
+func TestWithRetryBudget(t *testing.T) {
+	t.Parallel()
+	c := NewClient(nil)
+	if !c.allowRetry() {
+		t.Error("allowRetry without a budget = false, want true")
+	}
+
+	if c.WithRetryBudget(0, time.Minute).allowRetry() {
+		t.Error("allowRetry with a zero budget = true, want false")
+	}
+	if !c.WithRetryBudget(0, time.Minute).WithRetryBudget(-1, time.Minute).allowRetry() {
+		t.Error("allowRetry after removing the budget = false, want true")
+	}
+
+	// Copies of a client share its budget.
+	c1 := c.WithRetryBudget(1, time.Hour)
+	c2 := c1.WithAuthToken("t")
+	if !c1.allowRetry() {
+		t.Error("allowRetry on c1 = false, want true")
+	}
+	if c2.allowRetry() {
+		t.Error("allowRetry on c2 after c1 spent the budget = true, want false")
+	}
+}
+
+func TestDo_rateLimit_retryBudgetExhausted(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+	client = client.WithRetryBudget(0, time.Minute)
+
+	requests := 0
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set(headerRateLimit, "60")
+		w.Header().Set(headerRateRemaining, "0")
+		w.Header().Set(headerRateUsed, "60")
+		w.Header().Set(headerRateReset, fmt.Sprint(time.Now().Add(time.Hour).Unix()))
+		w.Header().Set(headerRateResource, "core")
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"message": "API rate limit exceeded for xxx.xxx.xxx.xxx."}`)
+	})
+
+	req, _ := client.NewRequest("GET", ".", nil)
+	ctx := context.WithValue(context.Background(), SleepUntilPrimaryRateLimitResetWhenRateLimited, true)
+	_, err := client.Do(ctx, req, nil)
+
+	var rateLimitErr *RateLimitError
+	if !errors.As(err, &rateLimitErr) {
+		t.Fatalf("Do returned error %v, want *RateLimitError", err)
+	}
+	if requests != 1 {
+		t.Errorf("Do made %v requests, want 1", requests)
+	}
+}
+
+func TestGitService_UpdateRefWithRetry_retryBudgetExhausted(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+	client = client.WithRetryBudget(1, time.Hour)
+
+	patches := 0
+	mux.HandleFunc("/repos/o/r/git/refs/heads/b", func(w http.ResponseWriter, r *http.Request) {
+		patches++
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		fmt.Fprint(w, `{"message":"Update is not a fast forward"}`)
+	})
+	mux.HandleFunc("/repos/o/r/git/ref/heads/b", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"ref":"refs/heads/b","object":{"sha":"moved"}}`)
+	})
+
+	rebuild := func(currentSHA string) (UpdateRefRequest, error) {
+		return UpdateRefRequest{Ref: "heads/b", SHA: currentSHA}, nil
+	}
+
+	ctx := context.Background()
+	_, _, err := client.Git.UpdateRefWithRetry(ctx, "o", "r", UpdateRefRequest{Ref: "heads/b", SHA: "s"}, rebuild, 5)
+	if !isNotFastForward(err) {
+		t.Errorf("Git.UpdateRefWithRetry returned error %v, want the not fast forward error", err)
+	}
+	if patches != 2 {
+		t.Errorf("Git.UpdateRefWithRetry made %v updates, want 2", patches)
+	}
+}
@@ -8,8 +8,11 @@ package github
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"sort"
 	"strconv"
 	"strings"
+	"unicode/utf8"
 
 	qs "github.com/google/go-querystring/query"
 )
@@ -222,6 +225,74 @@ func (tm TextMatch) String() string {
 	return Stringify(tm)
 }
 
+// Highlight returns the fragment with each match wrapped in before and after,
+// e.g. Highlight("<em>", "</em>"). Matches whose indices do not locate their
+// text within the fragment, or that overlap an earlier match, are left as-is.
+// Indices are interpreted as byte offsets, falling back to character offsets
+// for fragments containing multi-byte characters.
+func (tm *TextMatch) Highlight(before, after string) string {
+	fragment := tm.GetFragment()
+
+	type span struct{ start, end int }
+	var spans []span
+	for _, m := range tm.Matches {
+		if start, end, ok := matchSpan(fragment, m); ok {
+			spans = append(spans, span{start, end})
+		}
+	}
+	sort.Slice(spans, func(i, j int) bool { return spans[i].start < spans[j].start })
+
+	var b strings.Builder
+	last := 0
+	for _, sp := range spans {
+		if sp.start < last {
+			continue
+		}
+		b.WriteString(fragment[last:sp.start])
+		b.WriteString(before)
+		b.WriteString(fragment[sp.start:sp.end])
+		b.WriteString(after)
+		last = sp.end
+	}
+	b.WriteString(fragment[last:])
+	return b.String()
+}
+
+// matchSpan returns the byte offsets of m within fragment.
+func matchSpan(fragment string, m *Match) (start, end int, ok bool) {
+	if m == nil || len(m.Indices) != 2 {
+		return 0, 0, false
+	}
+	start, end = m.Indices[0], m.Indices[1]
+	if start < 0 || start > end {
+		return 0, 0, false
+	}
+
+	found := func(start, end int) bool {
+		if end > len(fragment) {
+			return false
+		}
+		if m.Text != nil {
+			return fragment[start:end] == *m.Text
+		}
+		return utf8.ValidString(fragment[start:end])
+	}
+	if found(start, end) {
+		return start, end, true
+	}
+
+	// Convert character offsets to byte offsets.
+	offsets := make([]int, 0, len(fragment)+1)
+	for i := range fragment {
+		offsets = append(offsets, i)
+	}
+	offsets = append(offsets, len(fragment))
+	if end < len(offsets) && found(offsets[start], offsets[end]) {
+		return offsets[start], offsets[end], true
+	}
+	return 0, 0, false
+}
+
 // CodeSearchResult represents the result of a code search.
 type CodeSearchResult struct {
 	Total             *int          `json:"total_count,omitempty"`
@@ -245,6 +316,16 @@ func (c CodeResult) String() string {
 
 // Code searches code via various criteria.
 //
+// The REST API uses the legacy code search engine, not the new code search
+// available on github.com, and there is no REST or GraphQL API for the new
+// engine. Queries therefore use the legacy syntax: qualifiers such as
+// "language:", "path:", "filename:", "extension:", "in:file", and "repo:" are
+// supported, while regular expressions, boolean operators, and the "content:"
+// and "symbol:" qualifiers are not. Only files on the default branch that are
+// smaller than 384 KB are searchable, and a query must include at least one
+// search term. Set opts.TextMatch to receive the matched fragments in
+// CodeResult.TextMatches.
+//
 // GitHub API docs: https://docs.github.com/rest/search/search#search-code
 //
 //meta:operation GET /search/code
@@ -343,5 +424,30 @@ func (s *SearchService) search(ctx context.Context, searchType string, parameter
 	}
 	req.Header.Set("Accept", strings.Join(acceptHeaders, ", "))
 
-	return s.client.Do(ctx, req, result)
+	resp, err := s.client.Do(ctx, req, result)
+	if err != nil {
+		return resp, err
+	}
+	if r, ok := result.(interface{ GetIncompleteResults() bool }); ok && s.client.searchCompletionCheck && r.GetIncompleteResults() {
+		return resp, &IncompleteSearchError{Response: resp.Response, Result: result}
+	}
+	return resp, nil
+}
+
+// IncompleteSearchError is returned by SearchService methods of a client
+// created with WithSearchCompletionCheck(true) when GitHub reports that the
+// results are incomplete, for example because the search timed out.
+//
+// GitHub API docs: https://docs.github.com/rest/search/search#timeouts-and-incomplete-results
+type IncompleteSearchError struct {
+	Response *http.Response // HTTP response that caused this error
+
+	// Result holds the partial results, as the same type the search method
+	// returns, such as *IssuesSearchResult.
+	Result interface{}
+}
+
+func (e *IncompleteSearchError) Error() string {
+	return fmt.Sprintf("%v %v: search results are incomplete",
+		e.Response.Request.Method, sanitizeURL(e.Response.Request.URL))
 }
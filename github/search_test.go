@@ -7,6 +7,7 @@ package github
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"strings"
@@ -265,6 +266,42 @@ func TestSearchService_Issues(t *testing.T) {
 	}
 }
 
+func TestSearchService_Issues_completionCheck(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/search/issues", func(w http.ResponseWriter, r *http.Request) {
+		incomplete := r.FormValue("q") == "slow"
+		fmt.Fprintf(w, `{"total_count": 1, "incomplete_results": %v, "items": [{"number":1}]}`, incomplete)
+	})
+
+	client = client.WithSearchCompletionCheck(true)
+	ctx := context.Background()
+	result, _, err := client.Search.Issues(ctx, "slow", nil)
+	if result != nil {
+		t.Errorf("Search.Issues returned %+v, want nil", result)
+	}
+	var incompleteErr *IncompleteSearchError
+	if !errors.As(err, &incompleteErr) {
+		t.Fatalf("Search.Issues returned error %v, want *IncompleteSearchError", err)
+	}
+	want := &IssuesSearchResult{
+		Total:             Ptr(1),
+		IncompleteResults: Ptr(true),
+		Issues:            []*Issue{{Number: Ptr(1)}},
+	}
+	if !cmp.Equal(incompleteErr.Result, want) {
+		t.Errorf("IncompleteSearchError.Result = %+v, want %+v", incompleteErr.Result, want)
+	}
+	if got := err.Error(); !strings.Contains(got, "search results are incomplete") {
+		t.Errorf("Error() = %q", got)
+	}
+
+	if _, _, err := client.Search.Issues(ctx, "blah", nil); err != nil {
+		t.Errorf("Search.Issues returned error for complete results: %v", err)
+	}
+}
+
 func TestSearchService_Issues_coverage(t *testing.T) {
 	t.Parallel()
 	client, _, _ := setup(t)
@@ -1020,3 +1057,58 @@ func TestCodeSearchResult_Marshal(t *testing.T) {
 
 	testJSONMarshal(t, u, want)
 }
+
+func TestTextMatch_Highlight(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		tm   *TextMatch
+		want string
+	}{
+		{
+			name: "no matches",
+			tm:   &TextMatch{Fragment: Ptr("hello world")},
+			want: "hello world",
+		},
+		{
+			name: "byte offsets out of order",
+			tm: &TextMatch{
+				Fragment: Ptr("foo bar foo"),
+				Matches: []*Match{
+					{Text: Ptr("foo"), Indices: []int{8, 11}},
+					{Text: Ptr("foo"), Indices: []int{0, 3}},
+				},
+			},
+			want: "<em>foo</em> bar <em>foo</em>",
+		},
+		{
+			name: "character offsets",
+			tm: &TextMatch{
+				Fragment: Ptr("héllo wörld"),
+				Matches:  []*Match{{Text: Ptr("wörld"), Indices: []int{6, 11}}},
+			},
+			want: "héllo <em>wörld</em>",
+		},
+		{
+			name: "invalid and overlapping matches are skipped",
+			tm: &TextMatch{
+				Fragment: Ptr("abcdef"),
+				Matches: []*Match{
+					{Text: Ptr("bcd"), Indices: []int{1, 4}},
+					{Text: Ptr("cde"), Indices: []int{2, 5}},
+					{Text: Ptr("xyz"), Indices: []int{0, 3}},
+					{Text: Ptr("f"), Indices: []int{5, 99}},
+					{Indices: []int{1}},
+					nil,
+				},
+			},
+			want: "a<em>bcd</em>ef",
+		},
+	}
+
+	for _, tt := range tests {
+		if got := tt.tm.Highlight("<em>", "</em>"); got != tt.want {
+			t.Errorf("%v: Highlight = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
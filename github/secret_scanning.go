@@ -104,7 +104,7 @@ type SecretScanningAlertUpdateOptions struct {
 //meta:operation GET /enterprises/{enterprise}/secret-scanning/alerts
 func (s *SecretScanningService) ListAlertsForEnterprise(ctx context.Context, enterprise string, opts *SecretScanningAlertListOptions) ([]*SecretScanningAlert, *Response, error) {
 	u := fmt.Sprintf("enterprises/%v/secret-scanning/alerts", enterprise)
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -133,7 +133,7 @@ func (s *SecretScanningService) ListAlertsForEnterprise(ctx context.Context, ent
 //meta:operation GET /orgs/{org}/secret-scanning/alerts
 func (s *SecretScanningService) ListAlertsForOrg(ctx context.Context, org string, opts *SecretScanningAlertListOptions) ([]*SecretScanningAlert, *Response, error) {
 	u := fmt.Sprintf("orgs/%v/secret-scanning/alerts", org)
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -162,7 +162,7 @@ func (s *SecretScanningService) ListAlertsForOrg(ctx context.Context, org string
 //meta:operation GET /repos/{owner}/{repo}/secret-scanning/alerts
 func (s *SecretScanningService) ListAlertsForRepo(ctx context.Context, owner, repo string, opts *SecretScanningAlertListOptions) ([]*SecretScanningAlert, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/secret-scanning/alerts", owner, repo)
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -241,7 +241,7 @@ func (s *SecretScanningService) UpdateAlert(ctx context.Context, owner, repo str
 //meta:operation GET /repos/{owner}/{repo}/secret-scanning/alerts/{alert_number}/locations
 func (s *SecretScanningService) ListLocationsForAlert(ctx context.Context, owner, repo string, number int64, opts *ListOptions) ([]*SecretScanningAlertLocation, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/secret-scanning/alerts/%v/locations", owner, repo, number)
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -186,7 +186,7 @@ func (s *SecurityAdvisoriesService) CreateTemporaryPrivateFork(ctx context.Conte
 //meta:operation GET /orgs/{org}/security-advisories
 func (s *SecurityAdvisoriesService) ListRepositorySecurityAdvisoriesForOrg(ctx context.Context, org string, opt *ListRepositorySecurityAdvisoriesOptions) ([]*SecurityAdvisory, *Response, error) {
 	url := fmt.Sprintf("orgs/%v/security-advisories", org)
-	url, err := addOptions(url, opt)
+	url, err := s.client.addOptions(url, opt)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -212,7 +212,7 @@ func (s *SecurityAdvisoriesService) ListRepositorySecurityAdvisoriesForOrg(ctx c
 //meta:operation GET /repos/{owner}/{repo}/security-advisories
 func (s *SecurityAdvisoriesService) ListRepositorySecurityAdvisories(ctx context.Context, owner, repo string, opt *ListRepositorySecurityAdvisoriesOptions) ([]*SecurityAdvisory, *Response, error) {
 	url := fmt.Sprintf("repos/%v/%v/security-advisories", owner, repo)
-	url, err := addOptions(url, opt)
+	url, err := s.client.addOptions(url, opt)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -238,7 +238,7 @@ func (s *SecurityAdvisoriesService) ListRepositorySecurityAdvisories(ctx context
 //meta:operation GET /advisories
 func (s *SecurityAdvisoriesService) ListGlobalSecurityAdvisories(ctx context.Context, opts *ListGlobalSecurityAdvisoriesOptions) ([]*GlobalSecurityAdvisory, *Response, error) {
 	url := "advisories"
-	url, err := addOptions(url, opts)
+	url, err := s.client.addOptions(url, opts)
 	if err != nil {
 		return nil, nil, err
 	}
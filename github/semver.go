@@ -0,0 +1,135 @@
+// Copyright 2026 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"strconv"
+	"strings"
+)
+
+// semver is a version parsed from a tag name such as "v1.2.3" or
+// "1.2.3-rc.1+build.5", following https://semver.org/spec/v2.0.0.html.
+type semver struct {
+	major, minor, patch uint64
+	prerelease          []string
+}
+
+// parseSemver parses a semantic version, optionally prefixed with "v". It
+// reports false if s is not a valid semantic version.
+func parseSemver(s string) (semver, bool) {
+	s = strings.TrimPrefix(s, "v")
+	if i := strings.IndexByte(s, '+'); i >= 0 {
+		if !validIdentifiers(s[i+1:], false) {
+			return semver{}, false
+		}
+		s = s[:i]
+	}
+	var v semver
+	core, pre, hasPre := strings.Cut(s, "-")
+	if hasPre {
+		if !validIdentifiers(pre, true) {
+			return semver{}, false
+		}
+		v.prerelease = strings.Split(pre, ".")
+	}
+
+	parts := strings.Split(core, ".")
+	if len(parts) != 3 {
+		return semver{}, false
+	}
+	nums := make([]uint64, 3)
+	for i, part := range parts {
+		if !isNumericIdentifier(part) {
+			return semver{}, false
+		}
+		n, err := strconv.ParseUint(part, 10, 64)
+		if err != nil {
+			return semver{}, false
+		}
+		nums[i] = n
+	}
+	v.major, v.minor, v.patch = nums[0], nums[1], nums[2]
+	return v, true
+}
+
+// validIdentifiers reports whether s is a non-empty dot-separated list of
+// alphanumeric identifiers. If prerelease is true, numeric identifiers must
+// not have leading zeros.
+func validIdentifiers(s string, prerelease bool) bool {
+	for _, id := range strings.Split(s, ".") {
+		if id == "" || strings.Trim(id, "0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ-") != "" {
+			return false
+		}
+		if prerelease && strings.Trim(id, "0123456789") == "" && !isNumericIdentifier(id) {
+			return false
+		}
+	}
+	return true
+}
+
+// isNumericIdentifier reports whether s is a non-empty string of digits
+// without a leading zero, other than "0" itself.
+func isNumericIdentifier(s string) bool {
+	if s == "" || strings.Trim(s, "0123456789") != "" {
+		return false
+	}
+	return s == "0" || s[0] != '0'
+}
+
+// compare returns -1, 0, or +1 depending on whether v has lower, equal, or
+// higher precedence than w. Build metadata is ignored.
+func (v semver) compare(w semver) int {
+	for _, c := range [][2]uint64{{v.major, w.major}, {v.minor, w.minor}, {v.patch, w.patch}} {
+		if c[0] != c[1] {
+			if c[0] < c[1] {
+				return -1
+			}
+			return 1
+		}
+	}
+
+	// A version without a prerelease has higher precedence than one with.
+	switch {
+	case len(v.prerelease) == 0 && len(w.prerelease) == 0:
+		return 0
+	case len(v.prerelease) == 0:
+		return 1
+	case len(w.prerelease) == 0:
+		return -1
+	}
+	for i := 0; i < len(v.prerelease) && i < len(w.prerelease); i++ {
+		if c := compareIdentifier(v.prerelease[i], w.prerelease[i]); c != 0 {
+			return c
+		}
+	}
+	switch {
+	case len(v.prerelease) < len(w.prerelease):
+		return -1
+	case len(v.prerelease) > len(w.prerelease):
+		return 1
+	}
+	return 0
+}
+
+// compareIdentifier compares two prerelease identifiers. Numeric identifiers
+// compare numerically and have lower precedence than alphanumeric ones.
+func compareIdentifier(a, b string) int {
+	aNum, bNum := isNumericIdentifier(a), isNumericIdentifier(b)
+	switch {
+	case aNum && bNum:
+		if len(a) != len(b) {
+			if len(a) < len(b) {
+				return -1
+			}
+			return 1
+		}
+	case aNum:
+		return -1
+	case bNum:
+		return 1
+	}
+	return strings.Compare(a, b)
+}
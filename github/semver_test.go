@@ -0,0 +1,66 @@
+// Copyright 2026 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import "testing"
+
+func TestParseSemver(t *testing.T) {
+	t.Parallel()
+	valid := []string{"1.2.3", "v0.0.0", "v1.2.3-rc.1", "1.0.0-alpha-1.0+build.5", "1.0.0+20130313144700"}
+	for _, s := range valid {
+		if _, ok := parseSemver(s); !ok {
+			t.Errorf("parseSemver(%q) reported invalid", s)
+		}
+	}
+
+	invalid := []string{"", "v", "1.2", "1.2.3.4", "01.2.3", "1.2.x", "1.2.3-", "1.2.3-01", "1.2.3-a..b", "1.2.3+", "1.2.3-a_b", "release-1", "vv1.2.3"}
+	for _, s := range invalid {
+		if _, ok := parseSemver(s); ok {
+			t.Errorf("parseSemver(%q) reported valid", s)
+		}
+	}
+}
+
+func TestSemver_compare(t *testing.T) {
+	t.Parallel()
+	// In increasing order of precedence, from https://semver.org/#spec-item-11.
+	ordered := []string{
+		"1.0.0-alpha",
+		"1.0.0-alpha.1",
+		"1.0.0-alpha.beta",
+		"1.0.0-beta",
+		"1.0.0-beta.2",
+		"1.0.0-beta.11",
+		"1.0.0-rc.1",
+		"1.0.0",
+		"1.0.1",
+		"1.2.0",
+		"1.10.0",
+		"2.0.0",
+	}
+
+	for i, a := range ordered {
+		for j, b := range ordered {
+			va, _ := parseSemver(a)
+			vb, _ := parseSemver(b)
+			want := 0
+			if i < j {
+				want = -1
+			} else if i > j {
+				want = 1
+			}
+			if got := va.compare(vb); got != want {
+				t.Errorf("compare(%v, %v) = %v, want %v", a, b, got, want)
+			}
+		}
+	}
+
+	a, _ := parseSemver("v1.0.0+a")
+	b, _ := parseSemver("1.0.0+b")
+	if got := a.compare(b); got != 0 {
+		t.Errorf("compare ignoring build metadata = %v, want 0", got)
+	}
+}
@@ -48,7 +48,7 @@ type DiscussionCommentListOptions struct {
 //meta:operation GET /orgs/{org}/teams/{team_slug}/discussions/{discussion_number}/comments
 func (s *TeamsService) ListCommentsByID(ctx context.Context, orgID, teamID int64, discussionNumber int, options *DiscussionCommentListOptions) ([]*DiscussionComment, *Response, error) {
 	u := fmt.Sprintf("organizations/%v/team/%v/discussions/%v/comments", orgID, teamID, discussionNumber)
-	u, err := addOptions(u, options)
+	u, err := s.client.addOptions(u, options)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -75,7 +75,7 @@ func (s *TeamsService) ListCommentsByID(ctx context.Context, orgID, teamID int64
 //meta:operation GET /orgs/{org}/teams/{team_slug}/discussions/{discussion_number}/comments
 func (s *TeamsService) ListCommentsBySlug(ctx context.Context, org, slug string, discussionNumber int, options *DiscussionCommentListOptions) ([]*DiscussionComment, *Response, error) {
 	u := fmt.Sprintf("orgs/%v/teams/%v/discussions/%v/comments", org, slug, discussionNumber)
-	u, err := addOptions(u, options)
+	u, err := s.client.addOptions(u, options)
 	if err != nil {
 		return nil, nil, err
 	}
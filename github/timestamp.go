@@ -13,7 +13,9 @@ import (
 // Timestamp represents a time that can be unmarshaled from a JSON string
 // formatted as either an RFC3339 or Unix timestamp. This is necessary for some
 // fields since the GitHub API is inconsistent in how it represents times. All
-// exported methods of time.Time can be called on Timestamp.
+// exported methods of time.Time can be called on Timestamp, such as In to
+// convert it to a caller's time.Location, Format, and IsZero, which reports
+// true for a Timestamp that was absent or null in the JSON.
 type Timestamp struct {
 	time.Time
 }
@@ -31,9 +33,12 @@ func (t *Timestamp) GetTime() *time.Time {
 }
 
 // UnmarshalJSON implements the json.Unmarshaler interface.
-// Time is expected in RFC3339 or Unix format.
+// Time is expected in RFC3339 or Unix format. A JSON null leaves t unchanged.
 func (t *Timestamp) UnmarshalJSON(data []byte) (err error) {
 	str := string(data)
+	if str == "null" {
+		return nil
+	}
 	i, err := strconv.ParseInt(str, 10, 64)
 	if err == nil {
 		t.Time = time.Unix(i, 0)
@@ -186,6 +186,33 @@ func TestTimestamp_GetTime(t *testing.T) {
 	}
 }
 
+func TestTimestamp_UnmarshalNull(t *testing.T) {
+	t.Parallel()
+	var got struct {
+		T Timestamp `json:"t"`
+	}
+	if err := json.Unmarshal([]byte(`{"t":null}`), &got); err != nil {
+		t.Fatalf("Unmarshal returned error: %v", err)
+	}
+	if !got.T.IsZero() {
+		t.Errorf("Unmarshal of null = %v, want zero Timestamp", got.T)
+	}
+}
+
+func TestTimestamp_In(t *testing.T) {
+	t.Parallel()
+	loc := time.FixedZone("UTC+9", 9*60*60)
+	ts := Timestamp{referenceTime}
+
+	got := ts.In(loc)
+	if !got.Equal(referenceTime) || got.Location() != loc {
+		t.Errorf("In = %v, want %v in %v", got, referenceTime, loc)
+	}
+	if got, want := ts.In(loc).Format(time.Kitchen), referenceTime.In(loc).Format(time.Kitchen); got != want {
+		t.Errorf("Format = %q, want %q", got, want)
+	}
+}
+
 func TestWrappedTimestamp_MarshalReflexivity(t *testing.T) {
 	t.Parallel()
 	testCases := []struct {
@@ -185,7 +185,7 @@ type UserContext struct {
 //meta:operation GET /users/{username}/hovercard
 func (s *UsersService) GetHovercard(ctx context.Context, user string, opts *HovercardOptions) (*Hovercard, *Response, error) {
 	u := fmt.Sprintf("users/%v/hovercard", user)
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -224,7 +224,7 @@ type UserListOptions struct {
 //
 //meta:operation GET /users
 func (s *UsersService) ListAll(ctx context.Context, opts *UserListOptions) ([]*User, *Response, error) {
-	u, err := addOptions("users", opts)
+	u, err := s.client.addOptions("users", opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -250,7 +250,7 @@ func (s *UsersService) ListAll(ctx context.Context, opts *UserListOptions) ([]*U
 //
 //meta:operation GET /user/repository_invitations
 func (s *UsersService) ListInvitations(ctx context.Context, opts *ListOptions) ([]*RepositoryInvitation, *Response, error) {
-	u, err := addOptions("user/repository_invitations", opts)
+	u, err := s.client.addOptions("user/repository_invitations", opts)
 	if err != nil {
 		return nil, nil, err
 	}
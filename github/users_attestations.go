@@ -20,7 +20,7 @@ import (
 func (s *UsersService) ListAttestations(ctx context.Context, user, subjectDigest string, opts *ListOptions) (*AttestationsResponse, *Response, error) {
 	var u = fmt.Sprintf("users/%v/attestations/%v", user, subjectDigest)
 
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
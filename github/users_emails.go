@@ -5,7 +5,10 @@
 
 package github
 
-import "context"
+import (
+	"context"
+	"fmt"
+)
 
 // UserEmail represents user's email address.
 type UserEmail struct {
@@ -22,7 +25,7 @@ type UserEmail struct {
 //meta:operation GET /user/emails
 func (s *UsersService) ListEmails(ctx context.Context, opts *ListOptions) ([]*UserEmail, *Response, error) {
 	u := "user/emails"
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -41,6 +44,53 @@ func (s *UsersService) ListEmails(ctx context.Context, opts *ListOptions) ([]*Us
 	return emails, resp, nil
 }
 
+// NoVerifiedEmailError is returned by GetPrimaryEmail when the authenticated
+// user has no email address that is both primary and verified.
+type NoVerifiedEmailError struct {
+	// Primary is the user's primary email address, if it exists but has not
+	// been verified.
+	Primary *UserEmail
+}
+
+func (e *NoVerifiedEmailError) Error() string {
+	if e.Primary != nil {
+		return fmt.Sprintf("primary email address %v is not verified", e.Primary.GetEmail())
+	}
+	return "no primary email address"
+}
+
+// GetPrimaryEmail returns the primary email address of the authenticated user,
+// paging through ListEmails as needed. If the user has no primary email address
+// or it has not been verified, a *NoVerifiedEmailError is returned.
+//
+// GitHub API docs: https://docs.github.com/rest/users/emails#list-email-addresses-for-the-authenticated-user
+//
+//meta:operation GET /user/emails
+func (s *UsersService) GetPrimaryEmail(ctx context.Context) (*UserEmail, *Response, error) {
+	opts := &ListOptions{PerPage: 100}
+	for {
+		emails, resp, err := s.ListEmails(ctx, opts)
+		if err != nil {
+			return nil, resp, err
+		}
+
+		for _, e := range emails {
+			if !e.GetPrimary() {
+				continue
+			}
+			if !e.GetVerified() {
+				return nil, resp, &NoVerifiedEmailError{Primary: e}
+			}
+			return e, resp, nil
+		}
+
+		if resp.NextPage == 0 {
+			return nil, resp, &NoVerifiedEmailError{}
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
 // AddEmails adds email addresses of the authenticated user.
 //
 // GitHub API docs: https://docs.github.com/rest/users/emails#add-an-email-address-for-the-authenticated-user
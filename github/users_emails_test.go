@@ -8,6 +8,7 @@ package github
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"testing"
@@ -185,3 +186,79 @@ func TestUsersService_SetEmailVisibility(t *testing.T) {
 		return resp, err
 	})
 }
+
+func TestUsersService_GetPrimaryEmail(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/user/emails", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if r.FormValue("page") == "" {
+			w.Header().Set("Link", `<https://api.github.com/user/emails?page=2>; rel="next"`)
+			fmt.Fprint(w, `[{"email":"a@example.com","verified":true}]`)
+			return
+		}
+		fmt.Fprint(w, `[{"email":"b@example.com","primary":true,"verified":true}]`)
+	})
+
+	ctx := context.Background()
+	email, _, err := client.Users.GetPrimaryEmail(ctx)
+	if err != nil {
+		t.Errorf("Users.GetPrimaryEmail returned error: %v", err)
+	}
+
+	want := &UserEmail{Email: Ptr("b@example.com"), Primary: Ptr(true), Verified: Ptr(true)}
+	if !cmp.Equal(email, want) {
+		t.Errorf("Users.GetPrimaryEmail returned %+v, want %+v", email, want)
+	}
+
+	const methodName = "GetPrimaryEmail"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Users.GetPrimaryEmail(ctx)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestUsersService_GetPrimaryEmail_notVerified(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/user/emails", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"email":"a@example.com","primary":true,"verified":false}]`)
+	})
+
+	ctx := context.Background()
+	_, _, err := client.Users.GetPrimaryEmail(ctx)
+	var noVerified *NoVerifiedEmailError
+	if !errors.As(err, &noVerified) {
+		t.Fatalf("Users.GetPrimaryEmail returned error %v, want *NoVerifiedEmailError", err)
+	}
+	if got, want := noVerified.Primary.GetEmail(), "a@example.com"; got != want {
+		t.Errorf("NoVerifiedEmailError.Primary = %q, want %q", got, want)
+	}
+	if got, want := err.Error(), "primary email address a@example.com is not verified"; got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+}
+
+func TestUsersService_GetPrimaryEmail_noPrimary(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/user/emails", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"email":"a@example.com","verified":true}]`)
+	})
+
+	ctx := context.Background()
+	_, _, err := client.Users.GetPrimaryEmail(ctx)
+	var noVerified *NoVerifiedEmailError
+	if !errors.As(err, &noVerified) {
+		t.Fatalf("Users.GetPrimaryEmail returned error %v, want *NoVerifiedEmailError", err)
+	}
+	if noVerified.Primary != nil {
+		t.Errorf("NoVerifiedEmailError.Primary = %+v, want nil", noVerified.Primary)
+	}
+}
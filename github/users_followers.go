@@ -25,7 +25,7 @@ func (s *UsersService) ListFollowers(ctx context.Context, user string, opts *Lis
 	} else {
 		u = "user/followers"
 	}
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -59,7 +59,7 @@ func (s *UsersService) ListFollowing(ctx context.Context, user string, opts *Lis
 	} else {
 		u = "user/following"
 	}
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -133,3 +133,54 @@ func (s *UsersService) Unfollow(ctx context.Context, user string) (*Response, er
 
 	return s.client.Do(ctx, req, nil)
 }
+
+// followManyConcurrency is the number of concurrent requests made by
+// FollowMany and UnfollowMany. It is kept low, as GitHub discourages
+// concurrent mutating requests.
+const followManyConcurrency = 4
+
+// FollowMany causes the authenticated user to follow each of the users in
+// logins, making a few requests concurrently. It returns the errors for the
+// logins that could not be followed, keyed by login, or nil if all succeeded.
+// Once a rate limit error is returned, or ctx is done, no further requests are
+// made and the remaining logins are reported with that error. See
+// ForEachBounded.
+//
+// GitHub API docs: https://docs.github.com/rest/users/followers#follow-a-user
+//
+//meta:operation PUT /user/following/{username}
+func (s *UsersService) FollowMany(ctx context.Context, logins []string) map[string]error {
+	return eachLogin(ctx, logins, func(ctx context.Context, login string) error {
+		_, err := s.Follow(ctx, login)
+		return err
+	})
+}
+
+// UnfollowMany causes the authenticated user to unfollow each of the users in
+// logins, in the same way as FollowMany.
+//
+// GitHub API docs: https://docs.github.com/rest/users/followers#unfollow-a-user
+//
+//meta:operation DELETE /user/following/{username}
+func (s *UsersService) UnfollowMany(ctx context.Context, logins []string) map[string]error {
+	return eachLogin(ctx, logins, func(ctx context.Context, login string) error {
+		_, err := s.Unfollow(ctx, login)
+		return err
+	})
+}
+
+// eachLogin calls fn for each of logins with ForEachBounded and returns the
+// non-nil errors keyed by login.
+func eachLogin(ctx context.Context, logins []string, fn func(ctx context.Context, login string) error) map[string]error {
+	var failed map[string]error
+	for i, err := range ForEachBounded(ctx, logins, followManyConcurrency, fn) {
+		if err == nil {
+			continue
+		}
+		if failed == nil {
+			failed = make(map[string]error)
+		}
+		failed[logins[i]] = err
+	}
+	return failed
+}
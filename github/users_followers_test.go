@@ -7,6 +7,7 @@ package github
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"testing"
@@ -384,3 +385,43 @@ func TestUsersService_Unfollow_invalidUser(t *testing.T) {
 	_, err := client.Users.Unfollow(ctx, "%")
 	testURLParseError(t, err)
 }
+
+func TestUsersService_FollowMany(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	for _, login := range []string{"a", "b", "c"} {
+		mux.HandleFunc("/user/following/"+login, func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, "PUT")
+			w.WriteHeader(http.StatusNoContent)
+		})
+	}
+	mux.HandleFunc("/user/following/missing", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	ctx := context.Background()
+	failed := client.Users.FollowMany(ctx, []string{"a", "missing", "b", "c"})
+	if len(failed) != 1 {
+		t.Fatalf("Users.FollowMany returned %v, want one failure", failed)
+	}
+	var errResp *ErrorResponse
+	if !errors.As(failed["missing"], &errResp) || errResp.Response.StatusCode != http.StatusNotFound {
+		t.Errorf("Users.FollowMany returned error %v for missing, want 404 *ErrorResponse", failed["missing"])
+	}
+}
+
+func TestUsersService_UnfollowMany(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/user/following/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	if failed := client.Users.UnfollowMany(ctx, []string{"a", "b"}); failed != nil {
+		t.Errorf("Users.UnfollowMany returned %v, want nil", failed)
+	}
+}
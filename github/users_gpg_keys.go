@@ -56,7 +56,7 @@ func (s *UsersService) ListGPGKeys(ctx context.Context, user string, opts *ListO
 	} else {
 		u = "user/gpg_keys"
 	}
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
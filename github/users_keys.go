@@ -42,7 +42,7 @@ func (s *UsersService) ListKeys(ctx context.Context, user string, opts *ListOpti
 	} else {
 		u = "user/keys"
 	}
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
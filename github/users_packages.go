@@ -25,7 +25,7 @@ func (s *UsersService) ListPackages(ctx context.Context, user string, opts *Pack
 	} else {
 		u = "user/packages"
 	}
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -137,7 +137,7 @@ func (s *UsersService) PackageGetAllVersions(ctx context.Context, user, packageT
 	} else {
 		u = fmt.Sprintf("user/packages/%v/%v/versions", packageType, packageName)
 	}
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -37,7 +37,7 @@ func (s *UsersService) ListSSHSigningKeys(ctx context.Context, user string, opts
 	} else {
 		u = "user/ssh_signing_keys"
 	}
-	u, err := addOptions(u, opts)
+	u, err := s.client.addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -0,0 +1,63 @@
+// Copyright 2026 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"time"
+)
+
+// defaultWaitInterval is the polling interval used when WaitOptions.Interval
+// is not set.
+const defaultWaitInterval = time.Second
+
+// WaitOptions specifies how the WaitFor methods poll GitHub while waiting for
+// an eventually consistent result to become available.
+type WaitOptions struct {
+	// Interval is the time to wait between polls. Default: 1 second.
+	Interval time.Duration
+
+	// Timeout bounds the total time spent waiting. If zero, waiting only
+	// stops when the context is done or the client's retry budget, if any,
+	// is exhausted (see Client.WithRetryBudget).
+	Timeout time.Duration
+}
+
+// poll calls check until it reports done or returns an error, sleeping for
+// opts.Interval between calls. If ctx is done or opts.Timeout elapses first,
+// ctx.Err() of the (possibly timeout-bounded) context is returned. Every call
+// after the first consumes the client's retry budget, if any; once it is
+// exhausted, ErrRetryBudgetExhausted is returned.
+func (c *Client) poll(ctx context.Context, opts WaitOptions, check func(ctx context.Context) (done bool, err error)) error {
+	interval := opts.Interval
+	if interval <= 0 {
+		interval = defaultWaitInterval
+	}
+	if opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+	}
+
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+		}
+
+		done, err := check(ctx)
+		if err != nil || done {
+			return err
+		}
+		if !c.allowRetry() {
+			return ErrRetryBudgetExhausted
+		}
+		timer.Reset(interval)
+	}
+}
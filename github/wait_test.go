@@ -0,0 +1,72 @@
+// Copyright 2026 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestPoll(t *testing.T) {
+	t.Parallel()
+	calls := 0
+	err := (&Client{}).poll(context.Background(), WaitOptions{Interval: time.Millisecond}, func(context.Context) (bool, error) {
+		calls++
+		return calls == 3, nil
+	})
+	if err != nil {
+		t.Errorf("poll returned error: %v", err)
+	}
+	if calls != 3 {
+		t.Errorf("poll called check %v times, want 3", calls)
+	}
+}
+
+func TestPoll_checkError(t *testing.T) {
+	t.Parallel()
+	wantErr := errors.New("check failed")
+	err := (&Client{}).poll(context.Background(), WaitOptions{}, func(context.Context) (bool, error) {
+		return false, wantErr
+	})
+	if !errors.Is(err, wantErr) {
+		t.Errorf("poll returned error %v, want %v", err, wantErr)
+	}
+}
+
+func TestPoll_canceled(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithCancel(context.Background())
+	calls := 0
+	err := (&Client{}).poll(ctx, WaitOptions{Interval: time.Hour}, func(context.Context) (bool, error) {
+		calls++
+		cancel()
+		return false, nil
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("poll returned error %v, want %v", err, context.Canceled)
+	}
+	if calls != 1 {
+		t.Errorf("poll called check %v times, want 1", calls)
+	}
+}
+
+func TestPoll_retryBudget(t *testing.T) {
+	t.Parallel()
+	c := &Client{retryBudget: newRetryBudget(2, time.Hour)}
+	calls := 0
+	err := c.poll(context.Background(), WaitOptions{Interval: time.Millisecond}, func(context.Context) (bool, error) {
+		calls++
+		return false, nil
+	})
+	if !errors.Is(err, ErrRetryBudgetExhausted) {
+		t.Errorf("poll returned error %v, want %v", err, ErrRetryBudgetExhausted)
+	}
+	if calls != 3 {
+		t.Errorf("poll called check %v times, want 3", calls)
+	}
+}
@@ -0,0 +1,14 @@
+module github.com/google/go-github/v69/workflowinputs
+
+go 1.22.0
+
+require (
+	github.com/google/go-cmp v0.6.0
+	github.com/google/go-github/v69 v69.0.0
+	gopkg.in/yaml.v3 v3.0.1
+)
+
+require github.com/google/go-querystring v1.1.0 // indirect
+
+// Use version at HEAD, not the latest published.
+replace github.com/google/go-github/v69 => ../
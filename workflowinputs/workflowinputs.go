@@ -0,0 +1,109 @@
+// Copyright 2026 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package workflowinputs reads the inputs declared by workflow_dispatch
+// workflows, so that they can be checked with github.WorkflowInputs.Validate
+// before a workflow is dispatched. It is a separate module so that the github
+// package does not depend on a YAML parser.
+package workflowinputs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/google/go-github/v69/github"
+	"gopkg.in/yaml.v3"
+)
+
+// ErrNotDispatchable is returned by Get and Parse when the workflow is not
+// triggered by the workflow_dispatch event.
+var ErrNotDispatchable = errors.New("workflow does not have a workflow_dispatch trigger")
+
+// Get reads the inputs declared under on.workflow_dispatch in a workflow file,
+// such as "main.yml", on the default branch of the repository. workflowFile
+// may also be a path, such as ".github/workflows/main.yml". It returns
+// ErrNotDispatchable if the workflow cannot be dispatched.
+//
+// GitHub API docs: https://docs.github.com/rest/repos/contents#get-repository-content
+func Get(ctx context.Context, client *github.Client, owner, repo, workflowFile string) (github.WorkflowInputs, *github.Response, error) {
+	filepath := workflowFile
+	if !strings.Contains(filepath, "/") {
+		filepath = path.Join(".github/workflows", filepath)
+	}
+
+	file, _, resp, err := client.Repositories.GetContents(ctx, owner, repo, filepath, nil)
+	if err != nil {
+		return nil, resp, err
+	}
+	if file == nil {
+		return nil, resp, fmt.Errorf("%v is a directory, not a workflow file", filepath)
+	}
+	content, err := file.GetContent()
+	if err != nil {
+		return nil, resp, err
+	}
+
+	inputs, err := Parse([]byte(content))
+	if err != nil {
+		return nil, resp, fmt.Errorf("parsing %v: %w", filepath, err)
+	}
+	return inputs, resp, nil
+}
+
+// Parse returns the workflow_dispatch inputs declared by the workflow file
+// data. Inputs that do not declare a type are given the type "string". It
+// returns ErrNotDispatchable if the workflow cannot be dispatched.
+func Parse(data []byte) (github.WorkflowInputs, error) {
+	var workflow struct {
+		On yaml.Node `yaml:"on"`
+	}
+	if err := yaml.Unmarshal(data, &workflow); err != nil {
+		return nil, err
+	}
+
+	// on may be a single event, a list of events, or a map of events to
+	// their configuration.
+	on := &workflow.On
+	switch on.Kind {
+	case yaml.ScalarNode:
+		if on.Value == "workflow_dispatch" {
+			return github.WorkflowInputs{}, nil
+		}
+	case yaml.SequenceNode:
+		for _, event := range on.Content {
+			if event.Value == "workflow_dispatch" {
+				return github.WorkflowInputs{}, nil
+			}
+		}
+	case yaml.MappingNode:
+		var events map[string]*struct {
+			Inputs github.WorkflowInputs `yaml:"inputs"`
+		}
+		if err := on.Decode(&events); err != nil {
+			return nil, err
+		}
+		dispatch, ok := events["workflow_dispatch"]
+		if !ok {
+			break
+		}
+		inputs := github.WorkflowInputs{}
+		if dispatch != nil {
+			for name, input := range dispatch.Inputs {
+				if input == nil {
+					input = &github.WorkflowInput{}
+				}
+				if input.Type == "" {
+					input.Type = "string"
+				}
+				inputs[name] = input
+			}
+		}
+		return inputs, nil
+	}
+	return nil, ErrNotDispatchable
+}
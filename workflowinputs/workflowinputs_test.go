@@ -0,0 +1,108 @@
+// Copyright 2026 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package workflowinputs
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-github/v69/github"
+)
+
+const testDispatchWorkflow = `
+name: deploy
+on:
+  push:
+    branches: [main]
+  workflow_dispatch:
+    inputs:
+      environment:
+        description: Target environment
+        type: environment
+        required: true
+      level:
+        type: choice
+        options: [debug, info, warn]
+        default: info
+        required: true
+      dry_run:
+        type: boolean
+        default: true
+      replicas:
+        type: number
+      note:
+jobs: {}
+`
+
+var testDispatchInputs = github.WorkflowInputs{
+	"environment": {Description: "Target environment", Type: "environment", Required: true},
+	"level":       {Type: "choice", Options: []string{"debug", "info", "warn"}, Default: "info", Required: true},
+	"dry_run":     {Type: "boolean", Default: "true"},
+	"replicas":    {Type: "number"},
+	"note":        {Type: "string"},
+}
+
+func TestGet(t *testing.T) {
+	t.Parallel()
+	mux := http.NewServeMux()
+	mux.HandleFunc("/repos/o/r/contents/.github/workflows/deploy.yml", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" {
+			t.Errorf("Request method: %v, want GET", r.Method)
+		}
+		fmt.Fprintf(w, `{"type":"file","encoding":"base64","content":%q}`, base64.StdEncoding.EncodeToString([]byte(testDispatchWorkflow)))
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+
+	client := github.NewClient(nil)
+	client.BaseURL, _ = url.Parse(srv.URL + "/")
+
+	got, _, err := Get(context.Background(), client, "o", "r", "deploy.yml")
+	if err != nil {
+		t.Fatalf("Get returned error: %v", err)
+	}
+	if !cmp.Equal(got, testDispatchInputs) {
+		t.Errorf("Get returned %+v, want %+v", got, testDispatchInputs)
+	}
+}
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		yaml    string
+		want    github.WorkflowInputs
+		wantErr error
+	}{
+		{name: "map", yaml: testDispatchWorkflow, want: testDispatchInputs},
+		{name: "scalar", yaml: "on: workflow_dispatch", want: github.WorkflowInputs{}},
+		{name: "sequence", yaml: "on: [push, workflow_dispatch]", want: github.WorkflowInputs{}},
+		{name: "no inputs", yaml: "on:\n  workflow_dispatch:\n", want: github.WorkflowInputs{}},
+		{name: "not dispatchable", yaml: "on: [push]", wantErr: ErrNotDispatchable},
+		{name: "no trigger", yaml: "name: x", wantErr: ErrNotDispatchable},
+	}
+
+	for _, tt := range tests {
+		got, err := Parse([]byte(tt.yaml))
+		if !errors.Is(err, tt.wantErr) {
+			t.Errorf("%v: Parse returned error %v, want %v", tt.name, err, tt.wantErr)
+		}
+		if !cmp.Equal(got, tt.want) {
+			t.Errorf("%v: Parse = %+v, want %+v", tt.name, got, tt.want)
+		}
+	}
+
+	if _, err := Parse([]byte("on: [")); err == nil {
+		t.Error("Parse returned nil error for invalid YAML")
+	}
+}